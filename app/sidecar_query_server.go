@@ -9,6 +9,8 @@ import (
 
 	tenderminapi "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
 	"github.com/cosmos/cosmos-sdk/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/labstack/echo/v4"
 
 	// nolint: staticcheck
@@ -22,10 +24,12 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v27/app"
 	txfeestypes "github.com/osmosis-labs/osmosis/v27/x/txfees/types"
+	grpcserver "github.com/osmosis-labs/sqs/delivery/grpc/server"
 	"github.com/osmosis-labs/sqs/domain/cosmos/auth/types"
 	ingestrpcdelivry "github.com/osmosis-labs/sqs/ingest/delivery/grpc"
 	ingestusecase "github.com/osmosis-labs/sqs/ingest/usecase"
 	"github.com/osmosis-labs/sqs/ingest/usecase/plugins/basefee"
+	"github.com/osmosis-labs/sqs/ingest/usecase/plugins/denomdiscovery"
 	orderbookclaimbot "github.com/osmosis-labs/sqs/ingest/usecase/plugins/orderbook/claimbot"
 	orderbookfillbot "github.com/osmosis-labs/sqs/ingest/usecase/plugins/orderbook/fillbot"
 	orderbookrepository "github.com/osmosis-labs/sqs/orderbook/repository"
@@ -37,13 +41,18 @@ import (
 	chaininfousecase "github.com/osmosis-labs/sqs/chaininfo/usecase"
 	passthroughHttpDelivery "github.com/osmosis-labs/sqs/passthrough/delivery/http"
 	passthroughUseCase "github.com/osmosis-labs/sqs/passthrough/usecase"
+	poolsGRPCDelivery "github.com/osmosis-labs/sqs/pools/delivery/grpc"
 	poolsHttpDelivery "github.com/osmosis-labs/sqs/pools/delivery/http"
-	poolsUseCase "github.com/osmosis-labs/sqs/pools/usecase"
+	poolsusecase "github.com/osmosis-labs/sqs/pools/usecase"
 	routerrepo "github.com/osmosis-labs/sqs/router/repository"
 	routerWorker "github.com/osmosis-labs/sqs/router/usecase/worker"
+	tokensgrpcdelivery "github.com/osmosis-labs/sqs/tokens/delivery/grpc"
 	tokenshttpdelivery "github.com/osmosis-labs/sqs/tokens/delivery/http"
 	tokensusecase "github.com/osmosis-labs/sqs/tokens/usecase"
 	"github.com/osmosis-labs/sqs/tokens/usecase/pricing"
+	aggregatedpricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/aggregated"
+	sharepricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/share"
+	twappricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/twap"
 	pricingWorker "github.com/osmosis-labs/sqs/tokens/usecase/pricing/worker"
 
 	"github.com/osmosis-labs/sqs/domain"
@@ -54,16 +63,24 @@ import (
 	orderbookgrpcclientdomain "github.com/osmosis-labs/sqs/domain/orderbook/grpcclient"
 	orderbookplugindomain "github.com/osmosis-labs/sqs/domain/orderbook/plugin"
 	passthroughdomain "github.com/osmosis-labs/sqs/domain/passthrough"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
 	"github.com/osmosis-labs/sqs/log"
 	"github.com/osmosis-labs/sqs/middleware"
 	sqspassthroughdomain "github.com/osmosis-labs/sqs/sqsdomain/passthroughdomain"
 
+	routerGRPCDelivery "github.com/osmosis-labs/sqs/router/delivery/grpc"
 	routerHttpDelivery "github.com/osmosis-labs/sqs/router/delivery/http"
 	routerUseCase "github.com/osmosis-labs/sqs/router/usecase"
+	"github.com/osmosis-labs/sqs/router/usecase/poolhealth"
+	"github.com/osmosis-labs/sqs/router/usecase/poolrouting"
+	"github.com/osmosis-labs/sqs/router/usecase/slippage"
 
 	systemhttpdelivery "github.com/osmosis-labs/sqs/system/delivery/http"
 )
 
+// tokenRegistryFilesWatchInterval is the interval at which the token registry files are checked for changes.
+const tokenRegistryFilesWatchInterval = 5 * time.Second
+
 // SideCarQueryServer defines an interface for sidecar query server (SQS).
 // It encapsulates all logic for ingesting chain data into the server
 // and exposes endpoints for querying formatter and processed data from frontend.
@@ -75,10 +92,13 @@ type SideCarQueryServer interface {
 }
 
 type sideCarQueryServer struct {
-	tokensUseCase mvc.TokensUsecase
-	e             *echo.Echo
-	sqsAddress    string
-	logger        log.Logger
+	tokensUseCase       mvc.TokensUsecase
+	tokenRegistryLoader *tokensusecase.MultiSourceTokenRegistryLoader
+	poolHistoryUseCase  mvc.PoolHistoryUsecase
+	priceHistoryUseCase mvc.PriceHistoryUsecase
+	e                   *echo.Echo
+	sqsAddress          string
+	logger              log.Logger
 }
 
 // GetTokensUseCase implements SideCarQueryServer.
@@ -93,6 +113,23 @@ func (sqs *sideCarQueryServer) GetLogger() log.Logger {
 
 // Shutdown implements SideCarQueryServer.
 func (sqs *sideCarQueryServer) Shutdown(ctx context.Context) error {
+	// Persist the pool history so that it survives the restart.
+	if sqs.poolHistoryUseCase != nil {
+		if err := sqs.poolHistoryUseCase.StorePoolHistory(); err != nil {
+			sqs.logger.Error("failed to store pool history", zap.Error(err))
+		}
+	}
+
+	// Persist the price history so that it survives the restart.
+	if sqs.priceHistoryUseCase != nil {
+		if err := sqs.priceHistoryUseCase.StorePriceHistory(); err != nil {
+			sqs.logger.Error("failed to store price history", zap.Error(err))
+		}
+	}
+
+	// Stop watching the token registry files.
+	sqs.tokenRegistryLoader.Close()
+
 	return sqs.e.Shutdown(ctx)
 }
 
@@ -116,30 +153,57 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 	e.Use(middleware.InstrumentMiddleware)
 	e.Use(otelecho.Middleware("sqs"), middleware.TraceWithParamsMiddleware())
 
-	routerRepository := routerrepo.New(logger)
+	// Initialize the pool routing usecase if the admin API is enabled.
+	// The runtime changes persisted by the admin API are applied to the router and pools config
+	// so that all components are initialized with the effective config.
+	var poolRoutingUseCase mvc.PoolRoutingUsecase
+	if adminConfig := config.Admin; adminConfig != nil && adminConfig.Enabled {
+		var err error
+		poolRoutingUseCase, err = poolrouting.New(domain.NewPoolRoutingConfig(*config.Router, *config.Pools), adminConfig.OverlayFile, logger)
+		if err != nil {
+			return nil, err
+		}
 
-	// Compute token metadata from chain denom.
-	tokenMetadataByChainDenom, _, err := tokensusecase.GetTokensFromChainRegistry(config.ChainRegistryAssetsFileURL)
-	if err != nil {
-		return nil, err
+		routerConfig, poolsConfig := *config.Router, *config.Pools
+		poolRoutingUseCase.GetPoolRoutingConfig().ApplyTo(&routerConfig, &poolsConfig)
+		config.Router, config.Pools = &routerConfig, &poolsConfig
 	}
 
+	routerRepository := routerrepo.New(logger)
+
 	// Initialized tokens usecase
 	// TODO: Make the max number of tokens configurable
 	tokensUseCase := tokensusecase.NewTokensUsecase(
-		tokenMetadataByChainDenom,
+		map[string]domain.Token{},
 		config.UpdateAssetsHeightInterval,
 		logger,
 	)
 
-	// Initialize chain registry HTTP fetcher
-	chainRegistryHTTPFetcher := tokensusecase.NewChainRegistryHTTPFetcher(
-		config.ChainRegistryAssetsFileURL,
-		tokensusecase.GetTokensFromChainRegistry,
-		tokensUseCase.LoadTokens,
-	)
+	// Initialize the token registry sources in ascending order of precedence.
+	tokenRegistrySources := []domain.TokenRegistrySource{}
+	if config.ChainRegistryAssetsFileURL != "" {
+		tokenRegistrySources = append(tokenRegistrySources, tokensusecase.NewChainRegistrySource(config.ChainRegistryAssetsFileURL, tokensusecase.GetTokensFromChainRegistry))
+	}
+
+	tokenRegistryOverrides := []domain.TokenRegistryOverride{}
+	if config.TokenRegistry != nil {
+		for _, file := range config.TokenRegistry.Files {
+			tokenRegistrySources = append(tokenRegistrySources, tokensusecase.NewFileSource(file))
+		}
+		tokenRegistryOverrides = config.TokenRegistry.Overrides
+	}
+
+	tokenRegistryLoader := tokensusecase.NewMultiSourceTokenRegistryLoader(tokenRegistrySources, tokenRegistryOverrides, tokensUseCase.LoadTokens, logger)
+
+	// Compute token metadata from chain denom.
+	if err := tokenRegistryLoader.FetchAndUpdateTokens(); err != nil {
+		return nil, err
+	}
+
+	// Reload the token registry files on change.
+	tokenRegistryLoader.WatchFiles(tokenRegistryFilesWatchInterval)
 
-	tokensUseCase.SetTokenRegistryLoader(chainRegistryHTTPFetcher)
+	tokensUseCase.SetTokenRegistryLoader(tokenRegistryLoader)
 
 	// Check the status of the grpc gateway
 	if err := checkGRPCGatewayStatus(config.ChainGRPCGatewayEndpoint); err != nil {
@@ -147,7 +211,7 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 	}
 
 	// Initialize pools repository, usecase and HTTP handler
-	poolsUseCase, err := poolsUseCase.NewPoolsUsecase(
+	poolsUseCase, err := poolsusecase.NewPoolsUsecase(
 		config.Pools,
 		config.ChainGRPCGatewayEndpoint,
 		routerRepository,
@@ -159,6 +223,11 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		return nil, err
 	}
 
+	// Update the code IDs of the CosmWasm pools first since the pools are validated against them when sorted.
+	if poolRoutingUseCase != nil {
+		poolRoutingUseCase.RegisterListener(poolsUseCase)
+	}
+
 	// Initialize candidate route searcher
 	candidateRouteSearcher := routerUseCase.NewCandidateRouteFinder(routerRepository, logger)
 
@@ -169,6 +238,12 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 	chainInfoRepository := chaininforepo.New()
 	chainInfoUseCase := chaininfousecase.NewChainInfoUsecase(chainInfoRepository)
 
+	// Initialize state snapshot use case.
+	// Requests first wait for their minHeight, if any, and then pin the latest snapshot.
+	stateSnapshotUseCase := ingestusecase.NewStateSnapshotUsecase(poolsUseCase, routerUsecase, routerRepository, tokensUseCase, logger)
+	e.Use(middleware.MinHeightMiddleware(stateSnapshotUseCase, time.Duration(config.MinHeightMaxWaitMs)*time.Millisecond))
+	e.Use(middleware.StateSnapshotMiddleware(stateSnapshotUseCase, poolsHttpDelivery.PoolChangesRoute))
+
 	cosmWasmPoolConfig := poolsUseCase.GetCosmWasmPoolConfig()
 
 	// Initialize chain pricing strategy
@@ -178,6 +253,16 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		return nil, err
 	}
 
+	// Track the pool health and quarantine the misbehaving pools if enabled.
+	// The tracker is shared by the routers so that the pools quarantined while pricing are excluded from the quotes and vice versa.
+	var poolHealthTracker mvc.PoolHealthTracker
+	if poolHealthConfig := config.PoolHealth; poolHealthConfig != nil && poolHealthConfig.Enabled {
+		poolHealthTracker = poolhealth.New(*poolHealthConfig, logger)
+
+		routerUsecase.SetPoolHealthTracker(poolHealthTracker)
+		pricingSimpleRouterUsecase.SetPoolHealthTracker(poolHealthTracker)
+	}
+
 	// Get the default quote denom
 	defaultQuoteDenom, err := tokensUseCase.GetChainDenom(config.Pricing.DefaultQuoteHumanDenom)
 	if err != nil {
@@ -193,6 +278,9 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		return nil, err
 	}
 
+	// Concentrated liquidity positions are fetched from the chain to be valued by the share pricing source.
+	poolsUseCase.RegisterConcentratedPositionFetcher(passthroughGRPCClient)
+
 	// Initialize passthrough query use case
 	passthroughUseCase := passthroughUseCase.NewPassThroughUsecase(passthroughGRPCClient, poolsUseCase, tokensUseCase, liquidityPricer, defaultQuoteDenom, logger)
 	if err != nil {
@@ -207,22 +295,92 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		return nil, err
 	}
 
+	// Initialize the store of the chain prices recorded at ingest, shared by the TWAP pricing strategy
+	// and the slippage recommender. It retains the prices covering the longest of their windows.
+	priceObservationWindowBlocks := config.Pricing.TWAPWindowBlocks
+	if recommendedSlippageConfig := config.Router.RecommendedSlippage; recommendedSlippageConfig != nil && recommendedSlippageConfig.Enabled && recommendedSlippageConfig.WindowBlocks > priceObservationWindowBlocks {
+		priceObservationWindowBlocks = recommendedSlippageConfig.WindowBlocks
+	}
+	priceObservationStore := priceobservation.New(priceObservationWindowBlocks)
+
+	// Initialize TWAP pricing strategy that averages the chain prices recorded at ingest.
+	twapPricingSource := twappricing.New(config.Pricing.TWAPWindowBlocks, priceObservationStore)
+
 	// Register pricing strategy on the tokens use case.
 	tokensUseCase.RegisterPricingStrategy(domain.ChainPricingSourceType, chainPricingSource)
 	tokensUseCase.RegisterPricingStrategy(domain.CoinGeckoPricingSourceType, coingeckoPricingSource)
+	tokensUseCase.RegisterPricingStrategy(domain.TWAPPricingSourceType, twapPricingSource)
+
+	// Initialize share pricing strategy that prices the pool share denoms from the chain prices of the pool assets.
+	sharePricingSource := sharepricing.New(poolsUseCase, tokensUseCase, liquidityPricer, chainPricingSource, defaultQuoteDenom)
+	tokensUseCase.RegisterPricingStrategy(domain.SharePricingSourceType, sharePricingSource)
+
+	// Initialize aggregated pricing strategy over the configured pricing sources.
+	aggregatedPricingSource, err := aggregatedpricing.New(config.Pricing.Aggregation, map[domain.PricingSourceType]domain.PricingSource{
+		domain.ChainPricingSourceType:     chainPricingSource,
+		domain.CoinGeckoPricingSourceType: coingeckoPricingSource,
+		domain.TWAPPricingSourceType:      twapPricingSource,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	tokensUseCase.RegisterPricingStrategy(domain.AggregatedPricingSourceType, aggregatedPricingSource)
 
 	wasmQueryClient := wasmtypes.NewQueryClient(passthroughGRPCClient.GetChainGRPCClient())
 	orderBookAPIClient := orderbookgrpcclientdomain.New(wasmQueryClient)
 	orderBookRepository := orderbookrepository.New()
 	orderBookUseCase := orderbookusecase.New(orderBookRepository, orderBookAPIClient, poolsUseCase, tokensUseCase, logger)
 
+	// Initialize the pool history if enabled.
+	var poolHistoryUseCase mvc.PoolHistoryUsecase
+	if poolHistoryConfig := config.PoolHistory; poolHistoryConfig != nil && poolHistoryConfig.Enabled {
+		poolHistoryUseCase, err = poolsusecase.NewPoolHistoryUsecase(*poolHistoryConfig, poolsUseCase, stateSnapshotUseCase, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize the price history if enabled.
+	var priceHistoryUseCase mvc.PriceHistoryUsecase
+	if priceHistoryConfig := config.PriceHistory; priceHistoryConfig != nil && priceHistoryConfig.Enabled {
+		priceHistoryUseCase, err = tokensusecase.NewPriceHistoryUsecase(*priceHistoryConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize the slippage recommender if enabled.
+	var slippageRecommender domain.SlippageRecommender
+	if recommendedSlippageConfig := config.Router.RecommendedSlippage; recommendedSlippageConfig != nil && recommendedSlippageConfig.Enabled {
+		slippageRecommender = slippage.New(*recommendedSlippageConfig, defaultQuoteDenom, priceObservationStore)
+	}
+
+	// Initialize the pool change stream if enabled.
+	var poolChangeStreamUseCase mvc.PoolChangeStreamUsecase
+	if poolChangeStreamConfig := config.PoolChangeStream; poolChangeStreamConfig != nil && poolChangeStreamConfig.Enabled {
+		poolChangeStreamUseCase = poolsusecase.NewPoolChangeStreamUsecase(*poolChangeStreamConfig, poolsUseCase, stateSnapshotUseCase, logger)
+	}
+
 	// HTTP handlers
 	poolsHttpDelivery.NewPoolsHandler(e, poolsUseCase)
+	if poolHistoryUseCase != nil {
+		poolsHttpDelivery.NewPoolHistoryHandler(e, poolHistoryUseCase)
+	}
+	if poolChangeStreamUseCase != nil {
+		poolsHttpDelivery.NewPoolChangesHandler(e, poolChangeStreamUseCase, logger)
+	}
 	passthroughHttpDelivery.NewPassthroughHandler(e, passthroughUseCase, orderBookUseCase, logger)
-	systemhttpdelivery.NewSystemHandler(e, config, logger, chainInfoUseCase)
 	if err := tokenshttpdelivery.NewTokensHandler(e, *config.Pricing, tokensUseCase, pricingSimpleRouterUsecase, logger); err != nil {
 		return nil, err
 	}
+	if priceHistoryUseCase != nil {
+		if err := tokenshttpdelivery.NewPriceHistoryHandler(e, *config.Pricing, priceHistoryUseCase, tokensUseCase); err != nil {
+			return nil, err
+		}
+	}
+	// The price change is only available if the price history is enabled.
+	tokensUseCase.SetTokenMarketsSources(poolsUseCase, priceHistoryUseCase, defaultQuoteDenom)
+	tokenshttpdelivery.NewTokensMarketsHandler(e, tokensUseCase)
 
 	grpcClient := passthroughGRPCClient.GetChainGRPCClient()
 	gasCalculator := tx.NewMsgSimulator(grpcClient, tx.CalculateGas, routerRepository)
@@ -232,7 +390,33 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		types.NewQueryClient(grpcClient),
 		config.ChainID,
 	)
-	routerHttpDelivery.NewRouterHandler(e, routerUsecase, tokensUseCase, quoteSimulator, logger)
+	routerHttpDelivery.NewRouterHandler(e, routerUsecase, tokensUseCase, quoteSimulator, slippageRecommender, logger)
+	if poolHealthTracker != nil {
+		routerHttpDelivery.NewPoolHealthHandler(e, poolHealthTracker)
+	}
+
+	// Start grpc query server if enabled
+	if grpcQueryConfig := config.GRPCQuery; grpcQueryConfig != nil && grpcQueryConfig.Enabled {
+		grpcQueryServer := grpcserver.NewQueryServer(*grpcQueryConfig, stateSnapshotUseCase)
+
+		poolsGRPCDelivery.NewPoolsGRPCHandler(grpcQueryServer, poolsUseCase)
+		routerGRPCDelivery.NewRouterGRPCHandler(grpcQueryServer, routerUsecase, tokensUseCase, logger)
+		if err := tokensgrpcdelivery.NewTokensGRPCHandler(grpcQueryServer, *config.Pricing, tokensUseCase, logger); err != nil {
+			return nil, err
+		}
+
+		go func() {
+			logger.Info("Starting grpc query server")
+
+			lis, err := net.Listen("tcp", grpcQueryConfig.ServerAddress)
+			if err != nil {
+				panic(err)
+			}
+			if err := grpcQueryServer.Serve(lis); err != nil {
+				panic(err)
+			}
+		}()
+	}
 
 	// Create a Numia HTTP client
 	passthroughConfig := config.Passthrough
@@ -254,16 +438,20 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 
 	// Start grpc ingest server if enabled
 	grpcIngesterConfig := config.GRPCIngester
+	var ingestUseCase mvc.IngestUsecase
 	if grpcIngesterConfig.Enabled {
 		quotePriceUpdateWorker := pricingWorker.New(tokensUseCase, defaultQuoteDenom, config.Pricing.WorkerMinPoolLiquidityCap, logger)
 
 		poolLiquidityComputeWorker := pricingWorker.NewPoolLiquidityWorker(tokensUseCase, poolsUseCase, liquidityPricer, logger)
 
-		candidateRouteSearchDataWorker := routerWorker.NewCandidateRouteSearchDataWorker(poolsUseCase, routerRepository, config.Router.PreferredPoolIDs, cosmWasmPoolConfig, logger)
+		candidateRouteSearchDataWorker := routerWorker.NewCandidateRouteSearchDataWorker(poolsUseCase, routerRepository, config.Router.PreferredPoolIDs, config.Router.DeniedPoolIDs, cosmWasmPoolConfig, logger)
 
 		// Register chain info use case (healthcheck) as a listener to the candidate route search data worker.
 		candidateRouteSearchDataWorker.RegisterListener(chainInfoUseCase)
 
+		// Publish the state snapshot once the search data, the last state updated within a block, is computed.
+		candidateRouteSearchDataWorker.RegisterListener(stateSnapshotUseCase)
+
 		// chain info use case acts as the healthcheck. It receives updates from the pricing worker.
 		// It then passes the healthcheck as long as updates are received at the appropriate intervals.
 		quotePriceUpdateWorker.RegisterListener(chainInfoUseCase)
@@ -271,8 +459,44 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		// pool liquidity compute worker listens to the quote price update worker.
 		quotePriceUpdateWorker.RegisterListener(poolLiquidityComputeWorker)
 
+		// The price observation store records the computed prices for the TWAP pricing source and the slippage recommender.
+		quotePriceUpdateWorker.RegisterListener(priceObservationStore)
+
+		// price history folds the computed prices into candles.
+		if priceHistoryUseCase != nil {
+			quotePriceUpdateWorker.RegisterListener(priceHistoryUseCase)
+		}
+
+		// The prices and the pool denom metadata of the other quotes are pre-computed by their own workers.
+		// The quotes missing from the asset list are skipped rather than failing the startup.
+		quoteDenoms, unresolvedQuoteHumanDenoms, err := config.Pricing.GetQuoteChainDenoms(tokensUseCase.GetChainDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, quoteHumanDenom := range unresolvedQuoteHumanDenoms {
+			logger.Warn("skipping quote human denom missing from the asset list", zap.String("quote_human_denom", quoteHumanDenom))
+		}
+
+		otherQuotePriceUpdateWorkers := []domain.PricingWorker{}
+		for _, quoteDenom := range quoteDenoms {
+			if quoteDenom == defaultQuoteDenom {
+				continue
+			}
+
+			otherQuotePriceUpdateWorker := pricingWorker.New(tokensUseCase, quoteDenom, config.Pricing.WorkerMinPoolLiquidityCap, logger)
+
+			otherQuotePriceUpdateWorker.RegisterListener(pricingWorker.NewQuotePoolDenomMetadataWorker(tokensUseCase, liquidityPricer, logger))
+			otherQuotePriceUpdateWorker.RegisterListener(priceObservationStore)
+			if priceHistoryUseCase != nil {
+				otherQuotePriceUpdateWorker.RegisterListener(priceHistoryUseCase)
+			}
+
+			otherQuotePriceUpdateWorkers = append(otherQuotePriceUpdateWorkers, otherQuotePriceUpdateWorker)
+		}
+
 		// Initialize ingest handler and usecase
-		ingestUseCase, err := ingestusecase.NewIngestUsecase(
+		ingestUseCase, err = ingestusecase.NewIngestUsecase(
 			poolsUseCase,
 			routerUsecase,
 			pricingSimpleRouterUsecase,
@@ -280,6 +504,7 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 			chainInfoUseCase,
 			appCodec,
 			quotePriceUpdateWorker,
+			otherQuotePriceUpdateWorkers,
 			candidateRouteSearchDataWorker,
 			orderBookUseCase,
 			logger,
@@ -289,6 +514,12 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 			return nil, err
 		}
 
+		// Re-sort the pools and recompute the search data with the runtime changes to the pool routing config.
+		if poolRoutingUseCase != nil {
+			poolRoutingUseCase.RegisterListener(candidateRouteSearchDataWorker)
+			poolRoutingUseCase.RegisterListener(ingestUseCase)
+		}
+
 		// Iterate over the plugin configurations and register the enabled plugins.
 		for _, plugin := range grpcIngesterConfig.Plugins {
 			if plugin.IsEnabled() {
@@ -335,6 +566,27 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		baseFeeFetcherPlugin := basefee.NewEndBlockUpdatePlugin(routerRepository, txfeestypes.NewQueryClient(grpcClient), logger)
 		ingestUseCase.RegisterEndBlockProcessPlugin(baseFeeFetcherPlugin)
 
+		// Resolve the pool denoms that are not in the token registry from the chain if enabled.
+		if denomDiscoveryConfig := config.DenomDiscovery; denomDiscoveryConfig != nil && denomDiscoveryConfig.Enabled {
+			denomDiscoveryPlugin := denomdiscovery.NewEndBlockDenomDiscoveryPlugin(tokensUseCase, transfertypes.NewQueryClient(grpcClient), banktypes.NewQueryClient(grpcClient), *denomDiscoveryConfig, logger)
+			ingestUseCase.RegisterEndBlockProcessPlugin(denomDiscoveryPlugin)
+		}
+
+		// Sample the pool history at the end of the blocks if enabled.
+		if poolHistoryUseCase != nil {
+			ingestUseCase.RegisterEndBlockProcessPlugin(poolHistoryUseCase)
+		}
+
+		// Push the pool changes to the subscribers at the end of the blocks if enabled.
+		if poolChangeStreamUseCase != nil {
+			ingestUseCase.RegisterEndBlockProcessPlugin(poolChangeStreamUseCase)
+		}
+
+		// Release the quarantined pools at the end of the blocks if enabled.
+		if poolHealthTracker != nil {
+			ingestUseCase.RegisterEndBlockProcessPlugin(poolHealthTracker)
+		}
+
 		// Register chain info use case as a listener to the pool liquidity compute worker (healthcheck).
 		poolLiquidityComputeWorker.RegisterListener(chainInfoUseCase)
 
@@ -356,6 +608,27 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 		}()
 	}
 
+	// Flush the route caches once the search data is recomputed with the runtime changes to the pool routing config
+	// and expose the admin endpoints.
+	if poolRoutingUseCase != nil {
+		poolRoutingUseCase.RegisterListener(routerUsecase)
+		poolRoutingUseCase.RegisterListener(pricingSimpleRouterUsecase)
+
+		adminGroup := e.Group("/admin", middleware.AdminAuthMiddleware(config.Admin.APIKey))
+		routerHttpDelivery.NewPoolRoutingHandler(adminGroup, poolRoutingUseCase)
+
+		// The pool health can only be overridden by the admin.
+		if poolHealthTracker != nil {
+			routerHttpDelivery.NewPoolHealthAdminHandler(adminGroup, poolHealthTracker)
+		}
+
+		tokenshttpdelivery.NewTokenRegistryAdminHandler(adminGroup, tokenRegistryLoader)
+	}
+
+	// Initialize system handler after the ingest use case
+	// so that the healthcheck can report the ingest sources status.
+	systemhttpdelivery.NewSystemHandler(e, config, logger, chainInfoUseCase, ingestUseCase)
+
 	go func() {
 		logger.Info("Starting profiling server")
 		err = http.ListenAndServe("localhost:6062", nil)
@@ -365,10 +638,13 @@ func NewSideCarQueryServer(appCodec codec.Codec, config domain.Config, logger lo
 	}()
 
 	return &sideCarQueryServer{
-		tokensUseCase: tokensUseCase,
-		logger:        logger,
-		e:             e,
-		sqsAddress:    config.ServerAddress,
+		tokensUseCase:       tokensUseCase,
+		tokenRegistryLoader: tokenRegistryLoader,
+		poolHistoryUseCase:  poolHistoryUseCase,
+		priceHistoryUseCase: priceHistoryUseCase,
+		logger:              logger,
+		e:                   e,
+		sqsAddress:          config.ServerAddress,
 	}, nil
 }
 
@@ -18,6 +18,7 @@ var DefaultConfig = domain.Config{
 	ChainID:                    "osmosis-1",
 	ChainRegistryAssetsFileURL: "https://raw.githubusercontent.com/osmosis-labs/assetlists/main/osmosis-1/generated/frontend/assetlist.json",
 	UpdateAssetsHeightInterval: 200,
+	MinHeightMaxWaitMs:         2000,
 
 	Router: &domain.RouterConfig{
 		PreferredPoolIDs:                 []uint64{},
@@ -39,12 +40,19 @@ var DefaultConfig = domain.Config{
 		DefaultSource:          domain.ChainPricingSourceType,
 		CacheExpiryMs:          2000, // 2 seconds.
 		DefaultQuoteHumanDenom: "usdc",
+		QuoteHumanDenoms:       []string{"usdc"},
 
 		MaxPoolsPerRoute:       4,
 		MaxRoutes:              5,
 		MinPoolLiquidityCap:    50,
 		CoingeckoUrl:           "https://prices.osmosis.zone/api/v3/simple/price",
 		CoingeckoQuoteCurrency: "usd",
+		TWAPWindowBlocks:       300,
+		Aggregation: domain.PricingAggregationConfig{
+			Sources:             []domain.PricingSourceType{domain.ChainPricingSourceType, domain.CoinGeckoPricingSourceType},
+			Method:              domain.PricingAggregationMethodMedian,
+			DivergenceThreshold: 0.05,
+		},
 	},
 
 	Passthrough: &passthroughdomain.PassthroughConfig{
@@ -0,0 +1,80 @@
+// Package server provides the GRPC server serving the public query services.
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	deliverygrpc "github.com/osmosis-labs/sqs/delivery/grpc"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
+
+// NewQueryServer returns a new GRPC server for the public query services.
+// Every unary request is served from the latest state snapshot, pinned
+// for the whole request the same way the HTTP middleware does.
+// The responses are encoded with the gogoproto codec so that the math custom types are marshaled correctly.
+func NewQueryServer(config domain.GRPCQueryConfig, stateSnapshotUsecase mvc.StateSnapshotUsecase) *grpc.Server {
+	return grpc.NewServer(
+		grpc.ForceServerCodec(deliverygrpc.OsmomathCodec{}),
+		grpc.MaxRecvMsgSize(config.MaxReceiveMsgSizeBytes),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			RecoveryUnaryInterceptor,
+			StateSnapshotUnaryInterceptor(stateSnapshotUsecase),
+		),
+	)
+}
+
+// RecoveryUnaryInterceptor converts a panic in the handler into an internal error
+// so that a single faulty request does not bring the server down.
+func RecoveryUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// StateSnapshotUnaryInterceptor pins the latest state snapshot into the request context
+// and reports its height in the header metadata of the response.
+func StateSnapshotUnaryInterceptor(stateSnapshotUsecase mvc.StateSnapshotUsecase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		snapshot, ok := stateSnapshotUsecase.GetLatestStateSnapshot()
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// Failing to send the header must not fail the request.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(domain.HeightHeader), strconv.FormatUint(snapshot.Height(), 10)))
+
+		return handler(domain.ContextWithStateSnapshot(ctx, snapshot), req)
+	}
+}
+
+// StatusError converts the given use case error into a GRPC status error.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, domain.ErrNotFound) || errors.As(err, &domain.PoolNotFoundError{}) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if errors.As(err, &domain.InvalidPoolsFilterExpressionError{}) || errors.As(err, &domain.UnsupportedPoolsRangeFilterFieldError{}) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -79,12 +79,19 @@ func (ev *Event) MarshalTo(w io.Writer) error {
 
 // WriteEvent writes the given data to the given ResponseWriter as an Event.
 func WriteEvent(w *echo.Response, data any) error {
+	return WriteEventWithID(w, "", data)
+}
+
+// WriteEventWithID writes the given data to the given ResponseWriter as an Event with the given ID.
+// Clients reconnecting to the stream send the ID of the last received event in the Last-Event-ID header.
+func WriteEventWithID(w *echo.Response, id string, data any) error {
 	b, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 
 	event := Event{
+		ID:   []byte(id),
 		Data: b,
 	}
 
@@ -96,3 +103,19 @@ func WriteEvent(w *echo.Response, data any) error {
 
 	return nil
 }
+
+// WriteComment writes the given comment to the given ResponseWriter.
+// Comments are ignored by the clients and can be used to keep the connection alive.
+func WriteComment(w *echo.Response, comment string) error {
+	event := Event{
+		Comment: []byte(comment),
+	}
+
+	if err := event.MarshalTo(w); err != nil {
+		return err
+	}
+
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "errors"
+
+// AdminConfig is the config of the authenticated admin API used to
+// reconfigure the server at runtime.
+type AdminConfig struct {
+	// Enabled defines whether the admin endpoints are registered.
+	Enabled bool `mapstructure:"enabled"`
+
+	// APIKey is the key expected in the "Authorization: Bearer <key>" header of the admin requests.
+	// Omitted from JSON so that it is never exposed via the /config endpoint.
+	APIKey string `mapstructure:"api-key" json:"-"`
+
+	// OverlayFile is the file the runtime changes to the pool routing config are persisted to
+	// and restored from on startup. If empty, the changes are lost on restart.
+	OverlayFile string `mapstructure:"overlay-file"`
+}
+
+var (
+	ErrAdminAPIKeyNotSet = errors.New("admin api is enabled but api-key is not set")
+)
+
+// Validate validates the admin config.
+// Returns an error if the config is invalid. Nil is returned if the config is valid.
+func (c AdminConfig) Validate() error {
+	if c.Enabled && c.APIKey == "" {
+		return ErrAdminAPIKeyNotSet
+	}
+
+	return nil
+}
@@ -75,6 +75,14 @@ func (c *Cache) Delete(key string) {
 	delete(c.data, key)
 }
 
+// Clear removes all items from the cache.
+func (c *Cache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.data = make(map[string]CacheItem)
+}
+
 // Len returns the number of entries in the cache
 func (c *Cache) Len() int {
 	c.mutex.RLock()
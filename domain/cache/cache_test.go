@@ -179,3 +179,26 @@ func TestCache_SetExpiration(t *testing.T) {
 		})
 	}
 }
+
+func TestCache_Clear(t *testing.T) {
+	c := cache.New()
+
+	c.Set("key1", "value1", cache.NoExpiration)
+	c.Set("key2", "value2", time.Minute)
+
+	c.Clear()
+
+	if c.Len() != 0 {
+		t.Errorf("Expected cache to be empty, got: %d entries", c.Len())
+	}
+
+	if _, exists := c.Get("key1"); exists {
+		t.Errorf("Expected key key1 to be cleared")
+	}
+
+	// The cache remains usable after being cleared.
+	c.Set("key1", "value3", cache.NoExpiration)
+	if value, exists := c.Get("key1"); !exists || value != "value3" {
+		t.Errorf("Expected value for key key1: value3, got: %v", value)
+	}
+}
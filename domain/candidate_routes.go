@@ -26,6 +26,10 @@ type CandidateRouteSearchOptions struct {
 	// If at least one of the callbacks in-slice returns true, the ShouldSkipPool function will
 	// also return true.
 	PoolFiltersAnyOf []CandidateRoutePoolFiltrerCb
+
+	// SearchData is the source of the candidate route search data.
+	// If nil, the search data is read from the live holder.
+	SearchData CandidateRouteDenomDataGetter
 }
 
 // ShouldSkipPool returns true if the candidate route algorithm should skip
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DefaultDepthChartPercents are the default percentages from the current price
+// the cumulative depth of a concentrated pool is computed for.
+var DefaultDepthChartPercents = []osmomath.Dec{
+	osmomath.MustNewDecFromStr("1"),
+	osmomath.MustNewDecFromStr("2"),
+	osmomath.MustNewDecFromStr("5"),
+	osmomath.MustNewDecFromStr("10"),
+}
+
+// ConcentratedDepthChart represents the liquidity of a concentrated pool
+// converted from the tick model into human prices and token amounts.
+// All prices are the prices of token0 quoted in token1, scaled by the token precisions.
+// All amounts are scaled by the precision of the respective token.
+type ConcentratedDepthChart struct {
+	PoolID uint64 `json:"pool_id"`
+	Token0 string `json:"token0"`
+	Token1 string `json:"token1"`
+	// CurrentTick is the current tick of the pool.
+	CurrentTick int64 `json:"current_tick"`
+	// CurrentPrice is the current price of the pool.
+	CurrentPrice osmomath.Dec `json:"current_price"`
+	// Buckets are the ranges of constant liquidity of the pool, sorted by price in ascending order.
+	Buckets []ConcentratedDepthBucket `json:"buckets"`
+	// CumulativeDepths are the cumulative depths within the requested percentages of the current price.
+	CumulativeDepths []ConcentratedCumulativeDepth `json:"cumulative_depths"`
+}
+
+// ConcentratedDepthBucket is a range of constant liquidity of a concentrated pool.
+// At the current price, the buckets above the current tick hold only token0 and the buckets
+// below it hold only token1. The bucket containing the current tick holds both.
+type ConcentratedDepthBucket struct {
+	LowerTick  int64        `json:"lower_tick"`
+	UpperTick  int64        `json:"upper_tick"`
+	LowerPrice osmomath.Dec `json:"lower_price"`
+	UpperPrice osmomath.Dec `json:"upper_price"`
+	// Amount0 is the amount of token0 in the bucket, available to buy with token1.
+	Amount0 osmomath.Dec `json:"amount0"`
+	// Amount1 is the amount of token1 in the bucket, available to buy with token0.
+	Amount1 osmomath.Dec `json:"amount1"`
+}
+
+// ConcentratedCumulativeDepth is the liquidity of a concentrated pool
+// available within a given percentage of the current price.
+type ConcentratedCumulativeDepth struct {
+	// Percent is the percentage from the current price, e.g. 2 for ±2%.
+	Percent    osmomath.Dec `json:"percent"`
+	LowerPrice osmomath.Dec `json:"lower_price"`
+	UpperPrice osmomath.Dec `json:"upper_price"`
+	// Amount0 is the amount of token0 available between the current price and the upper price.
+	// This is the amount of token0 that can be bought with token1 before moving the price up by the percentage.
+	Amount0 osmomath.Dec `json:"amount0"`
+	// Amount1 is the amount of token1 available between the lower price and the current price.
+	// This is the amount of token1 that can be bought with token0 before moving the price down by the percentage.
+	Amount1 osmomath.Dec `json:"amount1"`
+}
+
+// ValidateDepthChartPercents validates that all the given percentages are within (0, 100).
+func ValidateDepthChartPercents(percents []osmomath.Dec) error {
+	hundred := osmomath.NewDec(100)
+	for _, percent := range percents {
+		if !percent.IsPositive() || percent.GTE(hundred) {
+			return fmt.Errorf("depth percent (%s) must be greater than 0 and less than 100", percent)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+)
+
+// ConcentratedPositionPrefix is the prefix of the pseudo-denoms identifying concentrated liquidity positions
+// for pricing, e.g. cl/position/{position ID}. The price of such a denom is the value of the whole position.
+const ConcentratedPositionPrefix = "cl/position"
+
+// ConcentratedPositionFetcher fetches the concentrated liquidity positions from the chain.
+type ConcentratedPositionFetcher interface {
+	// PositionByID returns the concentrated liquidity position with the given ID.
+	PositionByID(ctx context.Context, positionID uint64) (concentratedmodel.Position, error)
+}
+
+// ConcentratedPositionSimulation is the result of simulating a concentrated liquidity position
+// created from a price range and the amount of one of the pool tokens.
+// All prices are the chain prices of token0 quoted in token1 and all amounts are chain amounts.
+type ConcentratedPositionSimulation struct {
+	PoolID uint64 `json:"pool_id"`
+	// LowerTick and UpperTick are the ticks of the position rounded to the tick spacing of the pool.
+	LowerTick int64 `json:"lower_tick"`
+	UpperTick int64 `json:"upper_tick"`
+	// LowerPrice and UpperPrice are the prices corresponding to the lower and upper ticks.
+	LowerPrice osmomath.BigDec `json:"lower_price"`
+	UpperPrice osmomath.BigDec `json:"upper_price"`
+	// Amount0 and Amount1 are the amounts of token0 and token1 required to create the position.
+	// One of them is the given amount while the other is computed from it.
+	Amount0 osmomath.Int `json:"amount0"`
+	Amount1 osmomath.Int `json:"amount1"`
+	// Liquidity is the liquidity units of the position.
+	Liquidity osmomath.Dec `json:"liquidity"`
+	// IsActive is true if the current tick of the pool is within the position range.
+	IsActive bool `json:"is_active"`
+	// ActiveLiquidityShare is the share of the active liquidity the position would hold.
+	// Zero if the position is not active.
+	ActiveLiquidityShare osmomath.Dec `json:"active_liquidity_share"`
+	// FeeAPR is the estimated fee APR of the position in percent.
+	// It assumes the fees of the last 7 days continue at the same rate and the current price does not move.
+	FeeAPR osmomath.Dec `json:"fee_apr"`
+	// FeeAPRError is set if the fee APR could not be estimated, e.g. due to missing fees data.
+	FeeAPRError string `json:"fee_apr_error,omitempty"`
+}
@@ -33,11 +33,19 @@ type Config struct {
 	ChainID                    string `mapstructure:"chain-id"`
 
 	// Chain registry assets URL.
+	// If empty, the tokens are only loaded from the token registry files and overrides.
 	ChainRegistryAssetsFileURL string `mapstructure:"chain-registry-assets-url"`
 
+	// TokenRegistry configures the token registry sources in addition to the chain registry assets URL.
+	TokenRegistry *TokenRegistryConfig `mapstructure:"token-registry"`
+
 	// Defines the block interval at which the assets are updated.
 	UpdateAssetsHeightInterval int `mapstructure:"update-assets-height-interval"`
 
+	// Defines the maximum time in milliseconds a request with the minHeight parameter
+	// waits for the height to be ingested before failing with 503.
+	MinHeightMaxWaitMs int `mapstructure:"min-height-max-wait-ms"`
+
 	FlightRecord *FlightRecordConfig `mapstructure:"flight-record"`
 
 	// Router encapsulates the router config.
@@ -54,11 +62,32 @@ type Config struct {
 	// GRPC ingester server configuration.
 	GRPCIngester *GRPCIngesterConfig `mapstructure:"grpc-ingester"`
 
+	// GRPC query server configuration.
+	GRPCQuery *GRPCQueryConfig `mapstructure:"grpc-query"`
+
 	// OpenTelemetry configuration.
 	OTEL *OTELConfig `mapstructure:"otel"`
 
 	// SideCarQueryServer CORS configuration.
 	CORS *CORSConfig `mapstructure:"cors"`
+
+	// Pool history configuration.
+	PoolHistory *PoolHistoryConfig `mapstructure:"pool-history"`
+
+	// Pool change stream configuration.
+	PoolChangeStream *PoolChangeStreamConfig `mapstructure:"pool-change-stream"`
+
+	// Price history configuration.
+	PriceHistory *PriceHistoryConfig `mapstructure:"price-history"`
+
+	// Pool health configuration.
+	PoolHealth *PoolHealthConfig `mapstructure:"pool-health"`
+
+	// Admin API configuration.
+	Admin *AdminConfig `mapstructure:"admin"`
+
+	// Denom discovery configuration.
+	DenomDiscovery *DenomDiscoveryConfig `mapstructure:"denom-discovery"`
 }
 
 const envPrefix = "SQS"
@@ -74,6 +103,11 @@ var (
 		ChainID:                    "osmosis-1",
 		ChainRegistryAssetsFileURL: "https://raw.githubusercontent.com/osmosis-labs/assetlists/main/osmosis-1/generated/frontend/assetlist.json",
 		UpdateAssetsHeightInterval: 200,
+		MinHeightMaxWaitMs:         2000,
+		TokenRegistry: &TokenRegistryConfig{
+			Files:     []string{},
+			Overrides: []TokenRegistryOverride{},
+		},
 		FlightRecord: &FlightRecordConfig{
 			Enabled:          true,
 			TraceThresholdMS: 1000,
@@ -102,6 +136,7 @@ var (
 		},
 		Router: &RouterConfig{
 			PreferredPoolIDs:                 []uint64{},
+			DeniedPoolIDs:                    []uint64{},
 			MaxPoolsPerRoute:                 4,
 			MaxRoutes:                        20,
 			MaxSplitRoutes:                   3,
@@ -131,17 +166,34 @@ var (
 					FilterValue:  1,
 				},
 			},
+			RecommendedSlippage: &RecommendedSlippageConfig{
+				Enabled:               true,
+				WindowBlocks:          600,
+				HorizonBlocks:         10,
+				VolatilityMultiplier:  3,
+				PriceImpactMultiplier: 1,
+				MinSlippage:           0.001,
+				MaxSlippage:           0.05,
+			},
 		},
 		Pricing: &PricingConfig{
 			CacheExpiryMs:             2000,
 			DefaultSource:             0,
 			DefaultQuoteHumanDenom:    "usdc",
+			QuoteHumanDenoms:          []string{"usdc"},
 			MaxPoolsPerRoute:          4,
 			MaxRoutes:                 3,
 			MinPoolLiquidityCap:       1000,
 			CoingeckoUrl:              "https://prices.osmosis.zone/api/v3/simple/price",
 			CoingeckoQuoteCurrency:    "usd",
 			WorkerMinPoolLiquidityCap: 1,
+			TWAPWindowBlocks:          300,
+			Aggregation: PricingAggregationConfig{
+				Sources:             []PricingSourceType{ChainPricingSourceType, CoinGeckoPricingSourceType},
+				Method:              PricingAggregationMethodMedian,
+				Weights:             []float64{},
+				DivergenceThreshold: 0.05,
+			},
 		},
 		Passthrough: &passthroughdomain.PassthroughConfig{
 			NumiaURL:                     "https://data.app.osmosis.zone",
@@ -164,6 +216,18 @@ var (
 					Name:    orderbookplugindomain.OrderbookClaimbotPlugin,
 				},
 			},
+			TLS: GRPCIngesterTLSConfig{
+				Enabled: false,
+			},
+			Auth: GRPCIngesterAuthConfig{
+				Enabled:             false,
+				MaxClockSkewSeconds: 30,
+			},
+		},
+		GRPCQuery: &GRPCQueryConfig{
+			Enabled:                false,
+			ServerAddress:          ":50052",
+			MaxReceiveMsgSizeBytes: 4194304,
 		},
 		OTEL: &OTELConfig{
 			Enabled:     true,
@@ -174,6 +238,41 @@ var (
 			AllowedMethods: "HEAD, GET, POST, HEAD, GET, POST, DELETE, OPTIONS, PATCH, PUT",
 			AllowedOrigin:  "*",
 		},
+		PoolHistory: &PoolHistoryConfig{
+			Enabled:               false,
+			Capacity:              1440,
+			SampleIntervalBlocks:  10,
+			PersistIntervalBlocks: 100,
+			StateFile:             "pool_history.json",
+		},
+		PoolChangeStream: &PoolChangeStreamConfig{
+			Enabled:              false,
+			RetainedBlocks:       100,
+			SubscriberBufferSize: 100,
+		},
+		PriceHistory: &PriceHistoryConfig{
+			Enabled:               false,
+			MaxCandles:            300,
+			PersistIntervalBlocks: 100,
+			StateFile:             "price_history.json",
+		},
+		PoolHealth: &PoolHealthConfig{
+			Enabled:          false,
+			ErrorThreshold:   10,
+			OutlierThreshold: 3,
+			OutlierTolerance: 0.5,
+			WindowBlocks:     100,
+			QuarantineBlocks: 300,
+		},
+		Admin: &AdminConfig{
+			Enabled:     false,
+			OverlayFile: "pool_routing_overlay.json",
+		},
+		DenomDiscovery: &DenomDiscoveryConfig{
+			Enabled:           true,
+			MaxDenomsPerBlock: 100,
+			MaxAttempts:       3,
+		},
 	}
 )
 
@@ -321,6 +420,17 @@ type OTELConfig struct {
 	Environment string `mapstructure:"environment"`
 }
 
+// GRPCQueryConfig represents the configuration of the GRPC query server
+// that serves the pools, router and tokens queries next to the HTTP server.
+type GRPCQueryConfig struct {
+	// Flag to enable the GRPC query server.
+	Enabled bool `mapstructure:"enabled"`
+	// The address of the GRPC query server.
+	ServerAddress string `mapstructure:"server-address"`
+	// The maximum number of bytes to receive in a single GRPC message.
+	MaxReceiveMsgSizeBytes int `mapstructure:"max-receive-msg-size-bytes"`
+}
+
 // CORSConfig represents HTTP CORS headers configuration.
 type CORSConfig struct {
 	// Specifies Access-Control-Allow-Headers header value.
@@ -349,6 +459,80 @@ func (c Config) Validate() error {
 		return err
 	}
 
+	// Validate the recommended slippage.
+	if c.Router.RecommendedSlippage != nil {
+		if err := c.Router.RecommendedSlippage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the token registry.
+	if c.TokenRegistry != nil {
+		if err := c.TokenRegistry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.ChainRegistryAssetsFileURL == "" && (c.TokenRegistry == nil || (len(c.TokenRegistry.Files) == 0 && len(c.TokenRegistry.Overrides) == 0)) {
+		return errors.New("chain registry assets url is empty and no token registry files or overrides are configured")
+	}
+
+	// Validate the pricing.
+	if c.Pricing != nil {
+		if err := c.Pricing.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the GRPC ingester transport security and authentication.
+	if c.GRPCIngester != nil {
+		if err := c.GRPCIngester.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the pool history.
+	if c.PoolHistory != nil {
+		if err := c.PoolHistory.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the pool change stream.
+	if c.PoolChangeStream != nil {
+		if err := c.PoolChangeStream.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the price history.
+	if c.PriceHistory != nil {
+		if err := c.PriceHistory.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the pool health.
+	if c.PoolHealth != nil {
+		if err := c.PoolHealth.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the admin API.
+	if c.Admin != nil {
+		if err := c.Admin.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the denom discovery.
+	if c.DenomDiscovery != nil {
+		if err := c.DenomDiscovery.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
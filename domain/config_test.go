@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/osmosis-labs/sqs/domain"
@@ -59,3 +60,457 @@ func TestValidateDynamicMinLiquidityCapDesc(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCIngesterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  domain.GRPCIngesterConfig
+		wantErr error
+	}{
+		{
+			name:    "tls and auth disabled",
+			config:  domain.GRPCIngesterConfig{},
+			wantErr: nil,
+		},
+		{
+			name: "valid tls and auth",
+			config: domain.GRPCIngesterConfig{
+				TLS:  domain.GRPCIngesterTLSConfig{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem"},
+				Auth: domain.GRPCIngesterAuthConfig{Enabled: true, SharedSecret: "secret", MaxClockSkewSeconds: 30},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "tls enabled without key file",
+			config: domain.GRPCIngesterConfig{
+				TLS: domain.GRPCIngesterTLSConfig{Enabled: true, CertFile: "cert.pem"},
+			},
+			wantErr: domain.ErrGRPCIngesterTLSCertNotSet,
+		},
+		{
+			name: "auth enabled without secret",
+			config: domain.GRPCIngesterConfig{
+				Auth: domain.GRPCIngesterAuthConfig{Enabled: true, MaxClockSkewSeconds: 30},
+			},
+			wantErr: domain.ErrGRPCIngesterAuthSecretNotSet,
+		},
+		{
+			name: "auth enabled with zero clock skew",
+			config: domain.GRPCIngesterConfig{
+				Auth: domain.GRPCIngesterAuthConfig{Enabled: true, SharedSecret: "secret"},
+			},
+			wantErr: domain.ErrGRPCIngesterAuthClockSkewZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if err != tt.wantErr {
+				t.Errorf("GRPCIngesterConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdminConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  domain.AdminConfig
+		wantErr error
+	}{
+		{
+			name:    "disabled",
+			config:  domain.AdminConfig{},
+			wantErr: nil,
+		},
+		{
+			name:    "enabled with api key",
+			config:  domain.AdminConfig{Enabled: true, APIKey: "secret"},
+			wantErr: nil,
+		},
+		{
+			name:    "enabled without api key",
+			config:  domain.AdminConfig{Enabled: true},
+			wantErr: domain.ErrAdminAPIKeyNotSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if err != tt.wantErr {
+				t.Errorf("AdminConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPricingAggregationConfigValidate(t *testing.T) {
+	sources := []domain.PricingSourceType{domain.ChainPricingSourceType, domain.CoinGeckoPricingSourceType}
+
+	tests := []struct {
+		name    string
+		config  domain.PricingAggregationConfig
+		wantErr bool
+	}{
+		{
+			name:    "valid median",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: domain.PricingAggregationMethodMedian, DivergenceThreshold: 0.05},
+			wantErr: false,
+		},
+		{
+			name:    "valid weighted",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: domain.PricingAggregationMethodWeighted, Weights: []float64{2, 1}, DivergenceThreshold: 0.05},
+			wantErr: false,
+		},
+		{
+			name:    "single source",
+			config:  domain.PricingAggregationConfig{Sources: sources[:1], Method: domain.PricingAggregationMethodMedian, DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated source",
+			config:  domain.PricingAggregationConfig{Sources: []domain.PricingSourceType{domain.ChainPricingSourceType, domain.ChainPricingSourceType}, Method: domain.PricingAggregationMethodMedian, DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "aggregated source",
+			config:  domain.PricingAggregationConfig{Sources: []domain.PricingSourceType{domain.ChainPricingSourceType, domain.AggregatedPricingSourceType}, Method: domain.PricingAggregationMethodMedian, DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "unknown method",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: "mean", DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "weights not matching sources",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: domain.PricingAggregationMethodWeighted, Weights: []float64{1}, DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "zero weight",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: domain.PricingAggregationMethodWeighted, Weights: []float64{1, 0}, DivergenceThreshold: 0.05},
+			wantErr: true,
+		},
+		{
+			name:    "zero divergence threshold",
+			config:  domain.PricingAggregationConfig{Sources: sources, Method: domain.PricingAggregationMethodMedian},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PricingAggregationConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPricingConfigQuoteHumanDenoms(t *testing.T) {
+	aggregation := domain.PricingAggregationConfig{
+		Sources:             []domain.PricingSourceType{domain.ChainPricingSourceType, domain.CoinGeckoPricingSourceType},
+		Method:              domain.PricingAggregationMethodMedian,
+		DivergenceThreshold: 0.05,
+	}
+
+	tests := []struct {
+		name                     string
+		quoteHumanDenoms         []string
+		wantErr                  bool
+		expectedQuoteHumanDenoms []string
+	}{
+		{
+			name:                     "none",
+			quoteHumanDenoms:         nil,
+			wantErr:                  false,
+			expectedQuoteHumanDenoms: []string{"usdc"},
+		},
+		{
+			name:                     "default listed",
+			quoteHumanDenoms:         []string{"osmo", "USDC", "atom"},
+			wantErr:                  false,
+			expectedQuoteHumanDenoms: []string{"usdc", "osmo", "atom"},
+		},
+		{
+			name:             "empty",
+			quoteHumanDenoms: []string{"osmo", ""},
+			wantErr:          true,
+		},
+		{
+			name:             "duplicated",
+			quoteHumanDenoms: []string{"osmo", "OSMO"},
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := domain.PricingConfig{
+				DefaultQuoteHumanDenom: "usdc",
+				QuoteHumanDenoms:       tt.quoteHumanDenoms,
+				TWAPWindowBlocks:       300,
+				Aggregation:            aggregation,
+			}
+
+			err := config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PricingConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if quoteHumanDenoms := config.GetQuoteHumanDenoms(); !reflect.DeepEqual(quoteHumanDenoms, tt.expectedQuoteHumanDenoms) {
+				t.Errorf("PricingConfig.GetQuoteHumanDenoms() = %v, want %v", quoteHumanDenoms, tt.expectedQuoteHumanDenoms)
+			}
+		})
+	}
+}
+
+func TestPricingConfigQuoteChainDenoms(t *testing.T) {
+	chainDenoms := map[string]string{"usdc": "uusdc", "osmo": "uosmo"}
+	getChainDenom := func(humanDenom string) (string, error) {
+		chainDenom, ok := chainDenoms[humanDenom]
+		if !ok {
+			return "", fmt.Errorf("denom (%s) not found", humanDenom)
+		}
+		return chainDenom, nil
+	}
+
+	tests := []struct {
+		name                   string
+		defaultQuoteHumanDenom string
+		quoteHumanDenoms       []string
+		wantErr                bool
+		expectedQuoteDenoms    []string
+		expectedUnresolved     []string
+	}{
+		{
+			name:                   "all resolved",
+			defaultQuoteHumanDenom: "usdc",
+			quoteHumanDenoms:       []string{"osmo"},
+			expectedQuoteDenoms:    []string{"uusdc", "uosmo"},
+		},
+		{
+			name:                   "missing quotes are skipped",
+			defaultQuoteHumanDenom: "usdc",
+			quoteHumanDenoms:       []string{"atom", "osmo", "wbtc"},
+			expectedQuoteDenoms:    []string{"uusdc", "uosmo"},
+			expectedUnresolved:     []string{"atom", "wbtc"},
+		},
+		{
+			name:                   "missing default quote",
+			defaultQuoteHumanDenom: "atom",
+			quoteHumanDenoms:       []string{"osmo"},
+			wantErr:                true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := domain.PricingConfig{
+				DefaultQuoteHumanDenom: tt.defaultQuoteHumanDenom,
+				QuoteHumanDenoms:       tt.quoteHumanDenoms,
+			}
+
+			quoteDenoms, unresolved, err := config.GetQuoteChainDenoms(getChainDenom)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PricingConfig.GetQuoteChainDenoms() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(quoteDenoms, tt.expectedQuoteDenoms) {
+				t.Errorf("PricingConfig.GetQuoteChainDenoms() = %v, want %v", quoteDenoms, tt.expectedQuoteDenoms)
+			}
+
+			if !reflect.DeepEqual(unresolved, tt.expectedUnresolved) {
+				t.Errorf("PricingConfig.GetQuoteChainDenoms() unresolved = %v, want %v", unresolved, tt.expectedUnresolved)
+			}
+		})
+	}
+}
+
+func TestTokenRegistryConfigValidate(t *testing.T) {
+	decimals, negativeDecimals := 6, -1
+
+	tests := []struct {
+		name    string
+		config  domain.TokenRegistryConfig
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			config:  domain.TokenRegistryConfig{},
+			wantErr: false,
+		},
+		{
+			name: "valid files and overrides",
+			config: domain.TokenRegistryConfig{
+				Files:     []string{"assetlist.json"},
+				Overrides: []domain.TokenRegistryOverride{{Denom: "uosmo", Decimals: &decimals}, {Denom: "uion", Symbol: "ION"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty file",
+			config:  domain.TokenRegistryConfig{Files: []string{""}},
+			wantErr: true,
+		},
+		{
+			name:    "override without denom",
+			config:  domain.TokenRegistryConfig{Overrides: []domain.TokenRegistryOverride{{Symbol: "OSMO"}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated override denom",
+			config:  domain.TokenRegistryConfig{Overrides: []domain.TokenRegistryOverride{{Denom: "uosmo"}, {Denom: "uosmo"}}},
+			wantErr: true,
+		},
+		{
+			name:    "negative override decimals",
+			config:  domain.TokenRegistryConfig{Overrides: []domain.TokenRegistryOverride{{Denom: "uosmo", Decimals: &negativeDecimals}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TokenRegistryConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDenomDiscoveryConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  domain.DenomDiscoveryConfig
+		wantErr bool
+	}{
+		{
+			name:    "disabled",
+			config:  domain.DenomDiscoveryConfig{},
+			wantErr: false,
+		},
+		{
+			name:    "enabled",
+			config:  domain.DenomDiscoveryConfig{Enabled: true, MaxDenomsPerBlock: 100, MaxAttempts: 3},
+			wantErr: false,
+		},
+		{
+			name:    "zero max denoms per block",
+			config:  domain.DenomDiscoveryConfig{Enabled: true, MaxAttempts: 3},
+			wantErr: true,
+		},
+		{
+			name:    "zero max attempts",
+			config:  domain.DenomDiscoveryConfig{Enabled: true, MaxDenomsPerBlock: 100},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DenomDiscoveryConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecommendedSlippageConfigValidate(t *testing.T) {
+	valid := domain.RecommendedSlippageConfig{
+		Enabled:               true,
+		WindowBlocks:          600,
+		HorizonBlocks:         10,
+		VolatilityMultiplier:  3,
+		PriceImpactMultiplier: 1,
+		MinSlippage:           0.001,
+		MaxSlippage:           0.05,
+	}
+
+	withMutation := func(mutate func(c *domain.RecommendedSlippageConfig)) domain.RecommendedSlippageConfig {
+		config := valid
+		mutate(&config)
+		return config
+	}
+
+	tests := []struct {
+		name    string
+		config  domain.RecommendedSlippageConfig
+		wantErr bool
+	}{
+		{
+			name:    "disabled",
+			config:  domain.RecommendedSlippageConfig{},
+			wantErr: false,
+		},
+		{
+			name:    "enabled",
+			config:  valid,
+			wantErr: false,
+		},
+		{
+			name:    "window of a single block",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.WindowBlocks = 1 }),
+			wantErr: true,
+		},
+		{
+			name:    "zero horizon blocks",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.HorizonBlocks = 0 }),
+			wantErr: true,
+		},
+		{
+			name:    "negative volatility multiplier",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.VolatilityMultiplier = -1 }),
+			wantErr: true,
+		},
+		{
+			name:    "negative price impact multiplier",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.PriceImpactMultiplier = -1 }),
+			wantErr: true,
+		},
+		{
+			name:    "negative min slippage",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.MinSlippage = -0.001 }),
+			wantErr: true,
+		},
+		{
+			name:    "max slippage not above min slippage",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.MaxSlippage = c.MinSlippage }),
+			wantErr: true,
+		},
+		{
+			name:    "max slippage of one",
+			config:  withMutation(func(c *domain.RecommendedSlippageConfig) { c.MaxSlippage = 1 }),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RecommendedSlippageConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "errors"
+
+// DenomDiscoveryConfig defines the config for resolving the metadata of the pool denoms
+// that are not in the token registry from the chain.
+type DenomDiscoveryConfig struct {
+	// Enabled defines whether the unknown pool denoms are resolved from the chain.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxDenomsPerBlock is the max number of unknown denoms resolved at the end of a block.
+	// The remaining denoms are resolved at the end of the subsequent blocks.
+	MaxDenomsPerBlock int `mapstructure:"max-denoms-per-block"`
+
+	// MaxAttempts is the number of failed resolution attempts after which a denom is no longer resolved.
+	MaxAttempts int `mapstructure:"max-attempts"`
+}
+
+// Validate validates the denom discovery config.
+func (c DenomDiscoveryConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxDenomsPerBlock <= 0 {
+		return errors.New("denom discovery max denoms per block must be positive")
+	}
+
+	if c.MaxAttempts <= 0 {
+		return errors.New("denom discovery max attempts must be positive")
+	}
+
+	return nil
+}
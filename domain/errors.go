@@ -73,6 +73,38 @@ func (e PoolNotFoundError) Error() string {
 	return fmt.Sprintf("pool with ID (%d) is not found", e.PoolID)
 }
 
+// InvalidPoolsFilterExpressionError is returned when the pools filter expression fails to compile.
+type InvalidPoolsFilterExpressionError struct {
+	Expression string
+	Err        error
+}
+
+func (e InvalidPoolsFilterExpressionError) Error() string {
+	return fmt.Sprintf("invalid pools filter expression (%s): %s", e.Expression, e.Err)
+}
+
+func (e InvalidPoolsFilterExpressionError) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedPoolsRangeFilterFieldError is returned when the pools are range filtered by an unsupported field.
+type UnsupportedPoolsRangeFilterFieldError struct {
+	Field string
+}
+
+func (e UnsupportedPoolsRangeFilterFieldError) Error() string {
+	return fmt.Sprintf("unsupported pools range filter field (%s)", e.Field)
+}
+
+// UnsupportedTokenMarketsSortFieldError is returned when the token markets are sorted by an unsupported field.
+type UnsupportedTokenMarketsSortFieldError struct {
+	Field string
+}
+
+func (e UnsupportedTokenMarketsSortFieldError) Error() string {
+	return fmt.Sprintf("unsupported sort field: %s", e.Field)
+}
+
 type ConcentratedPoolNoTickModelError struct {
 	PoolId uint64
 }
@@ -0,0 +1,143 @@
+// Package expression provides a small, safe expression language for filtering.
+//
+// Expressions are boolean combinations of comparisons over identifiers and literals, e.g.
+//
+//	totalFiatValueLocked > 1e6 && "uosmo" in denoms && (type == 1 || spreadFactor <= 0.003)
+//
+// Supported operators by increasing precedence are ||, &&, unary !, comparisons (==, !=, <, <=, >, >=)
+// and membership (in). Literals are numbers, double or single quoted strings, true, false and
+// lists of number or string literals, e.g. [1, 2]. Identifiers may contain dots, e.g. market.volume24hUsd.
+//
+// Expressions are compiled once against a schema declaring the type of each identifier.
+// All type errors are reported at compile time so that evaluation never fails.
+// There are no function calls, loops or assignments and the size of an expression is bounded.
+package expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxExpressionLength is the maximum length of an expression in bytes.
+	MaxExpressionLength = 1024
+	// maxDepth is the maximum nesting depth of an expression.
+	maxDepth = 32
+)
+
+// Type is the type of a value in an expression.
+type Type int
+
+const (
+	TypeInvalid Type = iota
+	TypeNumber
+	TypeString
+	TypeBool
+	TypeNumberList
+	TypeStringList
+)
+
+// String implements fmt.Stringer.
+func (t Type) String() string {
+	switch t {
+	case TypeNumber:
+		return "number"
+	case TypeString:
+		return "string"
+	case TypeBool:
+		return "bool"
+	case TypeNumberList:
+		return "number list"
+	case TypeStringList:
+		return "string list"
+	default:
+		return "invalid"
+	}
+}
+
+// Value is a value of an identifier or of a sub-expression.
+// Only the field corresponding to the type of the value is set.
+type Value struct {
+	Number  float64
+	String  string
+	Bool    bool
+	Numbers []float64
+	Strings []string
+}
+
+// NumberValue returns a number value.
+func NumberValue(n float64) Value { return Value{Number: n} }
+
+// StringValue returns a string value.
+func StringValue(s string) Value { return Value{String: s} }
+
+// BoolValue returns a bool value.
+func BoolValue(b bool) Value { return Value{Bool: b} }
+
+// NumberListValue returns a number list value.
+func NumberListValue(n []float64) Value { return Value{Numbers: n} }
+
+// StringListValue returns a string list value.
+func StringListValue(s []string) Value { return Value{Strings: s} }
+
+// Schema declares the types of the identifiers available in expressions.
+type Schema map[string]Type
+
+// Env resolves the value of the given identifier.
+// CONTRACT: the identifier is declared in the schema the expression is compiled against
+// and the returned value is of the declared type.
+type Env func(identifier string) Value
+
+// Program is a compiled expression. It is safe for concurrent use.
+type Program struct {
+	root        node
+	identifiers map[string]struct{}
+}
+
+// Compile parses and type checks the given expression against the given schema.
+// The expression must evaluate to a bool.
+func Compile(input string, schema Schema) (*Program, error) {
+	if len(input) > MaxExpressionLength {
+		return nil, fmt.Errorf("expression is longer than %d bytes", MaxExpressionLength)
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		tokens:      tokens,
+		schema:      schema,
+		identifiers: map[string]struct{}{},
+	}
+
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	if root.typ() != TypeBool {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", root.typ())
+	}
+
+	return &Program{
+		root:        root,
+		identifiers: p.identifiers,
+	}, nil
+}
+
+// Eval evaluates the program with the given environment.
+func (p *Program) Eval(env Env) bool {
+	return p.root.eval(env).Bool
+}
+
+// ReferencesPrefix returns true if the program references any identifier with the given prefix.
+func (p *Program) ReferencesPrefix(prefix string) bool {
+	for identifier := range p.identifiers {
+		if strings.HasPrefix(identifier, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package expression_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain/expression"
+)
+
+var (
+	testSchema = expression.Schema{
+		"liquidity_cap":   expression.TypeNumber,
+		"spread_factor":   expression.TypeNumber,
+		"type":            expression.TypeNumber,
+		"incentive":       expression.TypeString,
+		"denoms":          expression.TypeStringList,
+		"apr.total.upper": expression.TypeNumber,
+		"is_alloyed":      expression.TypeBool,
+	}
+
+	testValues = map[string]expression.Value{
+		"liquidity_cap":   expression.NumberValue(2_000_000),
+		"spread_factor":   expression.NumberValue(0.002),
+		"type":            expression.NumberValue(2),
+		"incentive":       expression.StringValue("superfluid"),
+		"denoms":          expression.StringListValue([]string{"uosmo", "uatom"}),
+		"apr.total.upper": expression.NumberValue(0.15),
+		"is_alloyed":      expression.BoolValue(false),
+	}
+
+	testEnv = func(identifier string) expression.Value {
+		return testValues[identifier]
+	}
+)
+
+// TestCompileAndEval validates that expressions are compiled and evaluated correctly.
+func TestCompileAndEval(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+
+		expected bool
+	}{
+		{
+			name:       "example from the docs",
+			expression: `liquidity_cap > 1e6 && "uosmo" in denoms && apr.total.upper > 0.1 && spread_factor <= 0.003`,
+			expected:   true,
+		},
+		{
+			name:       "comparison is false",
+			expression: "liquidity_cap < 1e6",
+			expected:   false,
+		},
+		{
+			name:       "string equality with single quotes",
+			expression: "incentive == 'superfluid'",
+			expected:   true,
+		},
+		{
+			name:       "string inequality",
+			expression: `incentive != "superfluid"`,
+			expected:   false,
+		},
+		{
+			name:       "denom not in pool",
+			expression: `"uion" in denoms`,
+			expected:   false,
+		},
+		{
+			name:       "number in list literal",
+			expression: "type in [0, 2, 3]",
+			expected:   true,
+		},
+		{
+			name:       "string in list literal",
+			expression: "incentive in ['none', 'noincentive']",
+			expected:   false,
+		},
+		{
+			name:       "negation and precedence",
+			expression: "!is_alloyed && type == 1 || spread_factor >= 0.002",
+			expected:   true,
+		},
+		{
+			name:       "parentheses override precedence",
+			expression: "!is_alloyed && (type == 1 || spread_factor > 0.002)",
+			expected:   false,
+		},
+		{
+			name:       "negative number",
+			expression: "-spread_factor < -0.001 && type >= -1",
+			expected:   true,
+		},
+		{
+			name:       "bool literal",
+			expression: "is_alloyed == false",
+			expected:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := expression.Compile(tc.expression, testSchema)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, program.Eval(testEnv))
+		})
+	}
+}
+
+// TestCompile_Error validates that malformed and ill-typed expressions fail to compile.
+func TestCompile_Error(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+
+		expectedError string
+	}{
+		{
+			name:          "empty expression",
+			expression:    "",
+			expectedError: `unexpected "end of expression" at position 0`,
+		},
+		{
+			name:          "unknown identifier",
+			expression:    "volume > 1",
+			expectedError: `unknown identifier "volume" at position 0`,
+		},
+		{
+			name:          "not a bool",
+			expression:    "liquidity_cap",
+			expectedError: "expression must evaluate to bool, got number",
+		},
+		{
+			name:          "comparing number and string",
+			expression:    "liquidity_cap == 'uosmo'",
+			expectedError: `operator "==" at position 14 cannot compare number and string`,
+		},
+		{
+			name:          "ordering strings",
+			expression:    "incentive > 'a'",
+			expectedError: `operator ">" at position 10 requires number operands, got string and string`,
+		},
+		{
+			name:          "number in string list",
+			expression:    "1 in denoms",
+			expectedError: `operator "in" at position 2 cannot test number in string list`,
+		},
+		{
+			name:          "logical operator on numbers",
+			expression:    "type && is_alloyed",
+			expectedError: `operator "&&" at position 5 requires bool operands, got number and bool`,
+		},
+		{
+			name:          "mixed list",
+			expression:    "type in [1, 'a']",
+			expectedError: `list at position 8 must contain either number or string literals, got "a" at position 12`,
+		},
+		{
+			name:          "unbalanced parentheses",
+			expression:    "(type == 1",
+			expectedError: `expected ")" at position 10, got "end of expression"`,
+		},
+		{
+			name:          "trailing tokens",
+			expression:    "type == 1 type",
+			expectedError: `unexpected "type" at position 10`,
+		},
+		{
+			name:          "unterminated string",
+			expression:    "incentive == 'none",
+			expectedError: "unterminated string at position 13",
+		},
+		{
+			name:          "unexpected character",
+			expression:    "type = 1",
+			expectedError: `unexpected character '=' at position 5`,
+		},
+		{
+			name:          "too deep",
+			expression:    "!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!is_alloyed",
+			expectedError: "expression is nested deeper than 32 levels",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := expression.Compile(tc.expression, testSchema)
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+// TestReferencesPrefix validates that the referenced identifiers are tracked.
+func TestReferencesPrefix(t *testing.T) {
+	program, err := expression.Compile("apr.total.upper > 0.1 && type == 1", testSchema)
+	require.NoError(t, err)
+
+	require.True(t, program.ReferencesPrefix("apr."))
+	require.False(t, program.ReferencesPrefix("fees."))
+}
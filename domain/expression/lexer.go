@@ -0,0 +1,203 @@
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenTrue
+	tokenFalse
+	tokenIn
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenEqual
+	tokenNotEqual
+	tokenLess
+	tokenLessEqual
+	tokenGreater
+	tokenGreaterEqual
+	tokenMinus
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+)
+
+// token is a lexical token of an expression.
+type token struct {
+	kind tokenKind
+	// text is the identifier name, the unquoted string or the source of the token.
+	text   string
+	number float64
+	// pos is the byte offset of the token in the expression.
+	pos int
+}
+
+// operators maps the operator symbols to their token kinds.
+// Two character operators are matched before single character ones.
+var operators = []struct {
+	symbol string
+	kind   tokenKind
+}{
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"==", tokenEqual},
+	{"!=", tokenNotEqual},
+	{"<=", tokenLessEqual},
+	{">=", tokenGreaterEqual},
+	{"<", tokenLess},
+	{">", tokenGreater},
+	{"!", tokenNot},
+	{"-", tokenMinus},
+	{"(", tokenLeftParen},
+	{")", tokenRightParen},
+	{"[", tokenLeftBracket},
+	{"]", tokenRightBracket},
+	{",", tokenComma},
+}
+
+// keywords maps the reserved words to their token kinds.
+var keywords = map[string]tokenKind{
+	"true":  tokenTrue,
+	"false": tokenFalse,
+	"in":    tokenIn,
+}
+
+// tokenize splits the given expression into tokens terminated by an EOF token.
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+
+	for pos := 0; pos < len(input); {
+		c := input[pos]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+
+		case isDigit(c) || (c == '.' && pos+1 < len(input) && isDigit(input[pos+1])):
+			end := scanNumber(input, pos)
+			number, err := strconv.ParseFloat(input[pos:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", input[pos:end], pos)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[pos:end], number: number, pos: pos})
+			pos = end
+
+		case c == '"' || c == '\'':
+			str, end, err := scanString(input, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: str, pos: pos})
+			pos = end
+
+		case isIdentifierStart(c):
+			end := scanIdentifier(input, pos)
+			text := input[pos:end]
+			kind, ok := keywords[text]
+			if !ok {
+				kind = tokenIdentifier
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: pos})
+			pos = end
+
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(input[pos:], op.symbol) {
+					tokens = append(tokens, token{kind: op.kind, text: op.symbol, pos: pos})
+					pos += len(op.symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(input)}), nil
+}
+
+// scanNumber returns the end offset of the number starting at the given offset.
+// Numbers consist of digits with an optional fraction and exponent, e.g. 1.5e-3.
+func scanNumber(input string, pos int) int {
+	end := pos
+	for end < len(input) && (isDigit(input[end]) || input[end] == '.') {
+		end++
+	}
+
+	if end < len(input) && (input[end] == 'e' || input[end] == 'E') {
+		exponentEnd := end + 1
+		if exponentEnd < len(input) && (input[exponentEnd] == '+' || input[exponentEnd] == '-') {
+			exponentEnd++
+		}
+		if exponentEnd < len(input) && isDigit(input[exponentEnd]) {
+			for exponentEnd < len(input) && isDigit(input[exponentEnd]) {
+				exponentEnd++
+			}
+			end = exponentEnd
+		}
+	}
+
+	return end
+}
+
+// scanString returns the unquoted string starting at the given offset and the end offset after the closing quote.
+// The quote character and the backslash may be escaped with a backslash.
+func scanString(input string, pos int) (string, int, error) {
+	quote := input[pos]
+
+	var sb strings.Builder
+	for end := pos + 1; end < len(input); end++ {
+		switch c := input[end]; {
+		case c == '\\' && end+1 < len(input) && (input[end+1] == quote || input[end+1] == '\\'):
+			sb.WriteByte(input[end+1])
+			end++
+		case c == quote:
+			return sb.String(), end + 1, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string at position %d", pos)
+}
+
+// scanIdentifier returns the end offset of the identifier starting at the given offset.
+// Identifiers consist of dot-separated segments of letters, digits and underscores, e.g. market.volume24hUsd.
+func scanIdentifier(input string, pos int) int {
+	end := pos
+	for end < len(input) {
+		c := input[end]
+		if isIdentifierStart(c) || isDigit(c) {
+			end++
+			continue
+		}
+		if c == '.' && end+1 < len(input) && isIdentifierStart(input[end+1]) {
+			end++
+			continue
+		}
+		break
+	}
+	return end
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,416 @@
+package expression
+
+import (
+	"fmt"
+	"slices"
+)
+
+// parser is a recursive descent parser that builds and type checks the syntax tree of an expression.
+//
+// Grammar:
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | comparison
+//	comparison = operand [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" | "in" ) operand ]
+//	operand    = [ "-" ] primary
+//	primary    = number | string | "true" | "false" | identifier | list | "(" or ")"
+//	list       = "[" [ literal { "," literal } ] "]"
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+
+	schema Schema
+	// identifiers are the identifiers referenced by the expression.
+	identifiers map[string]struct{}
+}
+
+// parse parses the whole expression.
+func (p *parser) parse() (node, error) {
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+
+	return root, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind, description string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return token{}, fmt.Errorf("expected %s at position %d, got %q", description, tok.pos, tok.text)
+	}
+	return tok, nil
+}
+
+// enter increments the nesting depth, failing if the maximum depth is exceeded.
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		return fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		tok := p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectBoolOperands(tok, left, right); err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		tok := p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectBoolOperands(tok, left, right); err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind != tokenNot {
+		return p.parseComparison()
+	}
+
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	tok := p.next()
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	if operand.typ() != TypeBool {
+		return nil, fmt.Errorf("operator %q at position %d requires bool operand, got %s", tok.text, tok.pos, operand.typ())
+	}
+
+	return &notNode{operand: operand}, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	switch tok.kind {
+	case tokenEqual, tokenNotEqual:
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		if left.typ() != right.typ() || (left.typ() != TypeNumber && left.typ() != TypeString && left.typ() != TypeBool) {
+			return nil, fmt.Errorf("operator %q at position %d cannot compare %s and %s", tok.text, tok.pos, left.typ(), right.typ())
+		}
+
+		return &equalNode{left: left, right: right, negate: tok.kind == tokenNotEqual}, nil
+
+	case tokenLess, tokenLessEqual, tokenGreater, tokenGreaterEqual:
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		if left.typ() != TypeNumber || right.typ() != TypeNumber {
+			return nil, fmt.Errorf("operator %q at position %d requires number operands, got %s and %s", tok.text, tok.pos, left.typ(), right.typ())
+		}
+
+		return &orderNode{left: left, right: right, op: tok.kind}, nil
+
+	case tokenIn:
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		if !(left.typ() == TypeString && right.typ() == TypeStringList) && !(left.typ() == TypeNumber && right.typ() == TypeNumberList) {
+			return nil, fmt.Errorf("operator %q at position %d cannot test %s in %s", tok.text, tok.pos, left.typ(), right.typ())
+		}
+
+		return &inNode{left: left, right: right}, nil
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	if p.peek().kind != tokenMinus {
+		return p.parsePrimary()
+	}
+
+	tok := p.next()
+	operand, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if operand.typ() != TypeNumber {
+		return nil, fmt.Errorf("operator %q at position %d requires number operand, got %s", tok.text, tok.pos, operand.typ())
+	}
+
+	return &negateNode{operand: operand}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenNumber:
+		return &literalNode{value: NumberValue(tok.number), t: TypeNumber}, nil
+
+	case tokenString:
+		return &literalNode{value: StringValue(tok.text), t: TypeString}, nil
+
+	case tokenTrue, tokenFalse:
+		return &literalNode{value: BoolValue(tok.kind == tokenTrue), t: TypeBool}, nil
+
+	case tokenIdentifier:
+		t, ok := p.schema[tok.text]
+		if !ok {
+			return nil, fmt.Errorf("unknown identifier %q at position %d", tok.text, tok.pos)
+		}
+		p.identifiers[tok.text] = struct{}{}
+		return &identifierNode{name: tok.text, t: t}, nil
+
+	case tokenLeftBracket:
+		return p.parseList(tok)
+
+	case tokenLeftParen:
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	}
+
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+// parseList parses a list of number or string literals. The opening bracket is already consumed.
+func (p *parser) parseList(open token) (node, error) {
+	var (
+		numbers []float64
+		strs    []string
+	)
+
+	for p.peek().kind != tokenRightBracket {
+		if len(numbers)+len(strs) > 0 {
+			if _, err := p.expect(tokenComma, "\",\""); err != nil {
+				return nil, err
+			}
+		}
+
+		tok := p.next()
+		switch {
+		case tok.kind == tokenNumber && len(strs) == 0:
+			numbers = append(numbers, tok.number)
+		case tok.kind == tokenMinus && len(strs) == 0:
+			numberTok, err := p.expect(tokenNumber, "number")
+			if err != nil {
+				return nil, err
+			}
+			numbers = append(numbers, -numberTok.number)
+		case tok.kind == tokenString && len(numbers) == 0:
+			strs = append(strs, tok.text)
+		default:
+			return nil, fmt.Errorf("list at position %d must contain either number or string literals, got %q at position %d", open.pos, tok.text, tok.pos)
+		}
+	}
+	p.next()
+
+	if len(strs) > 0 {
+		return &literalNode{value: StringListValue(strs), t: TypeStringList}, nil
+	}
+
+	// Empty lists are treated as number lists.
+	return &literalNode{value: NumberListValue(numbers), t: TypeNumberList}, nil
+}
+
+// expectBoolOperands returns error if any of the operands of the given logical operator is not a bool.
+func expectBoolOperands(op token, left, right node) error {
+	if left.typ() != TypeBool || right.typ() != TypeBool {
+		return fmt.Errorf("operator %q at position %d requires bool operands, got %s and %s", op.text, op.pos, left.typ(), right.typ())
+	}
+	return nil
+}
+
+// node is a type checked node of the syntax tree of an expression.
+type node interface {
+	typ() Type
+	eval(env Env) Value
+}
+
+type literalNode struct {
+	value Value
+	t     Type
+}
+
+func (n *literalNode) typ() Type        { return n.t }
+func (n *literalNode) eval(_ Env) Value { return n.value }
+
+type identifierNode struct {
+	name string
+	t    Type
+}
+
+func (n *identifierNode) typ() Type          { return n.t }
+func (n *identifierNode) eval(env Env) Value { return env(n.name) }
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) typ() Type { return TypeBool }
+func (n *notNode) eval(env Env) Value {
+	return BoolValue(!n.operand.eval(env).Bool)
+}
+
+type negateNode struct {
+	operand node
+}
+
+func (n *negateNode) typ() Type { return TypeNumber }
+func (n *negateNode) eval(env Env) Value {
+	return NumberValue(-n.operand.eval(env).Number)
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n *andNode) typ() Type { return TypeBool }
+func (n *andNode) eval(env Env) Value {
+	return BoolValue(n.left.eval(env).Bool && n.right.eval(env).Bool)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n *orNode) typ() Type { return TypeBool }
+func (n *orNode) eval(env Env) Value {
+	return BoolValue(n.left.eval(env).Bool || n.right.eval(env).Bool)
+}
+
+type equalNode struct {
+	left, right node
+	negate      bool
+}
+
+func (n *equalNode) typ() Type { return TypeBool }
+func (n *equalNode) eval(env Env) Value {
+	left, right := n.left.eval(env), n.right.eval(env)
+
+	var equal bool
+	switch n.left.typ() {
+	case TypeNumber:
+		equal = left.Number == right.Number
+	case TypeString:
+		equal = left.String == right.String
+	case TypeBool:
+		equal = left.Bool == right.Bool
+	}
+
+	return BoolValue(equal != n.negate)
+}
+
+type orderNode struct {
+	left, right node
+	op          tokenKind
+}
+
+func (n *orderNode) typ() Type { return TypeBool }
+func (n *orderNode) eval(env Env) Value {
+	left, right := n.left.eval(env).Number, n.right.eval(env).Number
+
+	switch n.op {
+	case tokenLess:
+		return BoolValue(left < right)
+	case tokenLessEqual:
+		return BoolValue(left <= right)
+	case tokenGreater:
+		return BoolValue(left > right)
+	default:
+		return BoolValue(left >= right)
+	}
+}
+
+type inNode struct {
+	left, right node
+}
+
+func (n *inNode) typ() Type { return TypeBool }
+func (n *inNode) eval(env Env) Value {
+	left, right := n.left.eval(env), n.right.eval(env)
+
+	if n.left.typ() == TypeString {
+		return BoolValue(slices.Contains(right.Strings, left.String))
+	}
+	return BoolValue(slices.Contains(right.Numbers, left.Number))
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+)
 
 type GRPCIngesterConfig struct {
 	// Flag to enable the GRPC ingester server
@@ -17,6 +20,82 @@ type GRPCIngesterConfig struct {
 
 	// Plugins encapsulates the plugins config.
 	Plugins []Plugin `mapstructure:"plugins"`
+
+	// TLS encapsulates the transport security config of the GRPC ingester server.
+	TLS GRPCIngesterTLSConfig `mapstructure:"tls"`
+
+	// Auth encapsulates the request authentication config of the GRPC ingester server.
+	Auth GRPCIngesterAuthConfig `mapstructure:"auth"`
+}
+
+// GRPCIngesterTLSConfig defines the TLS configuration of the GRPC ingester server.
+type GRPCIngesterTLSConfig struct {
+	// Flag to enable TLS on the GRPC ingester server.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path to the PEM-encoded server certificate.
+	CertFile string `mapstructure:"cert-file"`
+
+	// Path to the PEM-encoded server private key.
+	KeyFile string `mapstructure:"key-file"`
+
+	// Path to the PEM-encoded CA bundle used to verify client certificates.
+	// If set, mutual TLS is enforced and clients without a valid certificate are rejected.
+	ClientCAFile string `mapstructure:"client-ca-file"`
+}
+
+// GRPCIngesterAuthConfig defines the shared-secret HMAC authentication configuration
+// of the GRPC ingester server.
+type GRPCIngesterAuthConfig struct {
+	// Flag to enable HMAC authentication of the ingest requests.
+	Enabled bool `mapstructure:"enabled"`
+
+	// The secret shared between the node and SQS used as the HMAC key.
+	// Omitted from JSON so that it is never exposed via the /config endpoint.
+	SharedSecret string `mapstructure:"shared-secret" json:"-"`
+
+	// The maximum allowed difference in seconds between the request timestamp
+	// and the server time when the request starts. Chunked block streams may take longer to upload.
+	// Bounds the window in which the nonces of the authenticated requests are retained to reject replays.
+	MaxClockSkewSeconds int `mapstructure:"max-clock-skew-seconds"`
+}
+
+var (
+	ErrGRPCIngesterTLSCertNotSet     = errors.New("grpc ingester tls is enabled but cert-file or key-file is not set")
+	ErrGRPCIngesterAuthSecretNotSet  = errors.New("grpc ingester auth is enabled but shared-secret is not set")
+	ErrGRPCIngesterAuthClockSkewZero = errors.New("grpc ingester auth max-clock-skew-seconds must be positive")
+)
+
+// Validate validates the GRPC ingester config.
+// Returns an error if the config is invalid. Nil is returned if the config is valid.
+func (c GRPCIngesterConfig) Validate() error {
+	if c.TLS.Enabled && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		return ErrGRPCIngesterTLSCertNotSet
+	}
+
+	if c.Auth.Enabled {
+		if c.Auth.SharedSecret == "" {
+			return ErrGRPCIngesterAuthSecretNotSet
+		}
+
+		if c.Auth.MaxClockSkewSeconds <= 0 {
+			return ErrGRPCIngesterAuthClockSkewZero
+		}
+	}
+
+	return nil
+}
+
+// IngestSourceStatus is the status of a single source pushing blocks to the ingester.
+type IngestSourceStatus struct {
+	// Source is the identifier of the ingest source.
+	Source string `json:"source"`
+	// LatestHeight is the latest height received from the source.
+	LatestHeight uint64 `json:"latest_height"`
+	// Lag is the number of blocks the source is behind the latest accepted height.
+	Lag uint64 `json:"lag"`
+	// IsActive is true if the source delivered the latest accepted height.
+	IsActive bool `json:"is_active"`
 }
 
 // BlockPoolMetadata contains the metadata about unique pools
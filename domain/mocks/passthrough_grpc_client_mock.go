@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
 	passthroughdomain "github.com/osmosis-labs/sqs/domain/passthrough"
 	"google.golang.org/grpc"
 )
@@ -17,6 +18,7 @@ type PassthroughGRPCClientMock struct {
 	MockDelegatorUnbondingDelegationsCb func(ctx context.Context, address string) (sdk.Coins, error)
 	MockUserPositionsBalancesCb         func(ctx context.Context, address string) (sdk.Coins, sdk.Coins, error)
 	MockDelegationRewardsCb             func(ctx context.Context, address string) (sdk.Coins, error)
+	MockPositionByIDCb                  func(ctx context.Context, positionID uint64) (concentratedmodel.Position, error)
 }
 
 // GetChainGRPCClient implements passthroughdomain.PassthroughGRPCClient.
@@ -69,6 +71,15 @@ func (p *PassthroughGRPCClientMock) UserPositionsBalances(ctx context.Context, a
 	return nil, nil, errors.New("MockUserPositionsBalancesCb is not implemented")
 }
 
+// PositionByID implements passthroughdomain.PassthroughGRPCClient.
+func (p *PassthroughGRPCClientMock) PositionByID(ctx context.Context, positionID uint64) (concentratedmodel.Position, error) {
+	if p.MockPositionByIDCb != nil {
+		return p.MockPositionByIDCb(ctx, positionID)
+	}
+
+	return concentratedmodel.Position{}, errors.New("MockPositionByIDCb is not implemented")
+}
+
 // AccountUnlockingCoins implements passthroughdomain.PassthroughGRPCClient.
 func (p *PassthroughGRPCClientMock) AccountUnlockingCoins(ctx context.Context, address string) (sdk.Coins, error) {
 	if p.MockAccountUnlockingCoinsCb != nil {
@@ -82,3 +82,8 @@ func (p *PoolHandlerMock) StorePools(pools []sqsdomain.PoolI) error {
 func (p *PoolHandlerMock) CalcExitCFMMPool(poolID uint64, exitingShares math.Int) (types.Coins, error) {
 	panic("unimplemented")
 }
+
+// CalcJoinPool implements mvc.PoolHandler.
+func (p *PoolHandlerMock) CalcJoinPool(poolID uint64, tokensIn types.Coins) (domain.CFMMJoinPoolResult, error) {
+	panic("unimplemented")
+}
@@ -40,6 +40,8 @@ type MockRoutablePool struct {
 
 	PoolLiquidityCap      osmomath.Int
 	PoolLiquidityCapError string
+
+	CreationHeight uint64
 }
 
 // GetAPRData implements sqsdomain.PoolI.
@@ -105,6 +107,7 @@ func (mp *MockRoutablePool) GetSQSPoolModel() sqsdomain.SQSPool {
 		SpreadFactor:      mp.SpreadFactor,
 		PoolDenoms:        mp.Denoms,
 		CosmWasmPoolModel: mp.CosmWasmPoolModel,
+		CreationHeight:    mp.CreationHeight,
 	}
 }
 
@@ -22,12 +22,17 @@ type PoolsUsecaseMock struct {
 	GetAllPoolsFunc                     func() ([]sqsdomain.PoolI, error)
 	GetPoolsFunc                        func(opts ...domain.PoolsOption) ([]sqsdomain.PoolI, uint64, error)
 	StorePoolsFunc                      func(pools []sqsdomain.PoolI) error
-	GetRoutesFromCandidatesFunc         func(candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
+	GetRoutesFromCandidatesFunc         func(ctx context.Context, candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
 	GetTickModelMapFunc                 func(poolIDs []uint64) (map[uint64]*sqsdomain.TickModel, error)
 	GetPoolFunc                         func(poolID uint64) (sqsdomain.PoolI, error)
 	GetPoolSpotPriceFunc                func(ctx context.Context, poolID uint64, takerFee osmomath.Dec, quoteAsset, baseAsset string) (osmomath.BigDec, error)
+	GetConcentratedPoolDepthChartFunc   func(ctx context.Context, poolID uint64, depthPercents []osmomath.Dec) (domain.ConcentratedDepthChart, error)
+	SimulateConcentratedPositionFunc    func(ctx context.Context, poolID uint64, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error)
+	GetConcentratedPositionAssetsFunc   func(ctx context.Context, positionID uint64) (sdk.Coins, error)
+	GetAlloyedPoolIDFunc                func(alloyedDenom string) (uint64, error)
 	GetCosmWasmPoolConfigFunc           func() domain.CosmWasmPoolRouterConfig
 	CalcExitCFMMPoolFunc                func(poolID uint64, exitingShares osmomath.Int) (sdk.Coins, error)
+	CalcJoinPoolFunc                    func(poolID uint64, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error)
 	GetAllCanonicalOrderbookPoolIDsFunc func() ([]domain.CanonicalOrderBooksResult, error)
 
 	Pools        []sqsdomain.PoolI
@@ -89,9 +94,9 @@ func (pm *PoolsUsecaseMock) GetPools(opts ...domain.PoolsOption) ([]sqsdomain.Po
 // GetRoutesFromCandidates implements mvc.PoolsUsecase.
 // Note that taker fee are ignored and not set
 // Note that tick models are not set
-func (pm *PoolsUsecaseMock) GetRoutesFromCandidates(candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom string, tokenOutDenom string) ([]route.RouteImpl, error) {
+func (pm *PoolsUsecaseMock) GetRoutesFromCandidates(ctx context.Context, candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom string, tokenOutDenom string) ([]route.RouteImpl, error) {
 	if pm.GetRoutesFromCandidatesFunc != nil {
-		return pm.GetRoutesFromCandidatesFunc(candidateRoutes, tokenInDenom, tokenOutDenom)
+		return pm.GetRoutesFromCandidatesFunc(ctx, candidateRoutes, tokenInDenom, tokenOutDenom)
 	}
 
 	finalRoutes := make([]route.RouteImpl, 0, len(candidateRoutes.Routes))
@@ -160,6 +165,38 @@ func (pm *PoolsUsecaseMock) GetPoolSpotPrice(ctx context.Context, poolID uint64,
 	panic("unimplemented")
 }
 
+// GetConcentratedPoolDepthChart implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) GetConcentratedPoolDepthChart(ctx context.Context, poolID uint64, depthPercents []osmomath.Dec) (domain.ConcentratedDepthChart, error) {
+	if pm.GetConcentratedPoolDepthChartFunc != nil {
+		return pm.GetConcentratedPoolDepthChartFunc(ctx, poolID, depthPercents)
+	}
+	panic("unimplemented")
+}
+
+// SimulateConcentratedPosition implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) SimulateConcentratedPosition(ctx context.Context, poolID uint64, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error) {
+	if pm.SimulateConcentratedPositionFunc != nil {
+		return pm.SimulateConcentratedPositionFunc(ctx, poolID, lowerPrice, upperPrice, tokenIn)
+	}
+	panic("unimplemented")
+}
+
+// GetConcentratedPositionAssets implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) GetConcentratedPositionAssets(ctx context.Context, positionID uint64) (sdk.Coins, error) {
+	if pm.GetConcentratedPositionAssetsFunc != nil {
+		return pm.GetConcentratedPositionAssetsFunc(ctx, positionID)
+	}
+	panic("unimplemented")
+}
+
+// GetAlloyedPoolID implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) GetAlloyedPoolID(alloyedDenom string) (uint64, error) {
+	if pm.GetAlloyedPoolIDFunc != nil {
+		return pm.GetAlloyedPoolIDFunc(alloyedDenom)
+	}
+	panic("unimplemented")
+}
+
 // CalcExitCFMMPool implements mvc.PoolsUsecase.
 func (pm *PoolsUsecaseMock) CalcExitCFMMPool(poolID uint64, exitingShares osmomath.Int) (sdk.Coins, error) {
 	if pm.CalcExitCFMMPoolFunc != nil {
@@ -168,4 +205,12 @@ func (pm *PoolsUsecaseMock) CalcExitCFMMPool(poolID uint64, exitingShares osmoma
 	panic("unimplemented")
 }
 
+// CalcJoinPool implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) CalcJoinPool(poolID uint64, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error) {
+	if pm.CalcJoinPoolFunc != nil {
+		return pm.CalcJoinPoolFunc(poolID, tokensIn)
+	}
+	panic("unimplemented")
+}
+
 var _ mvc.PoolsUsecase = &PoolsUsecaseMock{}
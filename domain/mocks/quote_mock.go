@@ -13,6 +13,9 @@ type MockQuote struct {
 	GetAmountInFunc  func() types.Coin
 	GetAmountOutFunc func() math.Int
 	GetRouteFunc     func() []domain.SplitRoute
+
+	GetPriceImpactFunc func() math.LegacyDec
+	PrepareResultFunc  func(ctx context.Context, scalingFactor math.LegacyDec, logger log.Logger) ([]domain.SplitRoute, math.LegacyDec, error)
 }
 
 // GetAmountIn implements domain.Quote.
@@ -45,6 +48,10 @@ func (m *MockQuote) GetInBaseOutQuoteSpotPrice() math.LegacyDec {
 
 // GetPriceImpact implements domain.Quote.
 func (m *MockQuote) GetPriceImpact() math.LegacyDec {
+	if m.GetPriceImpactFunc != nil {
+		return m.GetPriceImpactFunc()
+	}
+
 	panic("unimplemented")
 }
 
@@ -59,6 +66,10 @@ func (m *MockQuote) GetRoute() []domain.SplitRoute {
 
 // PrepareResult implements domain.Quote.
 func (m *MockQuote) PrepareResult(ctx context.Context, scalingFactor math.LegacyDec, logger log.Logger) ([]domain.SplitRoute, math.LegacyDec, error) {
+	if m.PrepareResultFunc != nil {
+		return m.PrepareResultFunc(ctx, scalingFactor, logger)
+	}
+
 	panic("unimplemented")
 }
 
@@ -67,6 +78,16 @@ func (m *MockQuote) SetQuotePriceInfo(info *domain.TxFeeInfo) {
 	panic("unimplemented")
 }
 
+// SetHeight implements domain.Quote.
+func (m *MockQuote) SetHeight(height uint64) {
+	panic("unimplemented")
+}
+
+// SetRecommendedSlippage implements domain.Quote.
+func (m *MockQuote) SetRecommendedSlippage(slippage math.LegacyDec) {
+	panic("unimplemented")
+}
+
 // String implements domain.Quote.
 func (m *MockQuote) String() string {
 	panic("unimplemented")
@@ -18,6 +18,7 @@ var _ mvc.RouterUsecase = &RouterUsecaseMock{}
 type RouterUsecaseMock struct {
 	GetSimpleQuoteFunc                           func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error)
 	GetPoolSpotPriceFunc                         func(ctx context.Context, poolID uint64, quoteAsset, baseAsset string) (osmomath.BigDec, error)
+	GetPoolLiquidityCapFunc                      func(ctx context.Context, poolID uint64) (osmomath.Int, error)
 	GetOptimalQuoteFunc                          func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error)
 	GetOptimalQuoteInGivenOutFunc                func(ctx context.Context, tokenOut sdk.Coin, tokenInDenom string, opts ...domain.RouterOption) (domain.Quote, error)
 	GetBestSingleRouteQuoteFunc                  func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error)
@@ -34,7 +35,9 @@ type RouterUsecaseMock struct {
 	GetConfigFunc                                func() domain.RouterConfig
 	ConvertMinTokensPoolLiquidityCapToFilterFunc func(minTokensPoolLiquidityCap uint64) uint64
 	SetSortedPoolsFunc                           func(pools []sqsdomain.PoolI)
-	GetMinPoolLiquidityCapFilterFunc             func(tokenInDenom string, tokenOutDenom string) (uint64, error)
+	GetMinPoolLiquidityCapFilterFunc             func(ctx context.Context, tokenInDenom string, tokenOutDenom string) (uint64, error)
+	SetPoolHealthTrackerFunc                     func(tracker mvc.PoolHealthTracker)
+	OnPoolRoutingConfigUpdateFunc                func(ctx context.Context, config domain.PoolRoutingConfig) error
 
 	BaseFee domain.BaseFee
 }
@@ -45,9 +48,9 @@ func (m *RouterUsecaseMock) GetBaseFee() domain.BaseFee {
 }
 
 // GetMinPoolLiquidityCapFilter implements mvc.RouterUsecase.
-func (m *RouterUsecaseMock) GetMinPoolLiquidityCapFilter(tokenInDenom string, tokenOutDenom string) (uint64, error) {
+func (m *RouterUsecaseMock) GetMinPoolLiquidityCapFilter(ctx context.Context, tokenInDenom string, tokenOutDenom string) (uint64, error) {
 	if m.GetMinPoolLiquidityCapFilterFunc != nil {
-		return m.GetMinPoolLiquidityCapFilterFunc(tokenInDenom, tokenOutDenom)
+		return m.GetMinPoolLiquidityCapFilterFunc(ctx, tokenInDenom, tokenOutDenom)
 	}
 	panic("unimplemented")
 }
@@ -66,6 +69,13 @@ func (m *RouterUsecaseMock) GetPoolSpotPrice(ctx context.Context, poolID uint64,
 	return osmomath.BigDec{}, nil
 }
 
+func (m *RouterUsecaseMock) GetPoolLiquidityCap(ctx context.Context, poolID uint64) (osmomath.Int, error) {
+	if m.GetPoolLiquidityCapFunc != nil {
+		return m.GetPoolLiquidityCapFunc(ctx, poolID)
+	}
+	return osmomath.Int{}, nil
+}
+
 func (m *RouterUsecaseMock) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
 	if m.GetOptimalQuoteFunc != nil {
 		return m.GetOptimalQuoteFunc(ctx, tokenIn, tokenOutDenom, opts...)
@@ -175,3 +185,18 @@ func (m *RouterUsecaseMock) SetSortedPools(pools []sqsdomain.PoolI) {
 		m.SetSortedPoolsFunc(pools)
 	}
 }
+
+// SetPoolHealthTracker implements mvc.RouterUsecase.
+func (m *RouterUsecaseMock) SetPoolHealthTracker(tracker mvc.PoolHealthTracker) {
+	if m.SetPoolHealthTrackerFunc != nil {
+		m.SetPoolHealthTrackerFunc(tracker)
+	}
+}
+
+// OnPoolRoutingConfigUpdate implements mvc.RouterUsecase.
+func (m *RouterUsecaseMock) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	if m.OnPoolRoutingConfigUpdateFunc != nil {
+		return m.OnPoolRoutingConfigUpdateFunc(ctx, config)
+	}
+	return nil
+}
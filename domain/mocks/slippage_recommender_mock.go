@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"cosmossdk.io/math"
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+type SlippageRecommenderMock struct {
+	GetRecommendedSlippageFn func(tokenInDenom, tokenOutDenom string, priceImpact math.LegacyDec) (math.LegacyDec, bool)
+}
+
+// GetRecommendedSlippage implements domain.SlippageRecommender.
+func (s *SlippageRecommenderMock) GetRecommendedSlippage(tokenInDenom, tokenOutDenom string, priceImpact math.LegacyDec) (math.LegacyDec, bool) {
+	if s.GetRecommendedSlippageFn != nil {
+		return s.GetRecommendedSlippageFn(tokenInDenom, tokenOutDenom, priceImpact)
+	}
+	panic("GetRecommendedSlippageFn not implemented")
+}
+
+var _ domain.SlippageRecommender = &SlippageRecommenderMock{}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
+
+var _ mvc.StateSnapshotUsecase = &StateSnapshotUsecaseMock{}
+
+// StateSnapshotUsecaseMock is a mock implementation of the StateSnapshotUsecase interface
+type StateSnapshotUsecaseMock struct {
+	OnSearchDataUpdateFunc     func(ctx context.Context, height uint64) error
+	GetLatestStateSnapshotFunc func() (*domain.StateSnapshot, bool)
+	GetStateSnapshotFunc       func(height uint64) (*domain.StateSnapshot, bool)
+}
+
+func (m *StateSnapshotUsecaseMock) OnSearchDataUpdate(ctx context.Context, height uint64) error {
+	if m.OnSearchDataUpdateFunc != nil {
+		return m.OnSearchDataUpdateFunc(ctx, height)
+	}
+	return nil
+}
+
+func (m *StateSnapshotUsecaseMock) GetLatestStateSnapshot() (*domain.StateSnapshot, bool) {
+	if m.GetLatestStateSnapshotFunc != nil {
+		return m.GetLatestStateSnapshotFunc()
+	}
+	return nil, false
+}
+
+func (m *StateSnapshotUsecaseMock) GetStateSnapshot(height uint64) (*domain.StateSnapshot, bool) {
+	if m.GetStateSnapshotFunc != nil {
+		return m.GetStateSnapshotFunc(height)
+	}
+	return nil, false
+}
@@ -1,6 +1,10 @@
 package mocks
 
-import "github.com/osmosis-labs/sqs/domain/mvc"
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
 
 type TokenMetadataHolderMock struct {
 	MockMinPoolLiquidityCap      uint64
@@ -10,6 +14,6 @@ type TokenMetadataHolderMock struct {
 var _ mvc.TokenMetadataHolder = &TokenMetadataHolderMock{}
 
 // GetMinPoolLiquidityCap implements mvc.TokenMetadataHolder.
-func (t *TokenMetadataHolderMock) GetMinPoolLiquidityCap(denomA string, denomB string) (uint64, error) {
+func (t *TokenMetadataHolderMock) GetMinPoolLiquidityCap(ctx context.Context, denomA string, denomB string) (uint64, error) {
 	return t.MockMinPoolLiquidityCap, t.MockMinPoolLiquidityCapError
 }
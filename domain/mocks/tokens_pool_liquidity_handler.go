@@ -13,6 +13,8 @@ type TokensPoolLiquidityHandlerMock struct {
 	DenomScalingFactorMap map[string]math.LegacyDec
 
 	PoolDenomMetadataMap domain.PoolDenomMetaDataMap
+
+	QuotePoolDenomMetadataMap map[string]domain.PoolDenomMetaDataMap
 }
 
 var _ mvc.TokensPoolLiquidityHandler = &TokensPoolLiquidityHandlerMock{}
@@ -39,3 +41,11 @@ func (t *TokensPoolLiquidityHandlerMock) GetChainScalingFactorByDenomMut(denom s
 func (t *TokensPoolLiquidityHandlerMock) UpdatePoolDenomMetadata(tokensMetadata domain.PoolDenomMetaDataMap) {
 	t.PoolDenomMetadataMap = tokensMetadata
 }
+
+// UpdateQuotePoolDenomMetadata implements mvc.TokensPoolLiquidityHandler.
+func (t *TokensPoolLiquidityHandlerMock) UpdateQuotePoolDenomMetadata(quoteDenom string, tokensMetadata domain.PoolDenomMetaDataMap) {
+	if t.QuotePoolDenomMetadataMap == nil {
+		t.QuotePoolDenomMetadataMap = map[string]domain.PoolDenomMetaDataMap{}
+	}
+	t.QuotePoolDenomMetadataMap[quoteDenom] = tokensMetadata
+}
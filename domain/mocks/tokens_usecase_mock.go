@@ -12,18 +12,23 @@ import (
 // TokensUsecaseMock is a mock implementation of the TokensUsecase interface
 type TokensUsecaseMock struct {
 	UpdatePoolDenomMetadataFunc          func(tokensMetadata domain.PoolDenomMetaDataMap)
+	UpdateQuotePoolDenomMetadataFunc     func(quoteDenom string, tokensMetadata domain.PoolDenomMetaDataMap)
 	LoadTokensFunc                       func(tokenMetadataByChainDenom map[string]domain.Token)
+	LoadUnverifiedTokensFunc             func(tokenMetadataByChainDenom map[string]domain.Token)
 	GetMetadataByChainDenomFunc          func(denom string) (domain.Token, error)
 	GetFullTokenMetadataFunc             func() (map[string]domain.Token, error)
 	GetChainDenomFunc                    func(humanDenom string) (string, error)
 	GetChainScalingFactorByDenomMutFunc  func(denom string) (osmomath.Dec, error)
 	GetSpotPriceScalingFactorByDenomFunc func(baseDenom, quoteDenom string) (osmomath.Dec, error)
 	GetPricesFunc                        func(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PricesResult, error)
-	GetMinPoolLiquidityCapFunc           func(denomA, denomB string) (uint64, error)
-	GetPoolDenomMetadataFunc             func(chainDenom string) (domain.PoolDenomMetaData, error)
-	GetPoolLiquidityCapFunc              func(chainDenom string) (osmomath.Int, error)
-	GetPoolDenomsMetadataFunc            func(chainDenoms []string) domain.PoolDenomMetaDataMap
-	GetFullPoolDenomMetadataFunc         func() domain.PoolDenomMetaDataMap
+	GetPriceDetailsFunc                  func(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PriceDetailsResult, error)
+	GetMinPoolLiquidityCapFunc           func(ctx context.Context, denomA, denomB string) (uint64, error)
+	GetPoolDenomMetadataFunc             func(ctx context.Context, chainDenom string) (domain.PoolDenomMetaData, error)
+	GetPoolLiquidityCapFunc              func(ctx context.Context, chainDenom string) (osmomath.Int, error)
+	GetPoolDenomsMetadataFunc            func(ctx context.Context, chainDenoms []string) domain.PoolDenomMetaDataMap
+	GetFullPoolDenomMetadataFunc         func(ctx context.Context) domain.PoolDenomMetaDataMap
+	GetQuotePoolDenomsMetadataFunc       func(ctx context.Context, quoteDenom string, chainDenoms []string) domain.PoolDenomMetaDataMap
+	GetFullQuotePoolDenomMetadataFunc    func(ctx context.Context, quoteDenom string) domain.PoolDenomMetaDataMap
 	RegisterPricingStrategyFunc          func(source domain.PricingSourceType, strategy domain.PricingSource)
 	IsValidChainDenomFunc                func(chainDenom string) bool
 	IsValidPricingSourceFunc             func(pricingSource int) bool
@@ -31,6 +36,9 @@ type TokensUsecaseMock struct {
 	UpdateAssetsAtHeightIntervalSyncFunc func(height uint64) error
 	SetTokenRegistryLoaderFunc           func(loader domain.TokenRegistryLoader)
 	ClearPoolDenomMetadataFunc           func()
+	GetPoolDenomMetadataSnapshotFunc     func() (domain.PoolDenomMetaDataMap, map[string]domain.PoolDenomMetaDataMap)
+	SetTokenMarketsSourcesFunc           func(poolsUsecase mvc.PoolsUsecase, priceHistoryUsecase mvc.PriceHistoryUsecase, defaultQuoteDenom string)
+	GetTokenMarketsFunc                  func(ctx context.Context, opts ...domain.TokenMarketsOption) ([]domain.TokenMarket, uint64, error)
 }
 
 var _ mvc.TokensUsecase = &TokensUsecaseMock{}
@@ -41,12 +49,24 @@ func (m *TokensUsecaseMock) UpdatePoolDenomMetadata(tokensMetadata domain.PoolDe
 	}
 }
 
+func (m *TokensUsecaseMock) UpdateQuotePoolDenomMetadata(quoteDenom string, tokensMetadata domain.PoolDenomMetaDataMap) {
+	if m.UpdateQuotePoolDenomMetadataFunc != nil {
+		m.UpdateQuotePoolDenomMetadataFunc(quoteDenom, tokensMetadata)
+	}
+}
+
 func (m *TokensUsecaseMock) LoadTokens(tokenMetadataByChainDenom map[string]domain.Token) {
 	if m.LoadTokensFunc != nil {
 		m.LoadTokensFunc(tokenMetadataByChainDenom)
 	}
 }
 
+func (m *TokensUsecaseMock) LoadUnverifiedTokens(tokenMetadataByChainDenom map[string]domain.Token) {
+	if m.LoadUnverifiedTokensFunc != nil {
+		m.LoadUnverifiedTokensFunc(tokenMetadataByChainDenom)
+	}
+}
+
 func (m *TokensUsecaseMock) GetMetadataByChainDenom(denom string) (domain.Token, error) {
 	if m.GetMetadataByChainDenomFunc != nil {
 		return m.GetMetadataByChainDenomFunc(denom)
@@ -89,37 +109,58 @@ func (m *TokensUsecaseMock) GetPrices(ctx context.Context, baseDenoms []string,
 	return domain.PricesResult{}, nil
 }
 
-func (m *TokensUsecaseMock) GetMinPoolLiquidityCap(denomA, denomB string) (uint64, error) {
+func (m *TokensUsecaseMock) GetPriceDetails(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PriceDetailsResult, error) {
+	if m.GetPriceDetailsFunc != nil {
+		return m.GetPriceDetailsFunc(ctx, baseDenoms, quoteDenoms, pricingSourceType, opts...)
+	}
+	return domain.PriceDetailsResult{}, nil
+}
+
+func (m *TokensUsecaseMock) GetMinPoolLiquidityCap(ctx context.Context, denomA, denomB string) (uint64, error) {
 	if m.GetMinPoolLiquidityCapFunc != nil {
-		return m.GetMinPoolLiquidityCapFunc(denomA, denomB)
+		return m.GetMinPoolLiquidityCapFunc(ctx, denomA, denomB)
 	}
 	return 0, nil
 }
 
-func (m *TokensUsecaseMock) GetPoolDenomMetadata(chainDenom string) (domain.PoolDenomMetaData, error) {
+func (m *TokensUsecaseMock) GetPoolDenomMetadata(ctx context.Context, chainDenom string) (domain.PoolDenomMetaData, error) {
 	if m.GetPoolDenomMetadataFunc != nil {
-		return m.GetPoolDenomMetadataFunc(chainDenom)
+		return m.GetPoolDenomMetadataFunc(ctx, chainDenom)
 	}
 	return domain.PoolDenomMetaData{}, nil
 }
 
-func (m *TokensUsecaseMock) GetPoolLiquidityCap(chainDenom string) (osmomath.Int, error) {
+func (m *TokensUsecaseMock) GetPoolLiquidityCap(ctx context.Context, chainDenom string) (osmomath.Int, error) {
 	if m.GetPoolLiquidityCapFunc != nil {
-		return m.GetPoolLiquidityCapFunc(chainDenom)
+		return m.GetPoolLiquidityCapFunc(ctx, chainDenom)
 	}
 	return osmomath.Int{}, nil
 }
 
-func (m *TokensUsecaseMock) GetPoolDenomsMetadata(chainDenoms []string) domain.PoolDenomMetaDataMap {
+func (m *TokensUsecaseMock) GetPoolDenomsMetadata(ctx context.Context, chainDenoms []string) domain.PoolDenomMetaDataMap {
 	if m.GetPoolDenomsMetadataFunc != nil {
-		return m.GetPoolDenomsMetadataFunc(chainDenoms)
+		return m.GetPoolDenomsMetadataFunc(ctx, chainDenoms)
 	}
 	return domain.PoolDenomMetaDataMap{}
 }
 
-func (m *TokensUsecaseMock) GetFullPoolDenomMetadata() domain.PoolDenomMetaDataMap {
+func (m *TokensUsecaseMock) GetFullPoolDenomMetadata(ctx context.Context) domain.PoolDenomMetaDataMap {
 	if m.GetFullPoolDenomMetadataFunc != nil {
-		return m.GetFullPoolDenomMetadataFunc()
+		return m.GetFullPoolDenomMetadataFunc(ctx)
+	}
+	return domain.PoolDenomMetaDataMap{}
+}
+
+func (m *TokensUsecaseMock) GetQuotePoolDenomsMetadata(ctx context.Context, quoteDenom string, chainDenoms []string) domain.PoolDenomMetaDataMap {
+	if m.GetQuotePoolDenomsMetadataFunc != nil {
+		return m.GetQuotePoolDenomsMetadataFunc(ctx, quoteDenom, chainDenoms)
+	}
+	return domain.PoolDenomMetaDataMap{}
+}
+
+func (m *TokensUsecaseMock) GetFullQuotePoolDenomMetadata(ctx context.Context, quoteDenom string) domain.PoolDenomMetaDataMap {
+	if m.GetFullQuotePoolDenomMetadataFunc != nil {
+		return m.GetFullQuotePoolDenomMetadataFunc(ctx, quoteDenom)
 	}
 	return domain.PoolDenomMetaDataMap{}
 }
@@ -172,3 +213,25 @@ func (m *TokensUsecaseMock) ClearPoolDenomMetadata() {
 	}
 	panic("unimplemented")
 }
+
+func (m *TokensUsecaseMock) GetPoolDenomMetadataSnapshot() (domain.PoolDenomMetaDataMap, map[string]domain.PoolDenomMetaDataMap) {
+	if m.GetPoolDenomMetadataSnapshotFunc != nil {
+		return m.GetPoolDenomMetadataSnapshotFunc()
+	}
+	return nil, nil
+}
+
+// SetTokenMarketsSources implements mvc.TokensUsecase.
+func (m *TokensUsecaseMock) SetTokenMarketsSources(poolsUsecase mvc.PoolsUsecase, priceHistoryUsecase mvc.PriceHistoryUsecase, defaultQuoteDenom string) {
+	if m.SetTokenMarketsSourcesFunc != nil {
+		m.SetTokenMarketsSourcesFunc(poolsUsecase, priceHistoryUsecase, defaultQuoteDenom)
+	}
+}
+
+// GetTokenMarkets implements mvc.TokensUsecase.
+func (m *TokensUsecaseMock) GetTokenMarkets(ctx context.Context, opts ...domain.TokenMarketsOption) ([]domain.TokenMarket, uint64, error) {
+	if m.GetTokenMarketsFunc != nil {
+		return m.GetTokenMarketsFunc(ctx, opts...)
+	}
+	return nil, 0, nil
+}
@@ -10,6 +10,10 @@ import (
 
 // IngestUsecase represent the ingest's usecases
 type IngestUsecase interface {
+	// PoolRoutingConfigUpdateListener re-sorts the pools and recomputes the search data for all denoms
+	// when the pool routing config is updated at runtime.
+	domain.PoolRoutingConfigUpdateListener
+
 	// ProcessBlockData processes the block data as defined by height, takerFeesMap and poolData
 	// Prior to loading pools into the repository, the pools are transformed and instrumented with pool TVL data.
 	ProcessBlockData(ctx context.Context, height uint64, takerFeesMap sqsdomain.TakerFeeMap, poolData []*types.PoolData) (err error)
@@ -17,4 +21,38 @@ type IngestUsecase interface {
 	// RegisterEndBlockProcessPlugin registers the end block process plugin
 	// That is called at the end of the block
 	RegisterEndBlockProcessPlugin(plugin domain.EndBlockProcessPlugin)
+
+	// AcceptBlock records the height received from the given source and returns true
+	// if the block should be processed. Each height is processed successfully at most once across all sources.
+	// Returns false if the height is lower or equal to the latest accepted height or lower than a height being processed.
+	// If the same height is being processed from another source, it blocks until that processing completes
+	// and returns true only if it failed.
+	// Height regressions within a source and gaps between accepted heights are reported
+	// but do not prevent the block from being accepted.
+	// CONTRACT: CompleteBlock must be called for every accepted block.
+	AcceptBlock(ctx context.Context, source string, height uint64) bool
+
+	// CompleteBlock completes the processing of the accepted block at the given height.
+	// The height is marked as accepted only if err is nil. Otherwise, the same height
+	// pushed by another source can be accepted.
+	CompleteBlock(height uint64, err error)
+
+	// GetSourcesStatus returns the status of all sources that have pushed blocks, sorted by source.
+	GetSourcesStatus() []domain.IngestSourceStatus
+}
+
+// StateSnapshotUsecase publishes and serves the immutable per-height state snapshots.
+// A snapshot is published once the pools, taker fees, sorted pools and candidate route search data
+// of a block have all been updated so that readers never observe data from different heights.
+type StateSnapshotUsecase interface {
+	domain.CandidateRouteSearchDataUpdateListener
+
+	// GetLatestStateSnapshot returns the latest published state snapshot.
+	// Returns false if no snapshot has been published yet.
+	GetLatestStateSnapshot() (*domain.StateSnapshot, bool)
+
+	// GetStateSnapshot returns the state snapshot published at the given height.
+	// Only the snapshots of the most recent heights are retained.
+	// Returns false if no snapshot is retained for the height.
+	GetStateSnapshot(height uint64) (*domain.StateSnapshot, bool)
 }
@@ -0,0 +1,20 @@
+package mvc
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+// PoolChangeStreamUsecase pushes the pools updated within each ingested block to the subscribers.
+type PoolChangeStreamUsecase interface {
+	domain.EndBlockProcessPlugin
+
+	// Subscribe returns a channel receiving the pool changes matching the given filter, starting
+	// with the retained changes at or above fromHeight followed by the changes of the subsequent blocks.
+	// Zero fromHeight only subscribes to the subsequent blocks.
+	// The channel is closed once the context is done or if the subscriber falls behind
+	// by more than the configured buffer, after which it may resume from the next height.
+	// Returns domain.PoolChangesHeightNotRetainedError if fromHeight is below the retained window.
+	Subscribe(ctx context.Context, filter domain.PoolChangesFilter, fromHeight uint64) (<-chan domain.PoolChangesEvent, error)
+}
@@ -0,0 +1,30 @@
+package mvc
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+// PoolHealthTracker tracks the errors and the outlier results returned by the pools in the router
+// and quarantines the pools that misbehave beyond the configured thresholds.
+// Quarantined pools are excluded from the candidate routes until they behave
+// for the configured number of blocks.
+type PoolHealthTracker interface {
+	domain.EndBlockProcessPlugin
+
+	// RecordError records an error returned by the given pool.
+	RecordError(poolID uint64)
+	// RecordTokenOut records the amount out returned by the given pool and the amount out implied
+	// by its spot price, counting an outlier if the former exceeds the latter beyond the configured tolerance.
+	// Returns true if the result is an outlier.
+	RecordTokenOut(poolID uint64, tokenOutAmount osmomath.Int, spotPriceImpliedTokenOutAmount osmomath.Dec) bool
+	// IsQuarantined returns true if the given pool is excluded from the candidate routes.
+	IsQuarantined(poolID uint64) bool
+	// GetPoolHealthStatuses returns the health of the pools that either misbehaved within
+	// the current window, are quarantined or have an override, sorted by pool ID.
+	GetPoolHealthStatuses() []domain.PoolHealthStatus
+	// SetOverride sets the manual override of the quarantine state of the given pool.
+	// domain.PoolHealthOverrideNone clears the override.
+	SetOverride(poolID uint64, override domain.PoolHealthOverride)
+}
@@ -0,0 +1,19 @@
+package mvc
+
+import "github.com/osmosis-labs/sqs/domain"
+
+// PoolHistoryUsecase keeps a fixed-size history of the pool states sampled at the end of the ingested blocks.
+type PoolHistoryUsecase interface {
+	domain.EndBlockProcessPlugin
+
+	// GetPoolHistory returns the samples of the given pool within the given inclusive height range,
+	// ordered from the oldest to the newest. Zero heights mean unbounded.
+	// If maxPoints is positive and the samples exceed it, they are downsampled to at most maxPoints
+	// by keeping the latest sample of each equally-sized height bucket.
+	// Returns domain.PoolNotFoundError if the pool does not exist.
+	GetPoolHistory(poolID uint64, fromHeight, toHeight uint64, maxPoints int) ([]domain.PoolHistorySample, error)
+
+	// StorePoolHistory persists the pool history to the configured state file.
+	// No-op if the state file is not configured.
+	StorePoolHistory() error
+}
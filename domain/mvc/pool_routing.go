@@ -0,0 +1,25 @@
+package mvc
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+// PoolRoutingUsecase manages the denied and preferred pool IDs and the supported CosmWasm code IDs
+// that can be updated at runtime on top of the static config.
+// The runtime changes are persisted as an overlay so that they survive restarts.
+type PoolRoutingUsecase interface {
+	// GetPoolRoutingConfig returns the effective pool routing config, that is the static config with the overlay applied.
+	GetPoolRoutingConfig() domain.PoolRoutingConfig
+	// GetPoolRoutingOverlay returns the runtime changes applied on top of the static config.
+	GetPoolRoutingOverlay() domain.PoolRoutingOverlay
+	// AddIDs adds the given IDs to the given list, persists the overlay and notifies the listeners.
+	// Returns the updated effective config.
+	AddIDs(ctx context.Context, list domain.PoolRoutingList, ids []uint64) (domain.PoolRoutingConfig, error)
+	// RemoveIDs removes the given IDs from the given list, persists the overlay and notifies the listeners.
+	// Returns the updated effective config.
+	RemoveIDs(ctx context.Context, list domain.PoolRoutingList, ids []uint64) (domain.PoolRoutingConfig, error)
+	// RegisterListener registers the listener notified of the updates in the order of registration.
+	RegisterListener(listener domain.PoolRoutingConfigUpdateListener)
+}
@@ -20,13 +20,25 @@ type PoolsUsecase interface {
 
 	// GetRoutesFromCandidates converts candidate routes to routes intrusmented with all the data necessary for estimating
 	// a swap. This data entails the pool data, the taker fee.
-	GetRoutesFromCandidates(candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
+	GetRoutesFromCandidates(ctx context.Context, candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
 
 	GetTickModelMap(poolIDs []uint64) (map[uint64]*sqsdomain.TickModel, error)
 	// GetPool returns the pool with the given ID.
 	GetPool(poolID uint64) (sqsdomain.PoolI, error)
 	// GetPoolSpotPrice returns the spot price of the given pool given the taker fee, quote and base assets.
 	GetPoolSpotPrice(ctx context.Context, poolID uint64, takerFee osmomath.Dec, quoteAsset, baseAsset string) (osmomath.BigDec, error)
+	// GetConcentratedPoolDepthChart returns the depth chart of the concentrated pool with the given ID
+	// with the cumulative depths computed for the given percentages from the current price.
+	// Returns error if the pool is not concentrated or if its tick model is not set.
+	GetConcentratedPoolDepthChart(ctx context.Context, poolID uint64, depthPercents []osmomath.Dec) (domain.ConcentratedDepthChart, error)
+	// SimulateConcentratedPosition simulates creating a position in the concentrated pool with the given ID
+	// within the given chain price range by providing the given token.
+	// Returns the amount of the other token required, the liquidity, whether the position is active and its estimated fee APR.
+	SimulateConcentratedPosition(ctx context.Context, poolID uint64, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error)
+	// GetConcentratedPositionAssets returns the assets held by the concentrated liquidity position with the given ID
+	// at the current price of its pool. The claimable rewards are not included.
+	// Returns error if the position cannot be fetched or if its pool is not found.
+	GetConcentratedPositionAssets(ctx context.Context, positionID uint64) (sdk.Coins, error)
 
 	GetCosmWasmPoolConfig() domain.CosmWasmPoolRouterConfig
 
@@ -43,6 +55,10 @@ type PoolsUsecase interface {
 	// IsCanonicalOrderbookPool returns true if the given pool ID is a canonical orderbook pool
 	// for some token pair.
 	IsCanonicalOrderbookPool(poolID uint64) bool
+
+	// GetAlloyedPoolID returns the ID of the alloyed transmuter pool minting the given alloyed denom.
+	// Returns error if no such pool is stored.
+	GetAlloyedPoolID(alloyedDenom string) (uint64, error)
 }
 
 type PoolHandler interface {
@@ -56,6 +72,11 @@ type PoolHandler interface {
 	// CalcExitCFMMPool estimates the coins returned from redeeming CFMM pool shares given a pool ID and the GAMM shares to convert
 	// poolID must be a CFMM pool. Returns error if not.
 	CalcExitCFMMPool(poolID uint64, exitingShares osmomath.Int) (sdk.Coins, error)
+
+	// CalcJoinPool estimates the shares received from joining a CFMM pool given a pool ID and the tokens to join.
+	// The tokens must either be a single pool token or all of the pool tokens.
+	// poolID must be a CFMM pool. Returns error if not.
+	CalcJoinPool(poolID uint64, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error)
 }
 
 type CandidateRouteSearchPoolHandler interface {
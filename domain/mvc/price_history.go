@@ -0,0 +1,20 @@
+package mvc
+
+import "github.com/osmosis-labs/sqs/domain"
+
+// PriceHistoryUsecase folds the prices computed by the pricing worker at the end of the ingested blocks
+// into fixed-size histories of candles at every supported resolution.
+type PriceHistoryUsecase interface {
+	domain.PricingUpdateListener
+
+	// GetPriceCandles returns the candles of the given base denom in terms of the given quote denom
+	// at the given resolution that open within the given inclusive unix time range in seconds,
+	// ordered from the oldest to the newest. Zero times mean unbounded.
+	// The newest candle may still be open.
+	// Returns an empty slice if no prices were recorded for the denoms.
+	GetPriceCandles(baseDenom, quoteDenom string, resolution domain.PriceCandleResolution, from, to int64) []domain.PriceCandle
+
+	// StorePriceHistory persists the price history to the configured state file.
+	// No-op if the state file is not configured.
+	StorePriceHistory() error
+}
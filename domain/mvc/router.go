@@ -53,12 +53,19 @@ type SimpleRouterUsecase interface {
 
 	// GetPoolSpotPrice returns the spot price of a pool.
 	GetPoolSpotPrice(ctx context.Context, poolID uint64, quoteAsset, baseAsset string) (osmomath.BigDec, error)
+
+	// GetPoolLiquidityCap returns the liquidity capitalization of a pool.
+	GetPoolLiquidityCap(ctx context.Context, poolID uint64) (osmomath.Int, error)
 }
 
 // RouterUsecase represent the router's usecases
 type RouterUsecase interface {
 	SimpleRouterUsecase
 
+	// PoolRoutingConfigUpdateListener flushes the route caches
+	// when the pool routing config is updated at runtime.
+	domain.PoolRoutingConfigUpdateListener
+
 	// GetOptimalQuote returns the optimal quote for the given tokenIn and tokenOutDenom.
 	GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error)
 
@@ -103,7 +110,7 @@ type RouterUsecase interface {
 	// It is used to filter out pools with liquidity less than the output of this function.
 	// Returns error if one of the denom metadata is not found.
 	// Returns error if the filter is not found for the given denoms.
-	GetMinPoolLiquidityCapFilter(tokenInDenom, tokenOutDenom string) (uint64, error)
+	GetMinPoolLiquidityCapFilter(ctx context.Context, tokenInDenom, tokenOutDenom string) (uint64, error)
 
 	// ConvertMinTokensPoolLiquidityCapToFilter converts the minTokensPoolLiquidityCap to a filter.
 	// It is used to filter out pools with liquidity less than the output of this function.
@@ -117,4 +124,8 @@ type RouterUsecase interface {
 	// CONTRACT: the pools are already sorted according to the desired parameters.
 	// See sortPools() function.
 	SetSortedPools(pools []sqsdomain.PoolI)
+
+	// SetPoolHealthTracker sets the tracker recording the errors and outlier results of the pools
+	// and excludes the quarantined pools from the candidate routes.
+	SetPoolHealthTracker(tracker PoolHealthTracker)
 }
@@ -15,13 +15,18 @@ type TokensPoolLiquidityHandler interface {
 	// denom results stored internally, if any. The denoms metadata that is present internally
 	// but not in the provided map will be left unchanged.
 	UpdatePoolDenomMetadata(tokensMetadata domain.PoolDenomMetaDataMap)
+
+	// UpdateQuotePoolDenomMetadata is the same as UpdatePoolDenomMetadata but for the pool denom metadata
+	// priced in the given quote denom other than the default one.
+	UpdateQuotePoolDenomMetadata(quoteDenom string, tokensMetadata domain.PoolDenomMetaDataMap)
 }
 
 type TokenMetadataHolder interface {
 	// GetMinPoolLiquidityCap returns the min pool liquidity capitalization between the two denoms.
 	// Returns error if there is no pool liquidity metadata for one of the tokens.
 	// Returns error if pool liquidity metadata is large enough to cause overflow.
-	GetMinPoolLiquidityCap(denomA, denomB string) (uint64, error)
+	// The metadata is read from the state snapshot pinned in the context, if any.
+	GetMinPoolLiquidityCap(ctx context.Context, denomA, denomB string) (uint64, error)
 }
 
 // TokensUsecase defines an interface for the tokens usecase.
@@ -32,6 +37,11 @@ type TokensUsecase interface {
 	// LoadTokens loads token meta data by chain denom into tokensUseCase.
 	LoadTokens(tokenMetadataByChainDenom map[string]domain.Token)
 
+	// LoadUnverifiedTokens loads the token meta data resolved from the chain by chain denom into tokensUseCase.
+	// Contrary to LoadTokens, the chain denoms and human denoms that are already known are left unchanged
+	// so that the token registry always takes precedence.
+	LoadUnverifiedTokens(tokenMetadataByChainDenom map[string]domain.Token)
+
 	// GetMetadataByChainDenom returns token metadata for a given chain denom.
 	GetMetadataByChainDenom(denom string) (domain.Token, error)
 
@@ -59,22 +69,36 @@ type TokensUsecase interface {
 	// The result of the inner map is prices of the outer base and inner quote.
 	GetPrices(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PricesResult, error)
 
+	// GetPriceDetails is the same as GetPrices but returns the details of the computation together with every price.
+	// The pricing sources that do not implement domain.DetailedPricingSource only report the price and the source that computed it.
+	GetPriceDetails(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PriceDetailsResult, error)
+
 	// GetPoolDenomMetadata returns the pool denom metadata of a pool denom.
 	// This metadata is accumulated from all pools.
-	GetPoolDenomMetadata(chainDenom string) (domain.PoolDenomMetaData, error)
+	// The pool denom metadata getters read the metadata captured by the state snapshot pinned in the context, if any,
+	// and the latest metadata otherwise.
+	GetPoolDenomMetadata(ctx context.Context, chainDenom string) (domain.PoolDenomMetaData, error)
 
 	// GetPoolLiquidityCap returns the pool liquidity market cap for a given chain denom.
 	// This value is accumulated from all Osmosis pools.
-	GetPoolLiquidityCap(chainDenom string) (osmomath.Int, error)
+	GetPoolLiquidityCap(ctx context.Context, chainDenom string) (osmomath.Int, error)
 
 	// GetPoolDenomsMetadata returns the pool denom metadata for the given chain denoms.
 	// These values are accumulated from all Osmosis pools.
-	GetPoolDenomsMetadata(chainDenoms []string) domain.PoolDenomMetaDataMap
+	GetPoolDenomsMetadata(ctx context.Context, chainDenoms []string) domain.PoolDenomMetaDataMap
 
 	// GetFullPoolDenomMetadata returns the local market caps for all chain denoms.
 	// For any valid (per the asset list) denom, if there is no metadata, it will be set to empty
 	// and all values such as local market cap will be set to zero.
-	GetFullPoolDenomMetadata() domain.PoolDenomMetaDataMap
+	GetFullPoolDenomMetadata(ctx context.Context) domain.PoolDenomMetaDataMap
+
+	// GetQuotePoolDenomsMetadata is the same as GetPoolDenomsMetadata but for the pool denom metadata
+	// priced in the given quote denom other than the default one.
+	GetQuotePoolDenomsMetadata(ctx context.Context, quoteDenom string, chainDenoms []string) domain.PoolDenomMetaDataMap
+
+	// GetFullQuotePoolDenomMetadata is the same as GetFullPoolDenomMetadata but for the pool denom metadata
+	// priced in the given quote denom other than the default one.
+	GetFullQuotePoolDenomMetadata(ctx context.Context, quoteDenom string) domain.PoolDenomMetaDataMap
 
 	// RegisterPricingStrategy registers a pricing strategy for a given pricing source.
 	RegisterPricingStrategy(source domain.PricingSourceType, strategy domain.PricingSource)
@@ -91,11 +115,28 @@ type TokensUsecase interface {
 	// WARNING: use with caution, this will clear all pool denom metadata
 	ClearPoolDenomMetadata()
 
+	// GetPoolDenomMetadataSnapshot returns a copy of the latest pool denom metadata priced in the default quote denom
+	// and in the other quote denoms by quote denom, to be captured by the state snapshots.
+	GetPoolDenomMetadataSnapshot() (domain.PoolDenomMetaDataMap, map[string]domain.PoolDenomMetaDataMap)
+
 	// UpdateAssetsAtHeightIntervalSync updates assets at configured height interval.
 	UpdateAssetsAtHeightIntervalSync(height uint64) error
 
 	// SetTokenRegistryLoader sets the token registry loader.
 	SetTokenRegistryLoader(loader domain.TokenRegistryLoader)
+
+	// SetTokenMarketsSources sets the sources of the token markets: the pools usecase to count the pools of every token
+	// and the price history usecase to compute the price changes in the default quote denom.
+	// The price history usecase is nil if the price history is disabled.
+	SetTokenMarketsSources(poolsUsecase PoolsUsecase, priceHistoryUsecase PriceHistoryUsecase, defaultQuoteDenom string)
+
+	// GetTokenMarkets returns the market stats of the tokens filtered by the search, sorted and paginated per the options
+	// together with the total number of tokens matching the search.
+	// Given a search, the best matches come first unless sort fields are given.
+	// Otherwise, the tokens are sorted by liquidity capitalization in descending order.
+	// The pools and the pool denom metadata are read from the state snapshot pinned in the context, if any.
+	// Returns domain.UnsupportedTokenMarketsSortFieldError if sorted by an unsupported field.
+	GetTokenMarkets(ctx context.Context, opts ...domain.TokenMarketsOption) ([]domain.TokenMarket, uint64, error)
 }
 
 // ValidateChainDenomQueryParam validates the chain denom query parameter.
@@ -136,6 +177,12 @@ func ValidateChainDenomsQueryParam(c echo.Context, tokensUsecase TokensUsecase,
 		return nil, err
 	}
 
+	return ValidateChainDenoms(tokensUsecase, denoms, isHumanDenoms)
+}
+
+// ValidateChainDenoms validates the given denoms, converting them from human to chain denoms if isHumanDenoms is true.
+// Returns the chain denoms in the same order as given.
+func ValidateChainDenoms(tokensUsecase TokensUsecase, denoms []string, isHumanDenoms bool) ([]string, error) {
 	chainDenoms := make([]string, len(denoms))
 	for i, denom := range denoms {
 		chainDenom, err := ValidateChainDenomQueryParam(tokensUsecase, denom, isHumanDenoms)
@@ -9,6 +9,8 @@ import (
 // of the portfolio assets.
 type PortfolioAssetsResult struct {
 	Categories map[string]PortfolioAssetsCategoryResult `json:"categories"`
+	// Height is the height of the state the request was served at.
+	Height uint64 `json:"height,omitempty"`
 }
 
 // PortfolioAssetsCategoryResult represents the total value of the assets in the portfolio.
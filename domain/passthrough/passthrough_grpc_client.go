@@ -10,6 +10,7 @@ import (
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	math "github.com/osmosis-labs/osmosis/osmomath"
 	concentratedLiquidity "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/client/queryproto"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
 	lockup "github.com/osmosis-labs/osmosis/v27/x/lockup/types"
 	polarisgrpc "github.com/osmosis-labs/sqs/delivery/grpc"
 	"google.golang.org/grpc"
@@ -36,6 +37,9 @@ type PassthroughGRPCClient interface {
 	// The first return is the pooled balance. The second return is the reward balance.
 	UserPositionsBalances(ctx context.Context, address string) (sdk.Coins, sdk.Coins, error)
 
+	// PositionByID returns the concentrated liquidity position with the given ID.
+	PositionByID(ctx context.Context, positionID uint64) (concentratedmodel.Position, error)
+
 	// DelegationTotalRewards returns the total unclaimed staking rewards accrued of the user with the given address.
 	DelegationRewards(ctx context.Context, address string) (sdk.Coins, error)
 
@@ -177,6 +181,15 @@ func (p *passthroughGRPCClient) UserPositionsBalances(ctx context.Context, addre
 	return pooledCoins, rewardCoins, nil
 }
 
+func (p *passthroughGRPCClient) PositionByID(ctx context.Context, positionID uint64) (concentratedmodel.Position, error) {
+	response, err := p.concentratedLiquidityQueryClient.PositionById(ctx, &concentratedLiquidity.PositionByIdRequest{PositionId: positionID})
+	if err != nil {
+		return concentratedmodel.Position{}, err
+	}
+
+	return response.Position.Position, nil
+}
+
 func (p *passthroughGRPCClient) DelegationRewards(ctx context.Context, address string) (sdk.Coins, error) {
 	response, err := p.distributionClient.DelegationTotalRewards(
 		ctx,
@@ -76,19 +76,25 @@ func (dt *SyncMapTransformer[K, V]) Sort(less ...func(V, V) bool) *SyncMapTransf
 		return dt // no sorting required
 	}
 
-	sort.Slice(dt.keys, func(i, j int) bool {
+	// Subsequent criteria break the ties of the preceding ones.
+	sort.SliceStable(dt.keys, func(i, j int) bool {
+		vi, ok := dt.load(dt.keys[i])
+		if !ok {
+			return false
+		}
+
+		vj, ok := dt.load(dt.keys[j])
+		if !ok {
+			return false
+		}
+
 		for _, criterion := range less {
-			vi, ok := dt.load(dt.keys[i])
-			if !ok {
-				continue
+			if criterion(vi, vj) {
+				return true
 			}
-
-			vj, ok := dt.load(dt.keys[j])
-			if !ok {
-				continue
+			if criterion(vj, vi) {
+				return false
 			}
-
-			return criterion(vi, vj)
 		}
 		return false
 	})
@@ -215,27 +215,37 @@ func TestMapTransformerSort(t *testing.T) {
 	tests := []struct {
 		name     string
 		data     []int
-		less     func(int, int) bool
+		less     []func(int, int) bool
 		expected []int
 	}{
 		{
 			name:     "Sort integers ascending",
 			data:     []int{3, 1, 2},
-			less:     func(a, b int) bool { return a < b },
+			less:     []func(int, int) bool{func(a, b int) bool { return a < b }},
 			expected: []int{1, 2, 3},
 		},
 		{
 			name:     "Sort integers descending",
 			data:     []int{3, 1, 2},
-			less:     func(a, b int) bool { return a > b },
+			less:     []func(int, int) bool{func(a, b int) bool { return a > b }},
 			expected: []int{3, 2, 1},
 		},
 		{
 			name:     "Sort with equal values",
 			data:     []int{1, 2, 1},
-			less:     func(a, b int) bool { return a < b },
+			less:     []func(int, int) bool{func(a, b int) bool { return a < b }},
 			expected: []int{1, 1, 2},
 		},
+		{
+			name: "Sort by multiple criteria",
+			data: []int{13, 21, 11, 22, 12},
+			less: []func(int, int) bool{
+				// By tens descending, then by units ascending.
+				func(a, b int) bool { return a/10 > b/10 },
+				func(a, b int) bool { return a%10 < b%10 },
+			},
+			expected: []int{21, 22, 11, 12, 13},
+		},
 	}
 
 	for _, tt := range tests {
@@ -245,7 +255,7 @@ func TestMapTransformerSort(t *testing.T) {
 				m.Store(k, v)
 			}
 			transformer := NewSyncMapTransformer[int, int](&m)
-			transformer.Sort(tt.less)
+			transformer.Sort(tt.less...)
 
 			got := transformer.Data()
 			require.Equal(t, tt.expected, got, "Expected %v, but got %v", tt.expected, got)
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
+)
+
+// PoolChangeStreamConfig is the config for the pool change stream.
+type PoolChangeStreamConfig struct {
+	// Enabled defines whether the pool change stream is served.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RetainedBlocks is the number of the most recent blocks whose pool changes are retained
+	// so that the subscribers can resume from them.
+	RetainedBlocks int `mapstructure:"retained-blocks"`
+
+	// SubscriberBufferSize is the number of events buffered per subscriber.
+	// Subscribers falling behind by more than the buffer are disconnected and have to resume.
+	SubscriberBufferSize int `mapstructure:"subscriber-buffer-size"`
+}
+
+// Validate validates the pool change stream config.
+func (c PoolChangeStreamConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.RetainedBlocks <= 0 {
+		return errors.New("pool change stream retained blocks must be positive")
+	}
+
+	if c.SubscriberBufferSize <= 0 {
+		return errors.New("pool change stream subscriber buffer size must be positive")
+	}
+
+	return nil
+}
+
+// PoolChange is the state of a pool updated within a block.
+type PoolChange struct {
+	PoolID       uint64                    `json:"pool_id"`
+	Type         poolmanagertypes.PoolType `json:"type"`
+	Denoms       []string                  `json:"denoms"`
+	Balances     sdk.Coins                 `json:"balances"`
+	LiquidityCap osmomath.Int              `json:"liquidity_cap"`
+	// SpotPrice is the spot price of the first pool denom in terms of the second pool denom.
+	// Zero if it fails to be computed.
+	SpotPrice osmomath.BigDec `json:"spot_price"`
+}
+
+// PoolChangesEvent contains the pools updated within the block at the given height, sorted by pool ID.
+// If Gap is set, the event contains no pools and denotes that the pool changes of the blocks
+// from the gap's from height up to and including the event height are unavailable.
+type PoolChangesEvent struct {
+	Height uint64          `json:"height"`
+	Pools  []PoolChange    `json:"pools"`
+	Gap    *PoolChangesGap `json:"gap,omitempty"`
+}
+
+// PoolChangesGap denotes the range of blocks whose pool changes are unavailable,
+// for example because the blocks were skipped or failed to be processed.
+// Subscribers relying on every change should refetch the state of the pools they track.
+type PoolChangesGap struct {
+	FromHeight uint64 `json:"from_height"`
+}
+
+// PoolChangesFilter filters the pool changes pushed to a subscriber.
+// A pool change matches if its pool ID is one of the pool IDs and if it contains any of the denoms.
+// Empty pool IDs or denoms match all pool changes.
+type PoolChangesFilter struct {
+	PoolIDs []uint64
+	Denoms  []string
+}
+
+// Matches returns true if the given pool change matches the filter.
+func (f PoolChangesFilter) Matches(change PoolChange) bool {
+	if len(f.PoolIDs) > 0 && !slices.Contains(f.PoolIDs, change.PoolID) {
+		return false
+	}
+
+	if len(f.Denoms) == 0 {
+		return true
+	}
+
+	for _, denom := range change.Denoms {
+		if slices.Contains(f.Denoms, denom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter returns the event with the pool changes not matching the filter removed.
+// Returns false if no pool change matches. Gap events always match.
+func (f PoolChangesFilter) Filter(event PoolChangesEvent) (PoolChangesEvent, bool) {
+	if event.Gap != nil {
+		return event, true
+	}
+
+	if len(f.PoolIDs) == 0 && len(f.Denoms) == 0 {
+		return event, len(event.Pools) > 0
+	}
+
+	pools := make([]PoolChange, 0, len(event.Pools))
+	for _, change := range event.Pools {
+		if f.Matches(change) {
+			pools = append(pools, change)
+		}
+	}
+
+	return PoolChangesEvent{Height: event.Height, Pools: pools}, len(pools) > 0
+}
+
+// PoolChangesHeightNotRetainedError is returned when subscribing to the pool changes
+// from a height that is not retained.
+// OldestRetainedHeight is zero if no pool changes are retained yet.
+type PoolChangesHeightNotRetainedError struct {
+	Height               uint64
+	OldestRetainedHeight uint64
+}
+
+func (e PoolChangesHeightNotRetainedError) Error() string {
+	if e.OldestRetainedHeight == 0 {
+		return fmt.Sprintf("pool changes at height (%d) are not retained, no pool changes are retained yet", e.Height)
+	}
+	return fmt.Sprintf("pool changes at height (%d) are not retained, oldest retained height is (%d)", e.Height, e.OldestRetainedHeight)
+}
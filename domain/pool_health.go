@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v27/x/gamm/types"
+)
+
+// PoolHealthConfig is the config for tracking the health of the pools in the router
+// and quarantining the misbehaving ones.
+type PoolHealthConfig struct {
+	// Enabled defines whether the pool health is tracked and the misbehaving pools are quarantined.
+	Enabled bool `mapstructure:"enabled"`
+
+	// ErrorThreshold is the number of errors returned by a pool within the window
+	// after which the pool is quarantined.
+	ErrorThreshold int `mapstructure:"error-threshold"`
+
+	// OutlierThreshold is the number of outlier results returned by a pool within the window
+	// after which the pool is quarantined.
+	OutlierThreshold int `mapstructure:"outlier-threshold"`
+
+	// OutlierTolerance is the fraction by which the amount out of a pool may exceed
+	// the amount implied by its spot price before the result is considered an outlier.
+	// For example, 0.5 means that an amount out more than 50% above the spot price implied one is an outlier.
+	OutlierTolerance float64 `mapstructure:"outlier-tolerance"`
+
+	// WindowBlocks is the number of blocks within which the errors and outliers are counted.
+	WindowBlocks uint64 `mapstructure:"window-blocks"`
+
+	// QuarantineBlocks is the number of blocks a quarantined pool has to behave for to be released.
+	QuarantineBlocks uint64 `mapstructure:"quarantine-blocks"`
+}
+
+// Validate validates the pool health config.
+func (c PoolHealthConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.ErrorThreshold <= 0 {
+		return errors.New("pool health error threshold must be positive")
+	}
+
+	if c.OutlierThreshold <= 0 {
+		return errors.New("pool health outlier threshold must be positive")
+	}
+
+	if c.OutlierTolerance <= 0 {
+		return errors.New("pool health outlier tolerance must be positive")
+	}
+
+	if c.WindowBlocks == 0 {
+		return errors.New("pool health window blocks must be positive")
+	}
+
+	if c.QuarantineBlocks == 0 {
+		return errors.New("pool health quarantine blocks must be positive")
+	}
+
+	return nil
+}
+
+// PoolHealthOverride is a manual override of the quarantine state of a pool.
+type PoolHealthOverride string
+
+const (
+	// PoolHealthOverrideNone means that the quarantine state of the pool is determined by its behavior.
+	PoolHealthOverrideNone PoolHealthOverride = ""
+	// PoolHealthOverrideQuarantined means that the pool is quarantined regardless of its behavior.
+	PoolHealthOverrideQuarantined PoolHealthOverride = "quarantined"
+	// PoolHealthOverrideHealthy means that the pool is never quarantined regardless of its behavior.
+	PoolHealthOverrideHealthy PoolHealthOverride = "healthy"
+)
+
+// ParsePoolHealthOverride parses the given string into a pool health override.
+// "none" and the empty string both clear the override.
+func ParsePoolHealthOverride(s string) (PoolHealthOverride, error) {
+	switch s {
+	case "", "none":
+		return PoolHealthOverrideNone, nil
+	case string(PoolHealthOverrideQuarantined):
+		return PoolHealthOverrideQuarantined, nil
+	case string(PoolHealthOverrideHealthy):
+		return PoolHealthOverrideHealthy, nil
+	default:
+		return PoolHealthOverrideNone, fmt.Errorf("invalid pool health override (%s), expected one of none, %s, %s", s, PoolHealthOverrideQuarantined, PoolHealthOverrideHealthy)
+	}
+}
+
+// PoolHealthStatus is the health of a pool tracked by the router.
+type PoolHealthStatus struct {
+	PoolID uint64 `json:"pool_id"`
+	// Errors is the number of errors returned by the pool within the current window.
+	Errors int `json:"errors"`
+	// Outliers is the number of outlier results returned by the pool within the current window.
+	Outliers int `json:"outliers"`
+	// LastMisbehaviorHeight is the height at which the pool last returned an error or an outlier result.
+	LastMisbehaviorHeight uint64 `json:"last_misbehavior_height"`
+	// Quarantined is true if the pool is excluded from the candidate routes, accounting for the override.
+	Quarantined bool `json:"quarantined"`
+	// ReleaseHeight is the height at which the pool is released from quarantine unless it misbehaves again.
+	// Zero if the pool is not quarantined automatically.
+	ReleaseHeight uint64 `json:"release_height,omitempty"`
+	// Override is the manual override of the quarantine state, if any.
+	Override PoolHealthOverride `json:"override,omitempty"`
+}
+
+// PoolCalculateTokenOutError is returned when a pool fails to calculate the token out of a route.
+// It wraps the pool error and keeps its message so that the pool can be identified by the caller.
+type PoolCalculateTokenOutError struct {
+	PoolID uint64
+	Err    error
+}
+
+func (e PoolCalculateTokenOutError) Error() string {
+	return e.Err.Error()
+}
+
+func (e PoolCalculateTokenOutError) Unwrap() error {
+	return e.Err
+}
+
+// IsAmountDriven returns true if the pool error is caused by the requested amount rather than by the pool state.
+// For example, the amount exceeding the liquidity of the pool or being too small to yield any amount out.
+// Such errors can be triggered by any caller and must not count against the health of the pool.
+func (e PoolCalculateTokenOutError) IsAmountDriven() bool {
+	var (
+		concentratedNotEnoughLiquidityErr ConcentratedNotEnoughLiquidityToCompleteSwapError
+		orderbookNotEnoughLiquidityErr    OrderbookNotEnoughLiquidityToCompleteSwapError
+		transmuterInsufficientBalanceErr  TransmuterInsufficientBalanceError
+		staticRateLimiterUpperLimitErr    StaticRateLimiterInvalidUpperLimitError
+	)
+
+	return errors.As(e.Err, &concentratedNotEnoughLiquidityErr) ||
+		errors.As(e.Err, &orderbookNotEnoughLiquidityErr) ||
+		errors.As(e.Err, &transmuterInsufficientBalanceErr) ||
+		errors.As(e.Err, &staticRateLimiterUpperLimitErr) ||
+		errors.Is(e.Err, gammtypes.ErrInvalidMathApprox)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// PoolHistoryConfig is the config for the pool history.
+type PoolHistoryConfig struct {
+	// Enabled defines whether the pool history is kept.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Capacity is the maximum number of samples kept per pool.
+	// Once reached, the oldest samples are overwritten.
+	Capacity int `mapstructure:"capacity"`
+
+	// SampleIntervalBlocks is the block interval at which the pools are sampled.
+	// Only the pools updated within the interval are sampled.
+	SampleIntervalBlocks uint64 `mapstructure:"sample-interval-blocks"`
+
+	// PersistIntervalBlocks is the block interval at which the history is persisted to the state file.
+	PersistIntervalBlocks uint64 `mapstructure:"persist-interval-blocks"`
+
+	// StateFile is the router state file the history is persisted to and restored from on startup.
+	// If empty, the history is not persisted.
+	StateFile string `mapstructure:"state-file"`
+}
+
+// Validate validates the pool history config.
+func (c PoolHistoryConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Capacity <= 0 {
+		return errors.New("pool history capacity must be positive")
+	}
+
+	if c.SampleIntervalBlocks == 0 {
+		return errors.New("pool history sample interval must be positive")
+	}
+
+	if c.StateFile != "" && c.PersistIntervalBlocks == 0 {
+		return errors.New("pool history persist interval must be positive when the state file is set")
+	}
+
+	return nil
+}
+
+// PoolHistorySample is the state of a pool sampled at the end of a block.
+type PoolHistorySample struct {
+	Height uint64 `json:"height"`
+	// Timestamp is the unix time in seconds at which the sample was taken.
+	Timestamp    int64        `json:"timestamp"`
+	LiquidityCap osmomath.Int `json:"liquidity_cap"`
+	Balances     sdk.Coins    `json:"balances"`
+	// SpotPrice is the spot price of the first pool denom in terms of the second pool denom.
+	// Zero if it fails to be computed.
+	SpotPrice osmomath.BigDec `json:"spot_price"`
+	// CurrentTick is the current tick of the pool. Only set for concentrated pools.
+	CurrentTick *int64 `json:"current_tick,omitempty"`
+}
+
+// PoolHistory is the history of the pool with the given ID ordered from the oldest to the newest sample.
+type PoolHistory struct {
+	PoolID  uint64              `json:"pool_id"`
+	Samples []PoolHistorySample `json:"samples"`
+}
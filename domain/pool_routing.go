@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// PoolRoutingList identifies a list of the pool routing config that can be changed at runtime.
+type PoolRoutingList string
+
+const (
+	// PoolRoutingListDeniedPoolIDs is the list of the pools that are never routed through.
+	PoolRoutingListDeniedPoolIDs PoolRoutingList = "denied-pool-ids"
+	// PoolRoutingListPreferredPoolIDs is the list of the pools that are prioritized in the router.
+	PoolRoutingListPreferredPoolIDs PoolRoutingList = "preferred-pool-ids"
+	// PoolRoutingListTransmuterCodeIDs is the list of the supported transmuter code IDs.
+	PoolRoutingListTransmuterCodeIDs PoolRoutingList = "transmuter-code-ids"
+	// PoolRoutingListAlloyedTransmuterCodeIDs is the list of the supported alloyed transmuter code IDs.
+	PoolRoutingListAlloyedTransmuterCodeIDs PoolRoutingList = "alloyed-transmuter-code-ids"
+	// PoolRoutingListOrderbookCodeIDs is the list of the supported orderbook code IDs.
+	PoolRoutingListOrderbookCodeIDs PoolRoutingList = "orderbook-code-ids"
+	// PoolRoutingListGeneralCosmWasmCodeIDs is the list of the supported generalized CosmWasm code IDs.
+	PoolRoutingListGeneralCosmWasmCodeIDs PoolRoutingList = "general-cosmwasm-code-ids"
+)
+
+// ParsePoolRoutingList parses the given string into a pool routing list.
+func ParsePoolRoutingList(s string) (PoolRoutingList, error) {
+	list := PoolRoutingList(s)
+	if (&PoolRoutingConfig{}).getList(list) == nil {
+		return "", fmt.Errorf("invalid pool routing list (%s), expected one of %s, %s, %s, %s, %s, %s", s,
+			PoolRoutingListDeniedPoolIDs, PoolRoutingListPreferredPoolIDs, PoolRoutingListTransmuterCodeIDs,
+			PoolRoutingListAlloyedTransmuterCodeIDs, PoolRoutingListOrderbookCodeIDs, PoolRoutingListGeneralCosmWasmCodeIDs)
+	}
+	return list, nil
+}
+
+// PoolRoutingConfig is the subset of the router and pools config that determines
+// which pools are routed through and how they are prioritized.
+type PoolRoutingConfig struct {
+	DeniedPoolIDs            []uint64 `json:"denied_pool_ids,omitempty"`
+	PreferredPoolIDs         []uint64 `json:"preferred_pool_ids,omitempty"`
+	TransmuterCodeIDs        []uint64 `json:"transmuter_code_ids,omitempty"`
+	AlloyedTransmuterCodeIDs []uint64 `json:"alloyed_transmuter_code_ids,omitempty"`
+	OrderbookCodeIDs         []uint64 `json:"orderbook_code_ids,omitempty"`
+	GeneralCosmWasmCodeIDs   []uint64 `json:"general_cosmwasm_code_ids,omitempty"`
+}
+
+// NewPoolRoutingConfig returns the pool routing config from the given router and pools config.
+func NewPoolRoutingConfig(routerConfig RouterConfig, poolsConfig PoolsConfig) PoolRoutingConfig {
+	return PoolRoutingConfig{
+		DeniedPoolIDs:            routerConfig.DeniedPoolIDs,
+		PreferredPoolIDs:         routerConfig.PreferredPoolIDs,
+		TransmuterCodeIDs:        poolsConfig.TransmuterCodeIDs,
+		AlloyedTransmuterCodeIDs: poolsConfig.AlloyedTransmuterCodeIDs,
+		OrderbookCodeIDs:         poolsConfig.OrderbookCodeIDs,
+		GeneralCosmWasmCodeIDs:   poolsConfig.GeneralCosmWasmCodeIDs,
+	}
+}
+
+// ApplyTo sets the lists of the pool routing config on the given router and pools config.
+func (c PoolRoutingConfig) ApplyTo(routerConfig *RouterConfig, poolsConfig *PoolsConfig) {
+	routerConfig.DeniedPoolIDs = c.DeniedPoolIDs
+	routerConfig.PreferredPoolIDs = c.PreferredPoolIDs
+	*poolsConfig = c.GetPoolsConfig()
+}
+
+// GetPoolsConfig returns the pools config with the code IDs of the pool routing config.
+func (c PoolRoutingConfig) GetPoolsConfig() PoolsConfig {
+	return PoolsConfig{
+		TransmuterCodeIDs:        c.TransmuterCodeIDs,
+		AlloyedTransmuterCodeIDs: c.AlloyedTransmuterCodeIDs,
+		OrderbookCodeIDs:         c.OrderbookCodeIDs,
+		GeneralCosmWasmCodeIDs:   c.GeneralCosmWasmCodeIDs,
+	}
+}
+
+// getList returns a pointer to the given list of the config or nil if the list is unknown.
+func (c *PoolRoutingConfig) getList(list PoolRoutingList) *[]uint64 {
+	switch list {
+	case PoolRoutingListDeniedPoolIDs:
+		return &c.DeniedPoolIDs
+	case PoolRoutingListPreferredPoolIDs:
+		return &c.PreferredPoolIDs
+	case PoolRoutingListTransmuterCodeIDs:
+		return &c.TransmuterCodeIDs
+	case PoolRoutingListAlloyedTransmuterCodeIDs:
+		return &c.AlloyedTransmuterCodeIDs
+	case PoolRoutingListOrderbookCodeIDs:
+		return &c.OrderbookCodeIDs
+	case PoolRoutingListGeneralCosmWasmCodeIDs:
+		return &c.GeneralCosmWasmCodeIDs
+	default:
+		return nil
+	}
+}
+
+// PoolRoutingOverlay is the set of runtime changes applied on top of the static pool routing config.
+// An ID is never both added and removed from the same list.
+type PoolRoutingOverlay struct {
+	Added   PoolRoutingConfig `json:"added"`
+	Removed PoolRoutingConfig `json:"removed"`
+}
+
+// Add records the addition of the given IDs to the given list, undoing their prior removal.
+// Returns an error if the list is unknown.
+func (o *PoolRoutingOverlay) Add(list PoolRoutingList, ids []uint64) error {
+	return o.update(list, ids, &o.Added, &o.Removed)
+}
+
+// Remove records the removal of the given IDs from the given list, undoing their prior addition.
+// Returns an error if the list is unknown.
+func (o *PoolRoutingOverlay) Remove(list PoolRoutingList, ids []uint64) error {
+	return o.update(list, ids, &o.Removed, &o.Added)
+}
+
+// Apply returns the given config with the overlay applied.
+// The removed IDs are dropped and the added ones are appended in the order they were added.
+func (o PoolRoutingOverlay) Apply(config PoolRoutingConfig) PoolRoutingConfig {
+	result := PoolRoutingConfig{}
+	for _, list := range []PoolRoutingList{
+		PoolRoutingListDeniedPoolIDs,
+		PoolRoutingListPreferredPoolIDs,
+		PoolRoutingListTransmuterCodeIDs,
+		PoolRoutingListAlloyedTransmuterCodeIDs,
+		PoolRoutingListOrderbookCodeIDs,
+		PoolRoutingListGeneralCosmWasmCodeIDs,
+	} {
+		removed := toIDSet(*o.Removed.getList(list))
+
+		ids := make([]uint64, 0)
+		seen := make(map[uint64]struct{})
+		for _, listIDs := range [][]uint64{*config.getList(list), *o.Added.getList(list)} {
+			for _, id := range listIDs {
+				if _, ok := removed[id]; ok {
+					continue
+				}
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+
+		*result.getList(list) = ids
+	}
+
+	return result
+}
+
+// update appends the given IDs to the given list of the target config
+// and drops them from the same list of the opposite config.
+// The lists are replaced rather than mutated so that the copies of the overlay are not affected.
+func (o *PoolRoutingOverlay) update(list PoolRoutingList, ids []uint64, target, opposite *PoolRoutingConfig) error {
+	targetIDs := target.getList(list)
+	if targetIDs == nil {
+		return fmt.Errorf("invalid pool routing list (%s)", list)
+	}
+	oppositeIDs := opposite.getList(list)
+
+	idSet := toIDSet(ids)
+
+	remaining := make([]uint64, 0, len(*oppositeIDs))
+	for _, id := range *oppositeIDs {
+		if _, ok := idSet[id]; !ok {
+			remaining = append(remaining, id)
+		}
+	}
+	if len(remaining) != len(*oppositeIDs) {
+		*oppositeIDs = remaining
+	}
+
+	existing := toIDSet(*targetIDs)
+	updated := make([]uint64, len(*targetIDs), len(*targetIDs)+len(ids))
+	copy(updated, *targetIDs)
+	for _, id := range ids {
+		if _, ok := existing[id]; ok {
+			continue
+		}
+		existing[id] = struct{}{}
+		updated = append(updated, id)
+	}
+	*targetIDs = updated
+
+	return nil
+}
+
+// toIDSet returns the set of the given IDs.
+func toIDSet(ids []uint64) map[uint64]struct{} {
+	set := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// PoolRoutingConfigUpdateListener defines the interface for the pool routing config update listener.
+type PoolRoutingConfigUpdateListener interface {
+	// OnPoolRoutingConfigUpdate notifies the listener of the runtime update of the pool routing config.
+	OnPoolRoutingConfigUpdate(ctx context.Context, config PoolRoutingConfig) error
+}
@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+// This test validates that the overlay adds and removes the IDs on top of the static config
+// and that the later change to an ID undoes the earlier one.
+func TestPoolRoutingOverlay(t *testing.T) {
+	baseConfig := domain.PoolRoutingConfig{
+		DeniedPoolIDs:     []uint64{1},
+		PreferredPoolIDs:  []uint64{2, 3},
+		TransmuterCodeIDs: []uint64{148},
+	}
+
+	overlay := domain.PoolRoutingOverlay{}
+
+	// Add a new ID and an existing one.
+	require.NoError(t, overlay.Add(domain.PoolRoutingListDeniedPoolIDs, []uint64{4, 1}))
+	// Remove an ID set in the static config.
+	require.NoError(t, overlay.Remove(domain.PoolRoutingListPreferredPoolIDs, []uint64{2}))
+	// Add a new code ID.
+	require.NoError(t, overlay.Add(domain.PoolRoutingListOrderbookCodeIDs, []uint64{885}))
+
+	// Copies of the overlay are not affected by the subsequent changes.
+	overlayCopy := overlay
+
+	// Removing a previously added ID undoes the addition.
+	require.NoError(t, overlay.Remove(domain.PoolRoutingListDeniedPoolIDs, []uint64{4}))
+	// Adding a previously removed ID undoes the removal.
+	require.NoError(t, overlay.Add(domain.PoolRoutingListPreferredPoolIDs, []uint64{2}))
+
+	require.Equal(t, domain.PoolRoutingConfig{
+		DeniedPoolIDs:            []uint64{1, 4},
+		PreferredPoolIDs:         []uint64{3},
+		TransmuterCodeIDs:        []uint64{148},
+		AlloyedTransmuterCodeIDs: []uint64{},
+		OrderbookCodeIDs:         []uint64{885},
+		GeneralCosmWasmCodeIDs:   []uint64{},
+	}, overlayCopy.Apply(baseConfig))
+
+	require.Equal(t, domain.PoolRoutingConfig{
+		DeniedPoolIDs:    []uint64{1},
+		PreferredPoolIDs: []uint64{2},
+		OrderbookCodeIDs: []uint64{885},
+	}, overlay.Added)
+	require.Equal(t, domain.PoolRoutingConfig{
+		DeniedPoolIDs:    []uint64{4},
+		PreferredPoolIDs: []uint64{},
+	}, overlay.Removed)
+
+	require.Equal(t, domain.PoolRoutingConfig{
+		DeniedPoolIDs:            []uint64{1},
+		PreferredPoolIDs:         []uint64{2, 3},
+		TransmuterCodeIDs:        []uint64{148},
+		AlloyedTransmuterCodeIDs: []uint64{},
+		OrderbookCodeIDs:         []uint64{885},
+		GeneralCosmWasmCodeIDs:   []uint64{},
+	}, overlay.Apply(baseConfig))
+
+	// Unknown lists are rejected.
+	require.Error(t, overlay.Add(domain.PoolRoutingList("unknown"), []uint64{1}))
+}
+
+func TestParsePoolRoutingList(t *testing.T) {
+	list, err := domain.ParsePoolRoutingList("general-cosmwasm-code-ids")
+	require.NoError(t, err)
+	require.Equal(t, domain.PoolRoutingListGeneralCosmWasmCodeIDs, list)
+
+	_, err = domain.ParsePoolRoutingList("unknown")
+	require.Error(t, err)
+}
@@ -25,6 +25,26 @@ type CosmWasmPoolRouterConfig struct {
 	ChainGRPCGatewayEndpoint string
 }
 
+// NewCosmWasmPoolRouterConfig returns the CosmWasm pool router config with the code IDs of the given pools config.
+func NewCosmWasmPoolRouterConfig(poolsConfig PoolsConfig, chainGRPCGatewayEndpoint string) CosmWasmPoolRouterConfig {
+	return CosmWasmPoolRouterConfig{
+		TransmuterCodeIDs:        codeIDsToMap(poolsConfig.TransmuterCodeIDs),
+		AlloyedTransmuterCodeIDs: codeIDsToMap(poolsConfig.AlloyedTransmuterCodeIDs),
+		OrderbookCodeIDs:         codeIDsToMap(poolsConfig.OrderbookCodeIDs),
+		GeneralCosmWasmCodeIDs:   codeIDsToMap(poolsConfig.GeneralCosmWasmCodeIDs),
+		ChainGRPCGatewayEndpoint: chainGRPCGatewayEndpoint,
+	}
+}
+
+// codeIDsToMap returns the set of the given code IDs.
+func codeIDsToMap(codeIDs []uint64) map[uint64]struct{} {
+	codeIDsMap := make(map[uint64]struct{}, len(codeIDs))
+	for _, codeID := range codeIDs {
+		codeIDsMap[codeID] = struct{}{}
+	}
+	return codeIDsMap
+}
+
 // ScalingFactorGetterCb is a callback that is used to get the scaling factor for a given denom.
 type ScalingFactorGetterCb func(denom string) (osmomath.Dec, error)
 
@@ -87,10 +107,27 @@ func (c CanonicalOrderBooksResult) Validate() error {
 	return nil
 }
 
+// CFMMJoinPoolResult is the result of simulating a join into a CFMM pool.
+type CFMMJoinPoolResult struct {
+	// SharesOut is the amount of pool shares received for the join.
+	SharesOut osmomath.Int `json:"shares_out"`
+	// TokensJoined are the tokens joined into the pool.
+	TokensJoined sdk.Coins `json:"tokens_joined"`
+	// SwapFee is the spread factor paid on the imbalanced part of the join, i.e. the part
+	// that could not be joined proportionally to the pool liquidity. Zero for proportional joins.
+	SwapFee sdk.Coins `json:"swap_fee"`
+	// PriceImpact is the relative difference between the shares out and the shares
+	// corresponding to the spot value of the tokens joined. Negative if fewer shares are received.
+	PriceImpact osmomath.Dec `json:"price_impact"`
+}
+
 type PoolsOptions struct {
 	Filter     *api.GetPoolsRequestFilter
 	Pagination *v1beta1.PaginationRequest
 	Sort       *v1beta1.SortRequest
+	// StateSnapshot is the snapshot to read pools from.
+	// If nil, the live pools are used.
+	StateSnapshot *StateSnapshot
 }
 
 // PoolsOption configures the pools filter options.
@@ -142,6 +179,13 @@ func WithSearch(search string) PoolsOption {
 	})
 }
 
+// WithWhere configures the pools options with the where filter expression.
+func WithWhere(where string) PoolsOption {
+	return WithNonNilFilter(func(filter *api.GetPoolsRequestFilter) {
+		filter.Where = where
+	})
+}
+
 // WithPagination configures the pools options with the pagination request.
 func WithPagination(p *v1beta1.PaginationRequest) PoolsOption {
 	return func(o *PoolsOptions) {
@@ -155,3 +199,10 @@ func WithSort(s *v1beta1.SortRequest) PoolsOption {
 		o.Sort = s
 	}
 }
+
+// WithStateSnapshot configures the pools to be read from the given state snapshot.
+func WithStateSnapshot(snapshot *StateSnapshot) PoolsOption {
+	return func(o *PoolsOptions) {
+		o.StateSnapshot = snapshot
+	}
+}
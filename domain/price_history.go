@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// PriceHistoryConfig is the config for the price history.
+type PriceHistoryConfig struct {
+	// Enabled defines whether the price history is kept.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxCandles is the maximum number of closed candles kept per denom and resolution
+	// in addition to the open one. Once reached, the oldest candles are overwritten.
+	MaxCandles int `mapstructure:"max-candles"`
+
+	// PersistIntervalBlocks is the block interval at which the history is persisted to the state file.
+	PersistIntervalBlocks uint64 `mapstructure:"persist-interval-blocks"`
+
+	// StateFile is the file the history is persisted to and restored from on startup.
+	// If empty, the history is not persisted.
+	StateFile string `mapstructure:"state-file"`
+}
+
+// Validate validates the price history config.
+func (c PriceHistoryConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxCandles <= 0 {
+		return errors.New("price history max candles must be positive")
+	}
+
+	if c.StateFile != "" && c.PersistIntervalBlocks == 0 {
+		return errors.New("price history persist interval must be positive when the state file is set")
+	}
+
+	return nil
+}
+
+// PriceCandleResolution is the time span covered by a price candle.
+type PriceCandleResolution string
+
+const (
+	PriceCandleResolutionMinute     PriceCandleResolution = "1m"
+	PriceCandleResolutionFiveMinute PriceCandleResolution = "5m"
+	PriceCandleResolutionHour       PriceCandleResolution = "1h"
+	PriceCandleResolutionDay        PriceCandleResolution = "1d"
+)
+
+// PriceCandleResolutions are the supported price candle resolutions.
+var PriceCandleResolutions = []PriceCandleResolution{
+	PriceCandleResolutionMinute,
+	PriceCandleResolutionFiveMinute,
+	PriceCandleResolutionHour,
+	PriceCandleResolutionDay,
+}
+
+// Seconds returns the time span covered by a candle of the resolution in seconds.
+// Returns zero for unsupported resolutions.
+func (r PriceCandleResolution) Seconds() int64 {
+	switch r {
+	case PriceCandleResolutionMinute:
+		return 60
+	case PriceCandleResolutionFiveMinute:
+		return 5 * 60
+	case PriceCandleResolutionHour:
+		return 60 * 60
+	case PriceCandleResolutionDay:
+		return 24 * 60 * 60
+	default:
+		return 0
+	}
+}
+
+// ParsePriceCandleResolution parses the given string into a price candle resolution.
+// Returns error if the resolution is not supported.
+func ParsePriceCandleResolution(resolution string) (PriceCandleResolution, error) {
+	for _, supported := range PriceCandleResolutions {
+		if string(supported) == resolution {
+			return supported, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported price candle resolution (%s), must be one of %v", resolution, PriceCandleResolutions)
+}
+
+// PriceCandle is the open, high, low and close price of a base denom in terms of a quote denom
+// over the resolution starting at the given time.
+type PriceCandle struct {
+	// Time is the unix time in seconds at which the candle opens.
+	Time  int64           `json:"time"`
+	Open  osmomath.BigDec `json:"open"`
+	High  osmomath.BigDec `json:"high"`
+	Low   osmomath.BigDec `json:"low"`
+	Close osmomath.BigDec `json:"close"`
+}
+
+// NewPriceCandle returns a new candle opened at the given time with the given price.
+func NewPriceCandle(time int64, price osmomath.BigDec) PriceCandle {
+	return PriceCandle{
+		Time:  time,
+		Open:  price,
+		High:  price,
+		Low:   price,
+		Close: price,
+	}
+}
+
+// Update folds the given price into the candle.
+func (c *PriceCandle) Update(price osmomath.BigDec) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+}
+
+// PriceCandleHistory is the history of the candles of a base denom in terms of a quote denom at the given resolution,
+// ordered from the oldest to the newest candle.
+type PriceCandleHistory struct {
+	BaseDenom  string                `json:"base_denom"`
+	QuoteDenom string                `json:"quote_denom"`
+	Resolution PriceCandleResolution `json:"resolution"`
+	Candles    []PriceCandle         `json:"candles"`
+}
@@ -0,0 +1,19 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+func TestParsePriceCandleResolution(t *testing.T) {
+	resolution, err := domain.ParsePriceCandleResolution("5m")
+	require.NoError(t, err)
+	require.Equal(t, domain.PriceCandleResolutionFiveMinute, resolution)
+	require.Equal(t, int64(300), resolution.Seconds())
+
+	_, err = domain.ParsePriceCandleResolution("1w")
+	require.Error(t, err)
+}
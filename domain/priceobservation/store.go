@@ -0,0 +1,107 @@
+// Package priceobservation provides a store of the recent chain prices recorded at the end of the ingested blocks.
+package priceobservation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/ringbuffer"
+)
+
+// Observation is the price recorded at the end of the block with the given height.
+// The price is in effect until the height of the next observation.
+type Observation struct {
+	Height uint64
+	Price  osmomath.BigDec
+}
+
+// Store records the prices of every base denom in terms of every quote denom computed by the pricing workers
+// at the end of the ingested blocks, using the block as the unit of time.
+// It is shared by the consumers of the recent price history so that the prices are only recorded once.
+type Store struct {
+	capacity int
+
+	// mx guards the fields below.
+	mx sync.RWMutex
+	// observations are the recorded prices of every base and quote denom, ordered by height.
+	observations map[priceKey]*ringbuffer.RingBuffer[Observation]
+	// latestHeights are the latest heights with recorded prices by quote denom.
+	latestHeights map[string]uint64
+}
+
+// priceKey identifies the prices of a base denom in terms of a quote denom.
+type priceKey struct {
+	baseDenom  string
+	quoteDenom string
+}
+
+var _ domain.PricingUpdateListener = &Store{}
+
+// New creates a new store retaining the observations needed to cover a window of the given number of blocks.
+// The prices must be recorded by registering the store as a listener of the pricing workers.
+// CONTRACT: windowBlocks is positive.
+func New(windowBlocks uint64) *Store {
+	return &Store{
+		// One observation before the window start is needed to know the price in effect at the window start.
+		capacity: int(windowBlocks) + 1,
+
+		observations:  make(map[priceKey]*ringbuffer.RingBuffer[Observation]),
+		latestHeights: make(map[string]uint64),
+	}
+}
+
+// OnPricingUpdate implements domain.PricingUpdateListener.
+// It records the prices of the block.
+// Zero prices, which denote that the price could not be computed, are skipped so that the previous price remains in effect.
+// Prices of a height older than or equal to the latest recorded price of the denoms, notified out of order, are skipped.
+func (s *Store) OnPricingUpdate(ctx context.Context, height uint64, blockMetaData domain.BlockPoolMetadata, pricesBaseQuoteDenomMap domain.PricesResult, quoteDenom string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for baseDenom, quotePrices := range pricesBaseQuoteDenomMap {
+		price, ok := quotePrices[quoteDenom]
+		if !ok || price.IsNil() || !price.IsPositive() {
+			continue
+		}
+
+		key := priceKey{baseDenom: baseDenom, quoteDenom: quoteDenom}
+		observations, ok := s.observations[key]
+		if !ok {
+			observations = ringbuffer.New[Observation](s.capacity)
+			s.observations[key] = observations
+		} else if last, ok := observations.Last(); ok && last.Height >= height {
+			continue
+		}
+
+		observations.Push(Observation{Height: height, Price: price})
+	}
+
+	if height > s.latestHeights[quoteDenom] {
+		s.latestHeights[quoteDenom] = height
+	}
+
+	return nil
+}
+
+// GetObservations returns the observations of each of the given base denoms in terms of the given quote denom,
+// ordered by height, together with the latest height with recorded prices in terms of the quote denom.
+// They are read at once so that none of the observations is after the returned height.
+// The observations of the base denoms without recorded prices are empty.
+func (s *Store) GetObservations(quoteDenom string, baseDenoms ...string) ([][]Observation, uint64) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	result := make([][]Observation, 0, len(baseDenoms))
+	for _, baseDenom := range baseDenoms {
+		var items []Observation
+		if observations, ok := s.observations[priceKey{baseDenom: baseDenom, quoteDenom: quoteDenom}]; ok {
+			items = observations.Items()
+		}
+		result = append(result, items)
+	}
+
+	return result, s.latestHeights[quoteDenom]
+}
@@ -0,0 +1,64 @@
+package priceobservation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
+)
+
+const (
+	baseDenom       = "uosmo"
+	otherDenom      = "uatom"
+	quoteDenom      = "usdc"
+	otherQuoteDenom = "uion"
+)
+
+// TestStore validates that the prices are recorded by base and quote denom, that zero and out of order prices
+// are skipped, that the oldest prices are overwritten and that the latest height is tracked by quote denom.
+func TestStore(t *testing.T) {
+	store := priceobservation.New(2)
+
+	record := func(height uint64, quoteDenom string, prices map[string]string) {
+		pricesResult := domain.PricesResult{}
+		for denom, price := range prices {
+			pricesResult[denom] = map[string]osmomath.BigDec{quoteDenom: osmomath.MustNewBigDecFromStr(price)}
+		}
+		require.NoError(t, store.OnPricingUpdate(context.Background(), height, domain.BlockPoolMetadata{}, pricesResult, quoteDenom))
+	}
+
+	record(1, quoteDenom, map[string]string{baseDenom: "1", otherDenom: "10"})
+	record(2, quoteDenom, map[string]string{baseDenom: "2"})
+	// Zero price is skipped.
+	record(3, quoteDenom, map[string]string{baseDenom: "0"})
+	// Out of order height is skipped.
+	record(1, quoteDenom, map[string]string{baseDenom: "100"})
+	record(4, quoteDenom, map[string]string{baseDenom: "4"})
+	// Overwrites the oldest price of the base denom.
+	record(5, quoteDenom, map[string]string{baseDenom: "5"})
+	record(7, otherQuoteDenom, map[string]string{baseDenom: "7"})
+
+	observations, latestHeight := store.GetObservations(quoteDenom, baseDenom, otherDenom, otherQuoteDenom)
+	require.Equal(t, uint64(5), latestHeight)
+	require.Len(t, observations, 3)
+	require.Equal(t, []priceobservation.Observation{
+		{Height: 2, Price: osmomath.NewBigDec(2)},
+		{Height: 4, Price: osmomath.NewBigDec(4)},
+		{Height: 5, Price: osmomath.NewBigDec(5)},
+	}, observations[0])
+	require.Equal(t, []priceobservation.Observation{
+		{Height: 1, Price: osmomath.NewBigDec(10)},
+	}, observations[1])
+	require.Empty(t, observations[2])
+
+	observations, latestHeight = store.GetObservations(otherQuoteDenom, baseDenom)
+	require.Equal(t, uint64(7), latestHeight)
+	require.Equal(t, []priceobservation.Observation{
+		{Height: 7, Price: osmomath.NewBigDec(7)},
+	}, observations[0])
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,9 +22,39 @@ const (
 	// CoinGeckoPricingSourceType defines the pricing source
 	// that calls CoinGecko API.
 	CoinGeckoPricingSourceType
+	// TWAPPricingSourceType defines the pricing source
+	// that averages the chain prices recorded at ingest over a window of recent blocks.
+	TWAPPricingSourceType
+	// AggregatedPricingSourceType defines the pricing source
+	// that aggregates the prices of several other pricing sources.
+	AggregatedPricingSourceType
+	// SharePricingSourceType defines the pricing source
+	// that prices the pool share denoms from the pool liquidity.
+	// It is not selectable since the share denoms are priced by it regardless of the requested source.
+	SharePricingSourceType
 	NoneSourceType = -1
 )
 
+// String returns the name of the pricing source type.
+func (p PricingSourceType) String() string {
+	switch p {
+	case ChainPricingSourceType:
+		return "chain"
+	case CoinGeckoPricingSourceType:
+		return "coingecko"
+	case TWAPPricingSourceType:
+		return "twap"
+	case AggregatedPricingSourceType:
+		return "aggregated"
+	case SharePricingSourceType:
+		return "share"
+	case NoneSourceType:
+		return "none"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
 // PricingSource defines an interface that must be fulfilled by the specific
 // implementation of the pricing source.
 type PricingSource interface {
@@ -93,6 +125,10 @@ type PricingConfig struct {
 	// The default quote chain denom.
 	DefaultQuoteHumanDenom string `mapstructure:"default-quote-human-denom"`
 
+	// QuoteHumanDenoms are the human denoms of the quotes for which the prices and the pool denom metadata
+	// are pre-computed at ingest. The default quote human denom is always pre-computed, whether listed or not.
+	QuoteHumanDenoms []string `mapstructure:"quote-human-denoms"`
+
 	// Coingecko URL endpoint.
 	CoingeckoUrl string `mapstructure:"coingecko-url"`
 
@@ -105,6 +141,196 @@ type PricingConfig struct {
 	MinPoolLiquidityCap uint64 `mapstructure:"min-pool-liquidity-cap"`
 	// WorkerMinPoolLiquiidtyCap is the minimum liquidity capitalization required for a pool to be considered in the pricing worker.
 	WorkerMinPoolLiquidityCap uint64 `mapstructure:"worker-min-pool-liquidity-cap"`
+	// TWAPWindowBlocks is the number of recent blocks the TWAP pricing source averages the recorded prices over.
+	TWAPWindowBlocks uint64 `mapstructure:"twap-window-blocks"`
+	// Aggregation is the config of the aggregated pricing source.
+	Aggregation PricingAggregationConfig `mapstructure:"aggregation"`
+}
+
+// Validate validates the pricing config.
+func (c PricingConfig) Validate() error {
+	if c.TWAPWindowBlocks == 0 {
+		return errors.New("pricing twap window blocks must be positive")
+	}
+
+	seen := make(map[string]struct{}, len(c.QuoteHumanDenoms))
+	for _, quoteHumanDenom := range c.QuoteHumanDenoms {
+		if quoteHumanDenom == "" {
+			return errors.New("pricing quote human denoms must be non-empty")
+		}
+
+		lowerCaseQuoteHumanDenom := strings.ToLower(quoteHumanDenom)
+		if _, ok := seen[lowerCaseQuoteHumanDenom]; ok {
+			return fmt.Errorf("pricing quote human denom (%s) is duplicated", quoteHumanDenom)
+		}
+		seen[lowerCaseQuoteHumanDenom] = struct{}{}
+	}
+
+	return c.Aggregation.Validate()
+}
+
+// GetQuoteHumanDenoms returns the human denoms of the quotes pre-computed at ingest,
+// starting with the default quote human denom and without duplicates.
+func (c PricingConfig) GetQuoteHumanDenoms() []string {
+	quoteHumanDenoms := []string{c.DefaultQuoteHumanDenom}
+	for _, quoteHumanDenom := range c.QuoteHumanDenoms {
+		if !strings.EqualFold(quoteHumanDenom, c.DefaultQuoteHumanDenom) {
+			quoteHumanDenoms = append(quoteHumanDenoms, quoteHumanDenom)
+		}
+	}
+	return quoteHumanDenoms
+}
+
+// GetQuoteChainDenoms returns the chain denoms of the quotes pre-computed at ingest resolved with the given callback,
+// starting with the default quote chain denom and without duplicates.
+// The quote human denoms that cannot be resolved, e.g. as they are missing from the asset list, are skipped
+// and returned as the second return value so that a partial asset list does not prevent startup.
+// Returns error if the default quote human denom cannot be resolved.
+func (c PricingConfig) GetQuoteChainDenoms(getChainDenom func(humanDenom string) (string, error)) ([]string, []string, error) {
+	var (
+		quoteChainDenoms           []string
+		unresolvedQuoteHumanDenoms []string
+		seen                       = make(map[string]struct{})
+	)
+	for i, quoteHumanDenom := range c.GetQuoteHumanDenoms() {
+		quoteChainDenom, err := getChainDenom(quoteHumanDenom)
+		if err != nil {
+			if i == 0 {
+				return nil, nil, fmt.Errorf("failed to get chain denom for default quote human denom (%s): %w", quoteHumanDenom, err)
+			}
+
+			unresolvedQuoteHumanDenoms = append(unresolvedQuoteHumanDenoms, quoteHumanDenom)
+			continue
+		}
+
+		if _, ok := seen[quoteChainDenom]; ok {
+			continue
+		}
+		seen[quoteChainDenom] = struct{}{}
+
+		quoteChainDenoms = append(quoteChainDenoms, quoteChainDenom)
+	}
+	return quoteChainDenoms, unresolvedQuoteHumanDenoms, nil
+}
+
+// PricingAggregationMethod is the method used to aggregate the prices of several pricing sources.
+type PricingAggregationMethod string
+
+const (
+	// PricingAggregationMethodMedian returns the median of the source prices.
+	PricingAggregationMethodMedian PricingAggregationMethod = "median"
+	// PricingAggregationMethodWeighted returns the weighted average of the source prices.
+	PricingAggregationMethodWeighted PricingAggregationMethod = "weighted"
+)
+
+// PricingAggregationConfig is the config of the aggregated pricing source.
+type PricingAggregationConfig struct {
+	// Sources are the pricing sources to aggregate.
+	Sources []PricingSourceType `mapstructure:"sources"`
+	// Method is the aggregation method, either median or weighted.
+	Method PricingAggregationMethod `mapstructure:"method"`
+	// Weights are the weights of the sources, in the same order, used by the weighted method.
+	Weights []float64 `mapstructure:"weights"`
+	// DivergenceThreshold is the relative deviation of a source price from the aggregated price
+	// above which the price is flagged as divergent. For example, 0.05 stands for 5%.
+	DivergenceThreshold float64 `mapstructure:"divergence-threshold"`
+}
+
+// Validate validates the pricing aggregation config.
+func (c PricingAggregationConfig) Validate() error {
+	if len(c.Sources) < 2 {
+		return errors.New("pricing aggregation requires at least two sources")
+	}
+
+	seen := make(map[PricingSourceType]struct{}, len(c.Sources))
+	for _, source := range c.Sources {
+		if source < ChainPricingSourceType || source >= AggregatedPricingSourceType {
+			return fmt.Errorf("pricing aggregation source (%d) is not supported", source)
+		}
+
+		if _, ok := seen[source]; ok {
+			return fmt.Errorf("pricing aggregation source (%s) is duplicated", source)
+		}
+		seen[source] = struct{}{}
+	}
+
+	switch c.Method {
+	case PricingAggregationMethodMedian:
+	case PricingAggregationMethodWeighted:
+		if len(c.Weights) != len(c.Sources) {
+			return errors.New("pricing aggregation weights must match the sources")
+		}
+
+		for _, weight := range c.Weights {
+			if weight <= 0 {
+				return errors.New("pricing aggregation weights must be positive")
+			}
+		}
+	default:
+		return fmt.Errorf("pricing aggregation method (%s) is not supported", c.Method)
+	}
+
+	if c.DivergenceThreshold <= 0 {
+		return errors.New("pricing aggregation divergence threshold must be positive")
+	}
+
+	return nil
+}
+
+// PriceDetails is a price together with the details of its computation.
+type PriceDetails struct {
+	Price osmomath.BigDec `json:"price"`
+	// Source is the name of the pricing source that computed the price.
+	Source string `json:"source"`
+	// Aggregation describes the aggregated prices. Only set by the aggregated pricing source.
+	Aggregation *PriceAggregation `json:"aggregation,omitempty"`
+	// Confidence describes the route the price was computed over. Only set by the chain pricing source.
+	Confidence *PriceConfidence `json:"confidence,omitempty"`
+}
+
+// PriceAggregation describes the source prices aggregated into a price.
+type PriceAggregation struct {
+	// SourcePrices are the prices of the aggregated sources by source name.
+	// The sources that failed to compute the price are omitted.
+	SourcePrices map[string]osmomath.BigDec `json:"source_prices"`
+	// MaxDeviation is the maximum relative deviation of a source price from the aggregated price.
+	MaxDeviation osmomath.BigDec `json:"max_deviation"`
+	// IsDivergent is true if the max deviation exceeds the configured threshold.
+	IsDivergent bool `json:"is_divergent"`
+}
+
+// PriceConfidence describes the route a chain price was computed over so that
+// clients can discard the prices computed over low liquidity routes.
+type PriceConfidence struct {
+	// Route are the pools the price was computed over, from the quote to the base denom.
+	Route []PriceRoutePool `json:"route"`
+	// MinPoolLiquidityCap is the smallest liquidity capitalization of the pools along the route.
+	MinPoolLiquidityCap osmomath.Int `json:"min_pool_liquidity_cap"`
+	// ReferenceAmountIn is the amount of quote denom swapped over the route to select it.
+	ReferenceAmountIn sdk.Coin `json:"reference_amount_in"`
+	// PriceImpact is the price impact of swapping the reference amount over the route.
+	PriceImpact osmomath.Dec `json:"price_impact"`
+	// IsSpotPrice is true if the price was computed from the spot prices of the pools
+	// and false if it fell back to the reference amount swap.
+	IsSpotPrice bool `json:"is_spot_price"`
+	// Height is the height of the state the price was computed at.
+	// Zero if no state snapshot was pinned.
+	Height uint64 `json:"height"`
+}
+
+// PriceRoutePool is a pool along the route a price was computed over.
+type PriceRoutePool struct {
+	ID            uint64 `json:"id"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// DetailedPricingSource is a pricing source that returns the details of the computed prices.
+type DetailedPricingSource interface {
+	PricingSource
+
+	// GetPriceDetails returns the price given a base and a quote denom together with
+	// the details of its computation or otherwise error, if any.
+	GetPriceDetails(ctx context.Context, baseDenom string, quoteDenom string, opts ...PricingOption) (PriceDetails, error)
 }
 
 // FormatCacheKey formats the cache key for the given denoms.
@@ -212,6 +438,9 @@ type PoolLiquidityComputeListener interface {
 // separating the API response for backward compatibility.
 type PricesResult map[string]map[string]osmomath.BigDec
 
+// PriceDetailsResult defines a map of base denom to a map of quote denom to the price details.
+type PriceDetailsResult map[string]map[string]PriceDetails
+
 // GetPriceForDenom returns the price for the given baseDenom and quote denom.
 // Returns zero if the price is not found.
 func (prices PricesResult) GetPriceForDenom(baseDenom string, quoteDenom string) osmomath.BigDec {
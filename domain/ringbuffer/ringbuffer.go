@@ -0,0 +1,60 @@
+// Package ringbuffer provides a fixed-size buffer that overwrites its oldest items when full.
+package ringbuffer
+
+// RingBuffer is a fixed-size buffer that overwrites its oldest items when full.
+// It is not safe for concurrent use.
+type RingBuffer[T any] struct {
+	items []T
+	// start is the index of the oldest item.
+	start int
+	// size is the number of items in the buffer.
+	size int
+}
+
+// New returns a new ring buffer with the given capacity.
+// CONTRACT: capacity is positive.
+func New[T any](capacity int) *RingBuffer[T] {
+	return &RingBuffer[T]{
+		items: make([]T, capacity),
+	}
+}
+
+// Push appends the given item, overwriting the oldest item if the buffer is full.
+func (r *RingBuffer[T]) Push(item T) {
+	end := (r.start + r.size) % len(r.items)
+	r.items[end] = item
+
+	if r.size < len(r.items) {
+		r.size++
+	} else {
+		r.start = (r.start + 1) % len(r.items)
+	}
+}
+
+// Items returns a copy of the items ordered from the oldest to the newest.
+func (r *RingBuffer[T]) Items() []T {
+	items := make([]T, 0, r.size)
+	for i := 0; i < r.size; i++ {
+		items = append(items, r.items[(r.start+i)%len(r.items)])
+	}
+	return items
+}
+
+// Last returns the newest item. Returns false if the buffer is empty.
+func (r *RingBuffer[T]) Last() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.items[(r.start+r.size-1)%len(r.items)], true
+}
+
+// Len returns the number of items in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the capacity of the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.items)
+}
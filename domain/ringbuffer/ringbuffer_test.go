@@ -0,0 +1,68 @@
+package ringbuffer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain/ringbuffer"
+)
+
+func TestRingBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		pushed   []int
+
+		expected []int
+	}{
+		{
+			name:     "empty",
+			capacity: 3,
+			expected: []int{},
+		},
+		{
+			name:     "not full",
+			capacity: 3,
+			pushed:   []int{1, 2},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "full",
+			capacity: 3,
+			pushed:   []int{1, 2, 3},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "oldest items overwritten",
+			capacity: 3,
+			pushed:   []int{1, 2, 3, 4, 5, 6, 7},
+			expected: []int{5, 6, 7},
+		},
+		{
+			name:     "capacity of one",
+			capacity: 1,
+			pushed:   []int{1, 2},
+			expected: []int{2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buffer := ringbuffer.New[int](tc.capacity)
+			for _, item := range tc.pushed {
+				buffer.Push(item)
+			}
+
+			require.Equal(t, tc.expected, buffer.Items())
+			require.Equal(t, len(tc.expected), buffer.Len())
+			require.Equal(t, tc.capacity, buffer.Cap())
+
+			last, ok := buffer.Last()
+			require.Equal(t, len(tc.expected) > 0, ok)
+			if ok {
+				require.Equal(t, tc.expected[len(tc.expected)-1], last)
+			}
+		})
+	}
+}
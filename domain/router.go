@@ -75,6 +75,12 @@ type Quote interface {
 	// SetQuotePriceInfo sets the quote price info.
 	SetQuotePriceInfo(info *TxFeeInfo)
 
+	// SetHeight sets the height of the state the quote was computed at.
+	SetHeight(height uint64)
+
+	// SetRecommendedSlippage sets the slippage tolerance recommended for the quote.
+	SetRecommendedSlippage(slippage osmomath.Dec)
+
 	String() string
 }
 
@@ -88,6 +94,9 @@ type RouterConfig struct {
 	// Pool IDs that are prioritized in the router.
 	PreferredPoolIDs []uint64 `mapstructure:"preferred-pool-ids"`
 
+	// Pool IDs that are never routed through.
+	DeniedPoolIDs []uint64 `mapstructure:"denied-pool-ids"`
+
 	// Maximum number of pools in one route.
 	MaxPoolsPerRoute int `mapstructure:"max-pools-per-route"`
 
@@ -112,6 +121,9 @@ type RouterConfig struct {
 
 	// DynamicMinLiquidityCapFiltersAsc is a list of dynamic min liquidity cap filters in descending order.
 	DynamicMinLiquidityCapFiltersDesc []DynamicMinLiquidityCapFilterEntry `mapstructure:"dynamic-min-liquidity-cap-filters-desc"`
+
+	// RecommendedSlippage encapsulates the config for the slippage tolerance recommended with the quotes.
+	RecommendedSlippage *RecommendedSlippageConfig `mapstructure:"recommended-slippage"`
 }
 
 type PoolsConfig struct {
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// RecommendedSlippageConfig is the config for the slippage tolerance recommended with the quotes.
+//
+// The recommended slippage is computed as:
+// clamp(volatility-multiplier * volatility * sqrt(horizon-blocks) + price-impact-multiplier * |price impact|, min-slippage, max-slippage)
+// where the volatility is the standard deviation of the per-block log returns of the token in price
+// in terms of the token out over the window.
+type RecommendedSlippageConfig struct {
+	// Enabled defines whether the slippage tolerance is recommended with the quotes.
+	Enabled bool `mapstructure:"enabled"`
+
+	// WindowBlocks is the number of recent blocks the volatility is computed over.
+	WindowBlocks uint64 `mapstructure:"window-blocks"`
+
+	// HorizonBlocks is the number of blocks the price may move for before the swap is executed.
+	HorizonBlocks uint64 `mapstructure:"horizon-blocks"`
+
+	// VolatilityMultiplier is the number of standard deviations of the price move over the horizon to tolerate.
+	VolatilityMultiplier float64 `mapstructure:"volatility-multiplier"`
+
+	// PriceImpactMultiplier is the fraction of the price impact of the amount to tolerate.
+	// It accounts for the swaps of similar amounts executed ahead in the same direction.
+	PriceImpactMultiplier float64 `mapstructure:"price-impact-multiplier"`
+
+	// MinSlippage is the lower bound of the recommended slippage, e.g. 0.001 for 0.1%.
+	MinSlippage float64 `mapstructure:"min-slippage"`
+
+	// MaxSlippage is the upper bound of the recommended slippage, e.g. 0.05 for 5%.
+	MaxSlippage float64 `mapstructure:"max-slippage"`
+}
+
+// Validate validates the recommended slippage config.
+func (c RecommendedSlippageConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.WindowBlocks < 2 {
+		return errors.New("recommended slippage window blocks must be at least 2")
+	}
+
+	if c.HorizonBlocks == 0 {
+		return errors.New("recommended slippage horizon blocks must be positive")
+	}
+
+	if c.VolatilityMultiplier < 0 {
+		return errors.New("recommended slippage volatility multiplier must not be negative")
+	}
+
+	if c.PriceImpactMultiplier < 0 {
+		return errors.New("recommended slippage price impact multiplier must not be negative")
+	}
+
+	if c.MinSlippage < 0 {
+		return errors.New("recommended slippage min slippage must not be negative")
+	}
+
+	if c.MaxSlippage <= c.MinSlippage || c.MaxSlippage >= 1 {
+		return errors.New("recommended slippage max slippage must be greater than the min slippage and less than one")
+	}
+
+	return nil
+}
+
+// SlippageRecommender recommends the slippage tolerance for the quotes from the volatility of the prices
+// computed by the pricing worker at the end of the ingested blocks and the price impact of the quoted amount.
+type SlippageRecommender interface {
+	// GetRecommendedSlippage returns the slippage tolerance recommended for swapping the token in for the token out
+	// with the given price impact.
+	// Returns false if the recorded prices of either denom do not cover the window, for example right after startup.
+	GetRecommendedSlippage(tokenInDenom, tokenOutDenom string, priceImpact osmomath.Dec) (osmomath.Dec, bool)
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// StateSnapshotKeyType is a custom type for the state snapshot key.
+type StateSnapshotKeyType string
+
+const (
+	// StateSnapshotCtxKey is the key used to store the state snapshot pinned for a request in the request context.
+	StateSnapshotCtxKey StateSnapshotKeyType = "state_snapshot"
+
+	// HeightHeader is the response header reporting the height of the state snapshot used to serve the request.
+	HeightHeader = "X-SQS-Height"
+
+	// MinHeightQueryParam is the query parameter defining the minimum height the request must be served at.
+	MinHeightQueryParam = "minHeight"
+)
+
+// CandidateRouteDenomDataGetter returns the ranked candidate route search data for a given denom.
+type CandidateRouteDenomDataGetter interface {
+	// GetDenomData returns the ranked candidate route search pool data for a given denom.
+	// Returns an empty struct if the denom is not found.
+	GetDenomData(denom string) (CandidateRouteDenomData, error)
+}
+
+// StateSnapshot is an immutable view of the pools, taker fees, sorted pools,
+// candidate route search data and pool denom metadata as of the end of a single block.
+// It is constructed once per block by the ingester and is safe for concurrent reads.
+// CONTRACT: none of the returned values may be mutated by the callers.
+type StateSnapshot struct {
+	height                   uint64
+	pools                    *sync.Map
+	allPools                 []sqsdomain.PoolI
+	sortedPools              []sqsdomain.PoolI
+	takerFees                sqsdomain.TakerFeeMap
+	candidateRouteSearchData map[string]CandidateRouteDenomData
+
+	// poolDenomMetadata is the pool denom metadata priced in the default quote denom.
+	// Nil if the pool denom metadata is not captured by the snapshot.
+	poolDenomMetadata PoolDenomMetaDataMap
+	// quotePoolDenomMetadata is the pool denom metadata priced in the other quote denoms by quote denom.
+	quotePoolDenomMetadata map[string]PoolDenomMetaDataMap
+}
+
+var _ CandidateRouteDenomDataGetter = &StateSnapshot{}
+
+// NewStateSnapshot returns a new state snapshot for the given height.
+// The inputs are expected to be owned by the snapshot and are never written to afterwards.
+func NewStateSnapshot(height uint64, pools []sqsdomain.PoolI, sortedPools []sqsdomain.PoolI, takerFees sqsdomain.TakerFeeMap, candidateRouteSearchData map[string]CandidateRouteDenomData) *StateSnapshot {
+	poolsMap := &sync.Map{}
+	for _, pool := range pools {
+		poolsMap.Store(pool.GetId(), pool)
+	}
+
+	return &StateSnapshot{
+		height:                   height,
+		pools:                    poolsMap,
+		allPools:                 pools,
+		sortedPools:              sortedPools,
+		takerFees:                takerFees,
+		candidateRouteSearchData: candidateRouteSearchData,
+	}
+}
+
+// WithPoolDenomMetadata returns a copy of the snapshot capturing the given pool denom metadata
+// priced in the default quote denom and in the other quote denoms by quote denom.
+// The inputs are expected to be owned by the snapshot and are never written to afterwards.
+func (s *StateSnapshot) WithPoolDenomMetadata(poolDenomMetadata PoolDenomMetaDataMap, quotePoolDenomMetadata map[string]PoolDenomMetaDataMap) *StateSnapshot {
+	snapshot := *s
+	snapshot.poolDenomMetadata = poolDenomMetadata
+	snapshot.quotePoolDenomMetadata = quotePoolDenomMetadata
+	return &snapshot
+}
+
+// Height returns the height of the block the snapshot was taken at.
+func (s *StateSnapshot) Height() uint64 {
+	return s.height
+}
+
+// GetPool returns the pool with the given ID.
+// Returns PoolNotFoundError if the pool is not present in the snapshot.
+func (s *StateSnapshot) GetPool(poolID uint64) (sqsdomain.PoolI, error) {
+	poolObj, ok := s.pools.Load(poolID)
+	if !ok {
+		return nil, PoolNotFoundError{PoolID: poolID}
+	}
+
+	pool, ok := poolObj.(sqsdomain.PoolI)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast pool with ID %d", poolID)
+	}
+
+	return pool, nil
+}
+
+// GetAllPools returns all pools in the snapshot.
+func (s *StateSnapshot) GetAllPools() []sqsdomain.PoolI {
+	return s.allPools
+}
+
+// GetPoolsMap returns the pools in the snapshot keyed by pool ID.
+// CONTRACT: the returned map is read-only.
+func (s *StateSnapshot) GetPoolsMap() *sync.Map {
+	return s.pools
+}
+
+// GetSortedPools returns the pools sorted for routing at the snapshot height.
+func (s *StateSnapshot) GetSortedPools() []sqsdomain.PoolI {
+	return s.sortedPools
+}
+
+// GetTakerFee returns the taker fee for the given pair of denominations.
+// Returns true if the taker fee is found. False otherwise.
+func (s *StateSnapshot) GetTakerFee(denom0, denom1 string) (osmomath.Dec, bool) {
+	takerFee, ok := s.takerFees[sqsdomain.DenomPair{Denom0: denom0, Denom1: denom1}]
+	return takerFee, ok
+}
+
+// GetAllTakerFees returns all taker fees in the snapshot.
+func (s *StateSnapshot) GetAllTakerFees() sqsdomain.TakerFeeMap {
+	return s.takerFees
+}
+
+// GetCandidateRouteSearchData returns the candidate route search data in the snapshot.
+func (s *StateSnapshot) GetCandidateRouteSearchData() map[string]CandidateRouteDenomData {
+	return s.candidateRouteSearchData
+}
+
+// GetDenomData implements CandidateRouteDenomDataGetter.
+func (s *StateSnapshot) GetDenomData(denom string) (CandidateRouteDenomData, error) {
+	return s.candidateRouteSearchData[denom], nil
+}
+
+// GetPoolDenomMetadata returns the pool denom metadata priced in the default quote denom.
+// Returns false if the pool denom metadata is not captured by the snapshot.
+func (s *StateSnapshot) GetPoolDenomMetadata() (PoolDenomMetaDataMap, bool) {
+	return s.poolDenomMetadata, s.poolDenomMetadata != nil
+}
+
+// GetQuotePoolDenomMetadata returns the pool denom metadata priced in the given quote denom
+// other than the default one.
+// Returns false if the pool denom metadata is not captured by the snapshot.
+func (s *StateSnapshot) GetQuotePoolDenomMetadata(quoteDenom string) (PoolDenomMetaDataMap, bool) {
+	if s.poolDenomMetadata == nil {
+		return nil, false
+	}
+	return s.quotePoolDenomMetadata[quoteDenom], true
+}
+
+// GetHeightFromContext returns the height of the state snapshot pinned in the context.
+// Returns zero if no snapshot is pinned.
+func GetHeightFromContext(ctx context.Context) uint64 {
+	snapshot, ok := GetStateSnapshotFromContext(ctx)
+	if !ok {
+		return 0
+	}
+	return snapshot.Height()
+}
+
+// ContextWithStateSnapshot returns a copy of the context with the given state snapshot pinned.
+func ContextWithStateSnapshot(ctx context.Context, snapshot *StateSnapshot) context.Context {
+	return context.WithValue(ctx, StateSnapshotCtxKey, snapshot)
+}
+
+// GetStateSnapshotFromContext returns the state snapshot pinned in the context.
+// Returns false if no snapshot is pinned.
+func GetStateSnapshotFromContext(ctx context.Context) (*StateSnapshot, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	snapshot, ok := ctx.Value(StateSnapshotCtxKey).(*StateSnapshot)
+	if !ok || snapshot == nil {
+		return nil, false
+	}
+
+	return snapshot, true
+}
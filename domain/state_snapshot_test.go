@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// This test validates that the state snapshot serves pools, taker fees,
+// candidate route search data and pool denom metadata as provided at construction,
+// and that it can be pinned to and retrieved from the context.
+func TestStateSnapshot(t *testing.T) {
+	const (
+		height = uint64(100)
+		denomA = "denomA"
+		denomB = "denomB"
+	)
+
+	var (
+		poolOne = &sqsdomain.PoolWrapper{ChainModel: &mocks.ChainPoolMock{ID: 1}}
+		poolTwo = &sqsdomain.PoolWrapper{ChainModel: &mocks.ChainPoolMock{ID: 2}}
+
+		takerFee = osmomath.MustNewDecFromStr("0.001")
+	)
+
+	takerFees := sqsdomain.TakerFeeMap{}
+	takerFees.SetTakerFee(denomA, denomB, takerFee)
+
+	searchData := map[string]domain.CandidateRouteDenomData{
+		denomA: {SortedPools: []sqsdomain.PoolI{poolOne}},
+	}
+
+	snapshot := domain.NewStateSnapshot(height, []sqsdomain.PoolI{poolOne, poolTwo}, []sqsdomain.PoolI{poolTwo, poolOne}, takerFees, searchData)
+
+	require.Equal(t, height, snapshot.Height())
+	require.Len(t, snapshot.GetAllPools(), 2)
+	require.Equal(t, []sqsdomain.PoolI{poolTwo, poolOne}, snapshot.GetSortedPools())
+
+	// Pools
+	pool, err := snapshot.GetPool(2)
+	require.NoError(t, err)
+	require.Equal(t, poolTwo, pool)
+
+	_, err = snapshot.GetPool(3)
+	require.ErrorIs(t, err, domain.PoolNotFoundError{PoolID: 3})
+
+	// Taker fees
+	actualTakerFee, ok := snapshot.GetTakerFee(denomA, denomB)
+	require.True(t, ok)
+	require.Equal(t, takerFee, actualTakerFee)
+
+	_, ok = snapshot.GetTakerFee(denomB, "denomC")
+	require.False(t, ok)
+
+	// Search data
+	denomData, err := snapshot.GetDenomData(denomA)
+	require.NoError(t, err)
+	require.Equal(t, searchData[denomA], denomData)
+
+	denomData, err = snapshot.GetDenomData(denomB)
+	require.NoError(t, err)
+	require.Empty(t, denomData.SortedPools)
+
+	// Pool denom metadata
+	_, ok = snapshot.GetPoolDenomMetadata()
+	require.False(t, ok)
+
+	_, ok = snapshot.GetQuotePoolDenomMetadata(denomB)
+	require.False(t, ok)
+
+	poolDenomMetadata := domain.PoolDenomMetaDataMap{
+		denomA: {TotalLiquidity: osmomath.NewInt(1_000)},
+	}
+	quotePoolDenomMetadata := map[string]domain.PoolDenomMetaDataMap{
+		denomB: {denomA: {TotalLiquidity: osmomath.NewInt(2_000)}},
+	}
+
+	snapshotWithMetadata := snapshot.WithPoolDenomMetadata(poolDenomMetadata, quotePoolDenomMetadata)
+	require.Equal(t, height, snapshotWithMetadata.Height())
+
+	actualPoolDenomMetadata, ok := snapshotWithMetadata.GetPoolDenomMetadata()
+	require.True(t, ok)
+	require.Equal(t, poolDenomMetadata, actualPoolDenomMetadata)
+
+	actualQuotePoolDenomMetadata, ok := snapshotWithMetadata.GetQuotePoolDenomMetadata(denomB)
+	require.True(t, ok)
+	require.Equal(t, quotePoolDenomMetadata[denomB], actualQuotePoolDenomMetadata)
+
+	actualQuotePoolDenomMetadata, ok = snapshotWithMetadata.GetQuotePoolDenomMetadata(denomA)
+	require.True(t, ok)
+	require.Empty(t, actualQuotePoolDenomMetadata)
+
+	// The original snapshot is left unchanged.
+	_, ok = snapshot.GetPoolDenomMetadata()
+	require.False(t, ok)
+
+	// Context
+	_, ok = domain.GetStateSnapshotFromContext(context.Background())
+	require.False(t, ok)
+
+	actualSnapshot, ok := domain.GetStateSnapshotFromContext(domain.ContextWithStateSnapshot(context.Background(), snapshot))
+	require.True(t, ok)
+	require.Equal(t, snapshot, actualSnapshot)
+}
@@ -37,6 +37,59 @@ var (
 	// * height - the height of the block being processed
 	SQSIngestUsecaseProcessBlockErrorMetricName = "sqs_ingest_usecase_process_block_error_total"
 
+	// sqs_ingest_handler_unauthenticated_request_total
+	//
+	// counter that measures the number of ingest requests rejected by the grpc ingest handler
+	// due to failed authentication.
+	SQSIngestHandlerUnauthenticatedRequestMetricName = "sqs_ingest_handler_unauthenticated_request_total"
+
+	// sqs_admin_unauthenticated_request_total
+	//
+	// counter that measures the number of admin API requests rejected due to a missing or invalid API key.
+	SQSAdminUnauthenticatedRequestMetricName = "sqs_admin_unauthenticated_request_total"
+
+	// sqs_ingest_source_lag
+	//
+	// gauge that measures the number of blocks an ingest source is behind the latest accepted height
+	//
+	// Has the following labels:
+	// * source - the identifier of the ingest source
+	SQSIngestSourceLagMetricName = "sqs_ingest_source_lag"
+
+	// sqs_ingest_source_active
+	//
+	// gauge that is set to 1 for the ingest source that delivered the latest accepted height and 0 otherwise
+	//
+	// Has the following labels:
+	// * source - the identifier of the ingest source
+	SQSIngestSourceActiveMetricName = "sqs_ingest_source_active"
+
+	// sqs_ingest_source_skipped_block_total
+	//
+	// counter that measures the number of blocks ignored because their height was already accepted
+	//
+	// Has the following labels:
+	// * source - the identifier of the ingest source
+	SQSIngestSourceSkippedBlockMetricName = "sqs_ingest_source_skipped_block_total"
+
+	// sqs_ingest_source_height_regression_total
+	//
+	// counter that measures the number of times an ingest source sent a height lower than it previously sent
+	//
+	// Has the following labels:
+	// * source - the identifier of the ingest source
+	SQSIngestSourceHeightRegressionMetricName = "sqs_ingest_source_height_regression_total"
+
+	// sqs_ingest_height_gap_total
+	//
+	// counter that measures the number of times an accepted height skipped over one or more heights
+	SQSIngestHeightGapMetricName = "sqs_ingest_height_gap_total"
+
+	// sqs_state_snapshot_height
+	//
+	// gauge that measures the height of the latest published state snapshot
+	SQSStateSnapshotHeightMetricName = "sqs_state_snapshot_height"
+
 	// sqs_ingest_usecase_parse_pool_error_total
 	//
 	// counter that measures the number of errors that occur during pool parsing in ingest usecase
@@ -151,6 +204,40 @@ var (
 	// counter that measures the number of pricing coingecko cache misses
 	SQSPricingCoingeckoCacheMissesCounterMetricName = "sqs_pricing_coingecko_cache_misses_total"
 
+	// sqs_pricing_divergence_total
+	//
+	// counter that measures the number of aggregated prices where a source price deviates
+	// from the aggregated price by more than the configured threshold
+	//
+	// Has the following labels:
+	// * source - the name of the pricing source whose price deviates
+	SQSPricingDivergenceCounterMetricName = "sqs_pricing_divergence_total"
+
+	// sqs_router_pool_health_error_total
+	//
+	// counter that measures the number of errors returned by a pool when estimating a route
+	//
+	// Has the following labels:
+	// * pool_id - the identifier of the pool that returned the error
+	SQSRouterPoolHealthErrorCounterMetricName = "sqs_router_pool_health_error_total"
+
+	// sqs_router_pool_health_outlier_total
+	//
+	// counter that measures the number of outlier results returned by a pool when estimating a route,
+	// i.e. an amount out far beyond the one implied by the pool spot price
+	//
+	// Has the following labels:
+	// * pool_id - the identifier of the pool that returned the outlier result
+	SQSRouterPoolHealthOutlierCounterMetricName = "sqs_router_pool_health_outlier_total"
+
+	// sqs_router_pool_quarantined
+	//
+	// gauge that is set to 1 while a pool is quarantined and excluded from the candidate routes and to 0 otherwise
+	//
+	// Has the following labels:
+	// * pool_id - the identifier of the pool
+	SQSRouterPoolQuarantinedGaugeMetricName = "sqs_router_pool_quarantined"
+
 	SQSIngestHandlerProcessBlockHeightGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Name: SQSIngestUsecaseProcessBlockHeightMetricName,
@@ -179,6 +266,66 @@ var (
 		},
 	)
 
+	SQSIngestHandlerUnauthenticatedRequestCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: SQSIngestHandlerUnauthenticatedRequestMetricName,
+			Help: "counter that measures the number of ingest requests rejected by the grpc ingest handler due to failed authentication",
+		},
+	)
+
+	SQSAdminUnauthenticatedRequestCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: SQSAdminUnauthenticatedRequestMetricName,
+			Help: "counter that measures the number of admin API requests rejected due to a missing or invalid API key",
+		},
+	)
+
+	SQSIngestSourceLagGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: SQSIngestSourceLagMetricName,
+			Help: "gauge that measures the number of blocks an ingest source is behind the latest accepted height",
+		},
+		[]string{"source"},
+	)
+
+	SQSIngestSourceActiveGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: SQSIngestSourceActiveMetricName,
+			Help: "gauge that is set to 1 for the ingest source that delivered the latest accepted height and 0 otherwise",
+		},
+		[]string{"source"},
+	)
+
+	SQSIngestSourceSkippedBlockCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: SQSIngestSourceSkippedBlockMetricName,
+			Help: "counter that measures the number of blocks ignored because their height was already accepted",
+		},
+		[]string{"source"},
+	)
+
+	SQSIngestSourceHeightRegressionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: SQSIngestSourceHeightRegressionMetricName,
+			Help: "counter that measures the number of times an ingest source sent a height lower than it previously sent",
+		},
+		[]string{"source"},
+	)
+
+	SQSIngestHeightGapCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: SQSIngestHeightGapMetricName,
+			Help: "counter that measures the number of times an accepted height skipped over one or more heights",
+		},
+	)
+
+	SQSStateSnapshotHeightGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: SQSStateSnapshotHeightMetricName,
+			Help: "gauge that measures the height of the latest published state snapshot",
+		},
+	)
+
 	SQSIngestHandlerPoolParseErrorCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: SQSIngestUsecaseParsePoolErrorMetricName,
@@ -305,6 +452,38 @@ var (
 			Help: "Total number of pricing coingecko cache misses",
 		},
 	)
+
+	SQSPricingDivergenceCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: SQSPricingDivergenceCounterMetricName,
+			Help: "Total number of aggregated prices where a source price deviates from the aggregated price by more than the threshold",
+		},
+		[]string{"source"},
+	)
+
+	SQSRouterPoolHealthErrorCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: SQSRouterPoolHealthErrorCounterMetricName,
+			Help: "Total number of errors returned by a pool when estimating a route",
+		},
+		[]string{"pool_id"},
+	)
+
+	SQSRouterPoolHealthOutlierCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: SQSRouterPoolHealthOutlierCounterMetricName,
+			Help: "Total number of outlier results returned by a pool when estimating a route",
+		},
+		[]string{"pool_id"},
+	)
+
+	SQSRouterPoolQuarantinedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: SQSRouterPoolQuarantinedGaugeMetricName,
+			Help: "gauge that is set to 1 while a pool is quarantined and excluded from the candidate routes and to 0 otherwise",
+		},
+		[]string{"pool_id"},
+	)
 )
 
 func init() {
@@ -313,6 +492,14 @@ func init() {
 	prometheus.MustRegister(SQSIngestHandlerProcessBlockErrorCounter)
 	prometheus.MustRegister(SQSIngestHandlerProcessOrderbookPoolErrorCounter)
 	prometheus.MustRegister(SQSIngestHandlerPoolParseErrorCounter)
+	prometheus.MustRegister(SQSIngestHandlerUnauthenticatedRequestCounter)
+	prometheus.MustRegister(SQSAdminUnauthenticatedRequestCounter)
+	prometheus.MustRegister(SQSIngestSourceLagGauge)
+	prometheus.MustRegister(SQSIngestSourceActiveGauge)
+	prometheus.MustRegister(SQSIngestSourceSkippedBlockCounter)
+	prometheus.MustRegister(SQSIngestSourceHeightRegressionCounter)
+	prometheus.MustRegister(SQSIngestHeightGapCounter)
+	prometheus.MustRegister(SQSStateSnapshotHeightGauge)
 	prometheus.MustRegister(SQSPricingWorkerComputeDurationGauge)
 	prometheus.MustRegister(SQSPricingWorkerComputeErrorCounter)
 	prometheus.MustRegister(SQSPoolLiquidityPricingWorkerComputeDurationGauge)
@@ -330,4 +517,8 @@ func init() {
 	prometheus.MustRegister(SQSPricingSpotPriceError)
 	prometheus.MustRegister(SQSPricingCoingeckoCacheHitsCounter)
 	prometheus.MustRegister(SQSPricingCoingeckoCacheMissesCounter)
+	prometheus.MustRegister(SQSPricingDivergenceCounter)
+	prometheus.MustRegister(SQSRouterPoolHealthErrorCounter)
+	prometheus.MustRegister(SQSRouterPoolHealthOutlierCounter)
+	prometheus.MustRegister(SQSRouterPoolQuarantinedGauge)
 }
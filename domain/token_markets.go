@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+)
+
+// TokenMarket is a structure for serializing the market stats of a token returned to clients.
+type TokenMarket struct {
+	Denom    string `json:"denom"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Decimals int    `json:"decimals"`
+	// Price is the price in the default quote denom, zero if the token could not be priced.
+	// @Type string
+	Price osmomath.BigDec `json:"price"`
+	// LiquidityCap is the total liquidity capitalization across all pools in the default quote denom.
+	// @Type string
+	LiquidityCap osmomath.Int `json:"liquidity_cap"`
+	// NumPools is the number of pools containing the token.
+	NumPools int `json:"num_pools"`
+	// PriceChange24h is the relative price change over the last 24 hours, e.g. 0.05 for +5%.
+	// It is null if no prices were recorded for the token.
+	// @Type string
+	PriceChange24h *osmomath.BigDec `json:"price_change_24h"`
+	IsUnlisted     bool             `json:"unlisted"`
+	IsVerified     bool             `json:"verified"`
+}
+
+// TokenMarketsOptions configures the search, sort and pagination of the token markets.
+type TokenMarketsOptions struct {
+	// Search is the fuzzy search by symbol, name or chain denom.
+	Search     string
+	Pagination *v1beta1.PaginationRequest
+	Sort       *v1beta1.SortRequest
+}
+
+// TokenMarketsOption configures the token markets options.
+type TokenMarketsOption func(*TokenMarketsOptions)
+
+// WithTokenMarketsSearch configures the token markets options with the search.
+func WithTokenMarketsSearch(search string) TokenMarketsOption {
+	return func(o *TokenMarketsOptions) {
+		o.Search = search
+	}
+}
+
+// WithTokenMarketsPagination configures the token markets options with the pagination request.
+func WithTokenMarketsPagination(p *v1beta1.PaginationRequest) TokenMarketsOption {
+	return func(o *TokenMarketsOptions) {
+		o.Pagination = p
+	}
+}
+
+// WithTokenMarketsSort configures the token markets options with the sort request.
+func WithTokenMarketsSort(s *v1beta1.SortRequest) TokenMarketsOption {
+	return func(o *TokenMarketsOptions) {
+		o.Sort = s
+	}
+}
@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
@@ -17,6 +21,9 @@ type Token struct {
 	// IsUnlisted is true if the token is unlisted.
 	IsUnlisted  bool   `json:"preview"`
 	CoingeckoID string `json:"coingeckoId"`
+	// IsUnverified is true if the token is not in the token registry
+	// and its metadata is provisionally resolved from the chain.
+	IsUnverified bool `json:"unverified"`
 }
 
 // PoolDenomMetaData contains the metadata about the denoms collected from the pools.
@@ -50,6 +57,21 @@ type DenomPoolLiquidityData struct {
 // GAMMSharePrefix is the prefix for the GAMM share
 const GAMMSharePrefix = "gamm/pool"
 
+// AlloyedDenomInfix is the infix of the alloyed transmuter share denoms,
+// e.g. factory/{contract address}/alloyed/allBTC.
+const AlloyedDenomInfix = "/alloyed/"
+
+// IsShareDenom returns true if the given denom is a GAMM share, an alloyed transmuter share denom
+// or a concentrated liquidity position pseudo-denom.
+func IsShareDenom(denom string) bool {
+	return strings.HasPrefix(denom, GAMMSharePrefix) || IsAlloyedDenom(denom) || strings.HasPrefix(denom, ConcentratedPositionPrefix)
+}
+
+// IsAlloyedDenom returns true if the given denom is an alloyed transmuter share denom.
+func IsAlloyedDenom(denom string) bool {
+	return strings.Contains(denom, AlloyedDenomInfix)
+}
+
 // TokenRegistryLoader is loader of tokens from the chain registry.
 // Loaded tokens are used to update the token registry.
 type TokenRegistryLoader interface {
@@ -57,6 +79,93 @@ type TokenRegistryLoader interface {
 	FetchAndUpdateTokens() error
 }
 
+// TokenRegistryReporter reports on the tokens last loaded into the token registry.
+type TokenRegistryReporter interface {
+	// GetReport returns the validation report of the tokens last loaded into the token registry.
+	GetReport() TokenRegistryReport
+}
+
+// TokenRegistrySource is a source of tokens merged into the token registry,
+// e.g. the chain registry asset list or a local asset list file.
+type TokenRegistrySource interface {
+	// GetName returns the name of the source.
+	GetName() string
+	// GetTokens returns the tokens by chain denom and the checksum of the source data.
+	GetTokens() (map[string]Token, string, error)
+}
+
+// TokenRegistryConfig is the config of the token registry sources in addition to the chain registry asset list.
+// The sources are merged in order of precedence: the chain registry asset list, the files in the given order
+// and the overrides.
+type TokenRegistryConfig struct {
+	// Files are the paths of the local asset list files in the chain registry asset list format.
+	// A token of a file replaces the token with the same chain denom from the preceding sources.
+	// The files are reloaded on change.
+	Files []string `mapstructure:"files"`
+
+	// Overrides patch the non-empty fields of the tokens from all the other sources.
+	// An override of a chain denom not present in any source adds a new token.
+	Overrides []TokenRegistryOverride `mapstructure:"overrides"`
+}
+
+// TokenRegistryOverride is an inline override of a token in the token registry.
+type TokenRegistryOverride struct {
+	// Denom is the chain denom of the token.
+	Denom string `mapstructure:"denom"`
+	// Name is the name of the token.
+	Name string `mapstructure:"name"`
+	// Symbol is the human readable denom of the token.
+	Symbol string `mapstructure:"symbol"`
+	// Decimals is the precision of the token.
+	Decimals *int `mapstructure:"decimals"`
+	// CoingeckoID is the CoinGecko ID of the token.
+	CoingeckoID string `mapstructure:"coingecko-id"`
+	// Preview is true if the token is unlisted.
+	Preview *bool `mapstructure:"preview"`
+}
+
+// Validate validates the token registry config.
+func (c TokenRegistryConfig) Validate() error {
+	for _, file := range c.Files {
+		if file == "" {
+			return errors.New("token registry files must be non-empty")
+		}
+	}
+
+	seen := make(map[string]struct{}, len(c.Overrides))
+	for _, override := range c.Overrides {
+		if override.Denom == "" {
+			return errors.New("token registry override denom must be non-empty")
+		}
+
+		if _, ok := seen[override.Denom]; ok {
+			return fmt.Errorf("token registry override denom (%s) is duplicated", override.Denom)
+		}
+		seen[override.Denom] = struct{}{}
+
+		if override.Decimals != nil && *override.Decimals < 0 {
+			return fmt.Errorf("token registry override decimals of denom (%s) must not be negative", override.Denom)
+		}
+	}
+
+	return nil
+}
+
+// TokenRegistryReport is the validation report of the tokens merged from the token registry sources.
+type TokenRegistryReport struct {
+	// DuplicateSymbols are the chain denoms sharing a symbol, by lower case symbol.
+	DuplicateSymbols map[string][]string `json:"duplicate_symbols"`
+	// MissingDecimals are the chain denoms of the tokens without decimals.
+	MissingDecimals []string `json:"missing_decimals"`
+	// ConflictingCoingeckoIDs are the different CoinGecko IDs given by the sources, by chain denom.
+	ConflictingCoingeckoIDs map[string][]string `json:"conflicting_coingecko_ids"`
+}
+
+// IsEmpty returns true if the report has no findings.
+func (r TokenRegistryReport) IsEmpty() bool {
+	return len(r.DuplicateSymbols) == 0 && len(r.MissingDecimals) == 0 && len(r.ConflictingCoingeckoIDs) == 0
+}
+
 // SwapMethod is the type of token swap method.
 type TokenSwapMethod int
 
@@ -0,0 +1,343 @@
+package grpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+)
+
+const (
+	// IngestTimestampMetadataKey is the gRPC metadata key holding the unix timestamp
+	// in seconds at which the request was signed.
+	IngestTimestampMetadataKey = "x-sqs-ingest-timestamp"
+	// IngestNonceMetadataKey is the gRPC metadata key holding the unique nonce of the request.
+	// A nonce is accepted at most once within the allowed clock skew.
+	IngestNonceMetadataKey = "x-sqs-ingest-nonce"
+	// IngestSignatureMetadataKey is the gRPC metadata key holding the hex-encoded
+	// HMAC-SHA256 signature of the request.
+	IngestSignatureMetadataKey = "x-sqs-ingest-signature"
+)
+
+var (
+	errMissingMetadata  = errors.New("missing metadata")
+	errMissingTimestamp = errors.New("missing timestamp")
+	errMissingNonce     = errors.New("missing nonce")
+	errMissingSignature = errors.New("missing signature")
+	errInvalidTimestamp = errors.New("invalid timestamp")
+	errExpiredTimestamp = errors.New("timestamp outside of allowed clock skew")
+	errInvalidSignature = errors.New("invalid signature")
+	errReusedNonce      = errors.New("nonce already used")
+	errInvalidBody      = errors.New("invalid request body")
+)
+
+// ComputeIngestBodyHash returns the SHA-256 hash of the request body, defined as the concatenation
+// of the deterministic protobuf encoding of the given messages in the order they are sent.
+// For unary calls, the body is the single request message. For streaming calls, it is every message of the stream.
+func ComputeIngestBodyHash(messages ...proto.Message) ([]byte, error) {
+	bodyHash := sha256.New()
+	for _, message := range messages {
+		if err := writeIngestBody(bodyHash, message); err != nil {
+			return nil, err
+		}
+	}
+	return bodyHash.Sum(nil), nil
+}
+
+// ComputeIngestSignature returns the hex-encoded HMAC-SHA256 signature of the ingest request
+// for the given full gRPC method name, unix timestamp in seconds, nonce and body hash as returned by ComputeIngestBodyHash.
+// Clients are expected to set the result under IngestSignatureMetadataKey alongside the
+// timestamp under IngestTimestampMetadataKey and the nonce under IngestNonceMetadataKey.
+func ComputeIngestSignature(sharedSecret string, fullMethod string, timestamp int64, nonce string, bodyHash []byte) string {
+	mac := hmac.New(sha256.New, []byte(sharedSecret))
+	mac.Write([]byte(fullMethod))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(hex.EncodeToString(bodyHash)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// writeIngestBody writes the deterministic protobuf encoding of the message to the body hash.
+func writeIngestBody(bodyHash hash.Hash, message any) error {
+	protoMessage, ok := message.(proto.Message)
+	if !ok {
+		return errInvalidBody
+	}
+
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(protoMessage)
+	if err != nil {
+		return errInvalidBody
+	}
+
+	bodyHash.Write(bz)
+	return nil
+}
+
+// ingestAuthenticator authenticates the ingest requests with the shared-secret HMAC.
+type ingestAuthenticator struct {
+	sharedSecret string
+	maxClockSkew time.Duration
+	timeNowFunc  func() time.Time
+	logger       log.Logger
+
+	// mx guards usedNonces.
+	mx sync.Mutex
+	// usedNonces are the nonces of the authenticated requests mapped to the time after which
+	// their timestamp is outside of the allowed clock skew, so that they can no longer be replayed.
+	usedNonces map[string]time.Time
+}
+
+// signedRequest is the metadata of a request that must be signed.
+type signedRequest struct {
+	fullMethod string
+	timestamp  int64
+	nonce      string
+	signature  string
+}
+
+// newIngestAuthenticator returns a new ingest authenticator for the given config.
+func newIngestAuthenticator(authConfig domain.GRPCIngesterAuthConfig, logger log.Logger) *ingestAuthenticator {
+	return &ingestAuthenticator{
+		sharedSecret: authConfig.SharedSecret,
+		maxClockSkew: time.Duration(authConfig.MaxClockSkewSeconds) * time.Second,
+		timeNowFunc:  time.Now,
+		logger:       logger,
+		usedNonces:   make(map[string]time.Time),
+	}
+}
+
+// authenticate validates the timestamp, the nonce and the signature over the given body hash
+// from the incoming metadata.
+// On failure, it writes an audit log line, increments the unauthenticated request counter
+// and returns an Unauthenticated status error.
+func (a *ingestAuthenticator) authenticate(ctx context.Context, fullMethod string, bodyHash []byte) error {
+	request, err := a.parseMetadata(ctx, fullMethod)
+	if err == nil {
+		err = a.verify(request, bodyHash)
+	}
+
+	return a.handleError(ctx, fullMethod, err)
+}
+
+// handleError returns an Unauthenticated status error, writing an audit log line and incrementing
+// the unauthenticated request counter, if the given error is non-nil. Returns nil otherwise.
+func (a *ingestAuthenticator) handleError(ctx context.Context, fullMethod string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	peerAddr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerAddr = p.Addr.String()
+	}
+
+	a.logger.Warn(domain.SQSIngestHandlerUnauthenticatedRequestMetricName, zap.String("method", fullMethod), zap.String("peer", peerAddr), zap.Error(err))
+	domain.SQSIngestHandlerUnauthenticatedRequestCounter.Inc()
+
+	return status.Error(codes.Unauthenticated, "unauthenticated ingest request")
+}
+
+// parseMetadata returns the signed request from the incoming metadata.
+// Returns error if the metadata is incomplete or the timestamp is outside of the allowed clock skew.
+func (a *ingestAuthenticator) parseMetadata(ctx context.Context, fullMethod string) (signedRequest, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return signedRequest{}, errMissingMetadata
+	}
+
+	timestamps := md.Get(IngestTimestampMetadataKey)
+	if len(timestamps) == 0 {
+		return signedRequest{}, errMissingTimestamp
+	}
+
+	nonces := md.Get(IngestNonceMetadataKey)
+	if len(nonces) == 0 || nonces[0] == "" {
+		return signedRequest{}, errMissingNonce
+	}
+
+	signatures := md.Get(IngestSignatureMetadataKey)
+	if len(signatures) == 0 {
+		return signedRequest{}, errMissingSignature
+	}
+
+	timestamp, err := strconv.ParseInt(timestamps[0], 10, 64)
+	if err != nil {
+		return signedRequest{}, errInvalidTimestamp
+	}
+
+	if err := a.validateTimestamp(timestamp); err != nil {
+		return signedRequest{}, err
+	}
+
+	return signedRequest{
+		fullMethod: fullMethod,
+		timestamp:  timestamp,
+		nonce:      nonces[0],
+		signature:  signatures[0],
+	}, nil
+}
+
+// validateTimestamp returns nil if the timestamp is within the allowed clock skew.
+func (a *ingestAuthenticator) validateTimestamp(timestamp int64) error {
+	skew := a.timeNowFunc().Sub(time.Unix(timestamp, 0))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > a.maxClockSkew {
+		return errExpiredTimestamp
+	}
+	return nil
+}
+
+// verify returns nil if the signature of the request over the given body hash is valid
+// and its nonce has not been used yet.
+// The freshness of the timestamp is validated once when parsing the metadata, so that the body
+// of a streaming call may be received after the timestamp is outside of the allowed clock skew.
+// On success, the nonce is recorded so that the request cannot be replayed.
+func (a *ingestAuthenticator) verify(request signedRequest, bodyHash []byte) error {
+	expectedSignature := ComputeIngestSignature(a.sharedSecret, request.fullMethod, request.timestamp, request.nonce, bodyHash)
+	if !hmac.Equal([]byte(expectedSignature), []byte(request.signature)) {
+		return errInvalidSignature
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	now := a.timeNowFunc()
+
+	// Prune the nonces that can no longer be replayed since their timestamp is expired.
+	for nonce, expiresAt := range a.usedNonces {
+		if now.After(expiresAt) {
+			delete(a.usedNonces, nonce)
+		}
+	}
+
+	if _, used := a.usedNonces[request.nonce]; used {
+		return errReusedNonce
+	}
+
+	// A replayed stream may have started while the timestamp was fresh and commit later,
+	// so the nonce is kept for at least the allowed clock skew after it is used.
+	expiresAt := time.Unix(request.timestamp, 0).Add(a.maxClockSkew)
+	if usedExpiresAt := now.Add(a.maxClockSkew); usedExpiresAt.After(expiresAt) {
+		expiresAt = usedExpiresAt
+	}
+	a.usedNonces[request.nonce] = expiresAt
+
+	return nil
+}
+
+// unaryInterceptor rejects unauthenticated unary calls.
+// The signature covers the request message.
+func (a *ingestAuthenticator) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	bodyHash := sha256.New()
+	if err := writeIngestBody(bodyHash, req); err != nil {
+		return nil, a.handleError(ctx, info.FullMethod, err)
+	}
+
+	if err := a.authenticate(ctx, info.FullMethod, bodyHash.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor rejects streaming calls with invalid metadata before any message is received.
+// The signature covers every message of the stream and is verified once the block commit is received,
+// before the handler can dispatch the block.
+func (a *ingestAuthenticator) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	request, err := a.parseMetadata(ss.Context(), info.FullMethod)
+	if err != nil {
+		return a.handleError(ss.Context(), info.FullMethod, err)
+	}
+
+	return handler(srv, &authenticatedServerStream{
+		ServerStream:  ss,
+		authenticator: a,
+		request:       request,
+		bodyHash:      sha256.New(),
+	})
+}
+
+// authenticatedServerStream hashes the received messages and verifies the signature
+// of the stream upon receiving the block commit.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+
+	authenticator *ingestAuthenticator
+	request       signedRequest
+	bodyHash      hash.Hash
+}
+
+// RecvMsg implements grpc.ServerStream.
+// Returns an Unauthenticated status error instead of the block commit if the signature is invalid.
+func (s *authenticatedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if err := writeIngestBody(s.bodyHash, m); err != nil {
+		return s.authenticator.handleError(s.Context(), s.request.fullMethod, err)
+	}
+
+	if chunk, ok := m.(*prototypes.ProcessBlockChunk); ok && chunk.GetCommit() != nil {
+		return s.authenticator.handleError(s.Context(), s.request.fullMethod, s.authenticator.verify(s.request, s.bodyHash.Sum(nil)))
+	}
+
+	return nil
+}
+
+// newServerTransportCredentials returns the TLS transport credentials for the given config.
+// If the client CA file is set, client certificates are required and verified (mTLS).
+func newServerTransportCredentials(tlsConfig domain.GRPCIngesterTLSConfig) (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load grpc ingester key pair: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if tlsConfig.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(tlsConfig.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read grpc ingester client CA file: %w", err)
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("failed to parse grpc ingester client CA file (%s)", tlsConfig.ClientCAFile)
+		}
+
+		config.ClientCAs = clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(config), nil
+}
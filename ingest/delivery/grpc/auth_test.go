@@ -0,0 +1,345 @@
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/osmosis-labs/sqs/domain"
+	ingestgrpc "github.com/osmosis-labs/sqs/ingest/delivery/grpc"
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+)
+
+const (
+	sharedSecret = "secret"
+	nonce        = "nonce"
+)
+
+var (
+	now          = time.Unix(1_700_000_000, 0)
+	nowTimestamp = now.Unix()
+
+	authConfig = domain.GRPCIngesterAuthConfig{
+		Enabled:             true,
+		SharedSecret:        sharedSecret,
+		MaxClockSkewSeconds: 30,
+	}
+)
+
+// withSignedMetadata returns the incoming context with the given timestamp, nonce and signature.
+func withSignedMetadata(timestamp int64, nonce string, signature string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		ingestgrpc.IngestTimestampMetadataKey, strconv.FormatInt(timestamp, 10),
+		ingestgrpc.IngestNonceMetadataKey, nonce,
+		ingestgrpc.IngestSignatureMetadataKey, signature,
+	))
+}
+
+// mustComputeBodyHash returns the body hash of the given messages.
+func mustComputeBodyHash(t *testing.T, messages ...proto.Message) []byte {
+	bodyHash, err := ingestgrpc.ComputeIngestBodyHash(messages...)
+	require.NoError(t, err)
+	return bodyHash
+}
+
+// requireUnauthenticated requires that the error is an Unauthenticated status error.
+func requireUnauthenticated(t *testing.T, err error) {
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Tests that only requests with a fresh timestamp and a valid signature
+// over the called method, the nonce and the body are authenticated.
+func TestIngestAuthenticator_Authenticate(t *testing.T) {
+	const fullMethod = prototypes.SQSIngester_ProcessBlock_FullMethodName
+
+	var (
+		bodyHash      = mustComputeBodyHash(t, &prototypes.ProcessBlockRequest{BlockHeight: 1})
+		otherBodyHash = mustComputeBodyHash(t, &prototypes.ProcessBlockRequest{BlockHeight: 2})
+	)
+
+	tests := []struct {
+		name string
+
+		ctx context.Context
+
+		expectUnauthenticated bool
+	}{
+		{
+			name: "valid signature",
+
+			ctx: withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, nonce, bodyHash)),
+		},
+		{
+			name: "valid signature within clock skew",
+
+			ctx: withSignedMetadata(nowTimestamp-29, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp-29, nonce, bodyHash)),
+		},
+		{
+			name: "no metadata",
+
+			ctx: context.Background(),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "missing signature",
+
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				ingestgrpc.IngestTimestampMetadataKey, strconv.FormatInt(nowTimestamp, 10),
+				ingestgrpc.IngestNonceMetadataKey, nonce,
+			)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "missing nonce",
+
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				ingestgrpc.IngestTimestampMetadataKey, strconv.FormatInt(nowTimestamp, 10),
+				ingestgrpc.IngestSignatureMetadataKey, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, "", bodyHash),
+			)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "wrong secret",
+
+			ctx: withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature("other", fullMethod, nowTimestamp, nonce, bodyHash)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "signature for a different method",
+
+			ctx: withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, prototypes.SQSIngester_ProcessChunkedBlock_FullMethodName, nowTimestamp, nonce, bodyHash)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "signature for a different nonce",
+
+			ctx: withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, "other", bodyHash)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "signature for a different body",
+
+			ctx: withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, nonce, otherBodyHash)),
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "stale timestamp",
+
+			ctx: withSignedMetadata(nowTimestamp-31, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp-31, nonce, bodyHash)),
+
+			expectUnauthenticated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := ingestgrpc.NewIngestAuthenticator(authConfig, func() time.Time { return now })
+
+			err := authenticator.Authenticate(tt.ctx, fullMethod, bodyHash)
+
+			if tt.expectUnauthenticated {
+				requireUnauthenticated(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Tests that a nonce is only accepted once while its timestamp is within the allowed clock skew.
+func TestIngestAuthenticator_Replay(t *testing.T) {
+	const fullMethod = prototypes.SQSIngester_ProcessBlock_FullMethodName
+
+	currentTime := now
+	authenticator := ingestgrpc.NewIngestAuthenticator(authConfig, func() time.Time { return currentTime })
+
+	bodyHash := mustComputeBodyHash(t, &prototypes.ProcessBlockRequest{BlockHeight: 1})
+	ctx := withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, nonce, bodyHash))
+
+	require.NoError(t, authenticator.Authenticate(ctx, fullMethod, bodyHash))
+
+	// Replayed within the clock skew.
+	currentTime = now.Add(10 * time.Second)
+	requireUnauthenticated(t, authenticator.Authenticate(ctx, fullMethod, bodyHash))
+
+	// Replayed after the clock skew.
+	currentTime = now.Add(31 * time.Second)
+	requireUnauthenticated(t, authenticator.Authenticate(ctx, fullMethod, bodyHash))
+
+	// The same nonce with a new timestamp is a new request.
+	newTimestamp := currentTime.Unix()
+	ctx = withSignedMetadata(newTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, newTimestamp, nonce, bodyHash))
+	require.NoError(t, authenticator.Authenticate(ctx, fullMethod, bodyHash))
+}
+
+// mockChunkServerStream is a server stream receiving the given chunks.
+type mockChunkServerStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	chunks []*prototypes.ProcessBlockChunk
+}
+
+func (s *mockChunkServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockChunkServerStream) RecvMsg(m any) error {
+	if len(s.chunks) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), s.chunks[0])
+	s.chunks = s.chunks[1:]
+	return nil
+}
+
+// Tests that the signature of a stream covers all of its chunks and is verified
+// when the block commit is received.
+func TestIngestAuthenticator_StreamInterceptor(t *testing.T) {
+	const fullMethod = prototypes.SQSIngester_ProcessChunkedBlock_FullMethodName
+
+	var (
+		poolsChunk = &prototypes.ProcessBlockChunk{
+			BlockHeight: 1,
+			Chunk:       &prototypes.ProcessBlockChunk_Pools{Pools: &prototypes.ProcessBlockPoolsChunk{}},
+		}
+		commitChunk = &prototypes.ProcessBlockChunk{
+			BlockHeight: 1,
+			Chunk:       &prototypes.ProcessBlockChunk_Commit{Commit: &prototypes.ProcessBlockCommit{}},
+		}
+		tamperedCommitChunk = &prototypes.ProcessBlockChunk{
+			BlockHeight: 1,
+			Chunk:       &prototypes.ProcessBlockChunk_Commit{Commit: &prototypes.ProcessBlockCommit{TakerFeesMap: []byte("{}")}},
+		}
+
+		bodyHash = mustComputeBodyHash(t, poolsChunk, commitChunk)
+	)
+
+	tests := []struct {
+		name string
+
+		chunks []*prototypes.ProcessBlockChunk
+
+		expectUnauthenticated bool
+	}{
+		{
+			name: "valid signature",
+
+			chunks: []*prototypes.ProcessBlockChunk{poolsChunk, commitChunk},
+		},
+		{
+			name: "tampered commit",
+
+			chunks: []*prototypes.ProcessBlockChunk{poolsChunk, tamperedCommitChunk},
+
+			expectUnauthenticated: true,
+		},
+		{
+			name: "missing chunk",
+
+			chunks: []*prototypes.ProcessBlockChunk{commitChunk},
+
+			expectUnauthenticated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := ingestgrpc.NewIngestAuthenticator(authConfig, func() time.Time { return now })
+
+			stream := &mockChunkServerStream{
+				ctx:    withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, nonce, bodyHash)),
+				chunks: tt.chunks,
+			}
+
+			// Receives chunks until the commit, as the handler does.
+			err := authenticator.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: fullMethod}, func(srv any, ss grpc.ServerStream) error {
+				for {
+					chunk := &prototypes.ProcessBlockChunk{}
+					if err := ss.RecvMsg(chunk); err != nil {
+						return err
+					}
+
+					if chunk.GetCommit() != nil {
+						return nil
+					}
+				}
+			})
+
+			if tt.expectUnauthenticated {
+				requireUnauthenticated(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Tests that a stream whose upload takes longer than the allowed clock skew is accepted
+// since the freshness of its timestamp is only validated when it starts, and that it cannot be replayed.
+func TestIngestAuthenticator_StreamInterceptor_SlowUpload(t *testing.T) {
+	const fullMethod = prototypes.SQSIngester_ProcessChunkedBlock_FullMethodName
+
+	var (
+		poolsChunk = &prototypes.ProcessBlockChunk{
+			BlockHeight: 1,
+			Chunk:       &prototypes.ProcessBlockChunk_Pools{Pools: &prototypes.ProcessBlockPoolsChunk{}},
+		}
+		commitChunk = &prototypes.ProcessBlockChunk{
+			BlockHeight: 1,
+			Chunk:       &prototypes.ProcessBlockChunk_Commit{Commit: &prototypes.ProcessBlockCommit{}},
+		}
+
+		bodyHash = mustComputeBodyHash(t, poolsChunk, commitChunk)
+		ctx      = withSignedMetadata(nowTimestamp, nonce, ingestgrpc.ComputeIngestSignature(sharedSecret, fullMethod, nowTimestamp, nonce, bodyHash))
+	)
+
+	currentTime := now
+	authenticator := ingestgrpc.NewIngestAuthenticator(authConfig, func() time.Time { return currentTime })
+
+	// Receives chunks until the commit, advancing the time past the clock skew after the first chunk.
+	streamHandler := func(srv any, ss grpc.ServerStream) error {
+		for {
+			chunk := &prototypes.ProcessBlockChunk{}
+			if err := ss.RecvMsg(chunk); err != nil {
+				return err
+			}
+
+			if chunk.GetCommit() != nil {
+				return nil
+			}
+
+			currentTime = currentTime.Add(time.Minute)
+		}
+	}
+
+	stream := &mockChunkServerStream{ctx: ctx, chunks: []*prototypes.ProcessBlockChunk{poolsChunk, commitChunk}}
+	require.NoError(t, authenticator.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: fullMethod}, streamHandler))
+
+	// A replay started while the timestamp was fresh is rejected at commit.
+	currentTime = now
+	stream = &mockChunkServerStream{ctx: ctx, chunks: []*prototypes.ProcessBlockChunk{poolsChunk, commitChunk}}
+	requireUnauthenticated(t, authenticator.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: fullMethod}, streamHandler))
+}
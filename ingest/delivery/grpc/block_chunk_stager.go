@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"fmt"
+
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+)
+
+// blockChunkStager accumulates the chunks of a single streamed block.
+// The staged pools are only released on commit, guaranteeing that a
+// partially received block is never propagated to the ingest use case.
+// It is not safe for concurrent use and is expected to be scoped to a single stream.
+type blockChunkStager struct {
+	height    uint64
+	hasHeight bool
+	pools     []*prototypes.PoolData
+}
+
+// stagedBlock is the result of a successfully committed chunked block.
+type stagedBlock struct {
+	height       uint64
+	takerFeesMap []byte
+	pools        []*prototypes.PoolData
+}
+
+// newBlockChunkStager returns a new empty block chunk stager.
+func newBlockChunkStager() *blockChunkStager {
+	return &blockChunkStager{}
+}
+
+// stage validates the given chunk and stages it.
+// Returns a non-nil staged block if the chunk is a commit message.
+// Returns error if:
+// - the chunk height differs from the height of previously staged chunks.
+// - the chunk is empty.
+// - the number of pools in the commit does not match the number of staged pools.
+func (s *blockChunkStager) stage(chunk *prototypes.ProcessBlockChunk) (*stagedBlock, error) {
+	if !s.hasHeight {
+		s.height = chunk.BlockHeight
+		s.hasHeight = true
+	} else if chunk.BlockHeight != s.height {
+		return nil, fmt.Errorf("chunk height (%d) does not match staged block height (%d)", chunk.BlockHeight, s.height)
+	}
+
+	switch c := chunk.Chunk.(type) {
+	case *prototypes.ProcessBlockChunk_Pools:
+		s.pools = append(s.pools, c.Pools.GetPools()...)
+		return nil, nil
+	case *prototypes.ProcessBlockChunk_Commit:
+		if c.Commit == nil {
+			return nil, fmt.Errorf("commit for block height (%d) is nil", s.height)
+		}
+
+		if c.Commit.NumPools != uint64(len(s.pools)) {
+			return nil, fmt.Errorf("commit for block height (%d) expects (%d) pools, staged (%d)", s.height, c.Commit.NumPools, len(s.pools))
+		}
+
+		return &stagedBlock{
+			height:       s.height,
+			takerFeesMap: c.Commit.TakerFeesMap,
+			pools:        s.pools,
+		}, nil
+	default:
+		return nil, fmt.Errorf("empty chunk received for block height (%d)", chunk.BlockHeight)
+	}
+}
+
+// discard drops all staged data.
+func (s *blockChunkStager) discard() {
+	s.height = 0
+	s.hasHeight = false
+	s.pools = nil
+}
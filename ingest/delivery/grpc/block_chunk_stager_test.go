@@ -0,0 +1,145 @@
+package grpc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ingestgrpc "github.com/osmosis-labs/sqs/ingest/delivery/grpc"
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+)
+
+const defaultHeight = 100
+
+var (
+	poolA = &prototypes.PoolData{ChainModel: []byte("a")}
+	poolB = &prototypes.PoolData{ChainModel: []byte("b")}
+
+	takerFees = []byte("{}")
+)
+
+func poolsChunk(height uint64, pools ...*prototypes.PoolData) *prototypes.ProcessBlockChunk {
+	return &prototypes.ProcessBlockChunk{
+		BlockHeight: height,
+		Chunk: &prototypes.ProcessBlockChunk_Pools{
+			Pools: &prototypes.ProcessBlockPoolsChunk{Pools: pools},
+		},
+	}
+}
+
+func commitChunk(height uint64, numPools uint64) *prototypes.ProcessBlockChunk {
+	return &prototypes.ProcessBlockChunk{
+		BlockHeight: height,
+		Chunk: &prototypes.ProcessBlockChunk_Commit{
+			Commit: &prototypes.ProcessBlockCommit{TakerFeesMap: takerFees, NumPools: numPools},
+		},
+	}
+}
+
+// Tests that the chunks are staged until commit and validated.
+func TestBlockChunkStager_Stage(t *testing.T) {
+	tests := []struct {
+		name string
+
+		chunks []*prototypes.ProcessBlockChunk
+
+		expectedCommitted bool
+		expectedPools     []*prototypes.PoolData
+		expectError       bool
+	}{
+		{
+			name: "multiple chunks followed by commit",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				poolsChunk(defaultHeight, poolA),
+				poolsChunk(defaultHeight, poolB),
+				commitChunk(defaultHeight, 2),
+			},
+
+			expectedCommitted: true,
+			expectedPools:     []*prototypes.PoolData{poolA, poolB},
+		},
+		{
+			name: "commit with no chunks",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				commitChunk(defaultHeight, 0),
+			},
+
+			expectedCommitted: true,
+		},
+		{
+			name: "chunks without commit are not released",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				poolsChunk(defaultHeight, poolA),
+				poolsChunk(defaultHeight, poolB),
+			},
+		},
+		{
+			name: "height mismatch",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				poolsChunk(defaultHeight, poolA),
+				poolsChunk(defaultHeight+1, poolB),
+			},
+
+			expectError: true,
+		},
+		{
+			name: "pool count mismatch on commit",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				poolsChunk(defaultHeight, poolA),
+				commitChunk(defaultHeight, 2),
+			},
+
+			expectError: true,
+		},
+		{
+			name: "empty chunk",
+
+			chunks: []*prototypes.ProcessBlockChunk{
+				{BlockHeight: defaultHeight},
+			},
+
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			stager := ingestgrpc.NewBlockChunkStager()
+
+			var (
+				committed bool
+				height    uint64
+				fees      []byte
+				pools     []*prototypes.PoolData
+				err       error
+			)
+			for _, chunk := range tt.chunks {
+				committed, height, fees, pools, err = stager.Stage(chunk)
+				if err != nil {
+					break
+				}
+			}
+
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedCommitted, committed)
+			if !tt.expectedCommitted {
+				return
+			}
+
+			require.Equal(t, uint64(defaultHeight), height)
+			require.Equal(t, takerFees, fees)
+			require.Equal(t, tt.expectedPools, pools)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+)
+
+type (
+	BlockChunkStager = blockChunkStager
+)
+
+func NewBlockChunkStager() *BlockChunkStager {
+	return newBlockChunkStager()
+}
+
+// Stage stages the chunk and returns the height, taker fees map and pools
+// of the committed block, if any.
+func (s *BlockChunkStager) Stage(chunk *prototypes.ProcessBlockChunk) (bool, uint64, []byte, []*prototypes.PoolData, error) {
+	block, err := s.stage(chunk)
+	if err != nil || block == nil {
+		return false, 0, nil, nil, err
+	}
+	return true, block.height, block.takerFeesMap, block.pools, nil
+}
+
+type (
+	IngestAuthenticator = ingestAuthenticator
+)
+
+func NewIngestAuthenticator(authConfig domain.GRPCIngesterAuthConfig, timeNowFunc func() time.Time) *IngestAuthenticator {
+	authenticator := newIngestAuthenticator(authConfig, &log.NoOpLogger{})
+	authenticator.timeNowFunc = timeNowFunc
+	return authenticator
+}
+
+func (a *IngestAuthenticator) Authenticate(ctx context.Context, fullMethod string, bodyHash []byte) error {
+	return a.authenticate(ctx, fullMethod, bodyHash)
+}
+
+func (a *IngestAuthenticator) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return a.streamInterceptor(srv, ss, info, handler)
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"io"
+	"net"
 	"time"
 
 	"github.com/osmosis-labs/sqs/domain"
@@ -17,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -40,6 +43,12 @@ const (
 	numBlockProcessWorkers = 2
 
 	tracerName = "sqs-ingest-handler"
+
+	// IngestSourceMetadataKey is the gRPC metadata key identifying the source
+	// pushing the blocks. If not set, the peer address is used instead.
+	IngestSourceMetadataKey = "x-sqs-ingest-source"
+
+	unknownIngestSource = "unknown"
 )
 
 var (
@@ -56,18 +65,43 @@ func NewIngestGRPCHandler(us mvc.IngestUsecase, grpcIngesterConfig domain.GRPCIn
 		blockProcessDispatcher: workerpool.NewDispatcher[uint64](numBlockProcessWorkers),
 	}
 
-	go ingestHandler.blockProcessDispatcher.Run()
+	if err := grpcIngesterConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(grpcIngesterConfig.MaxReceiveMsgSizeBytes),
+		grpc.ConnectionTimeout(time.Second * time.Duration(grpcIngesterConfig.ServerConnectionTimeoutSeconds)),
+	}
 
-	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(grpcIngesterConfig.MaxReceiveMsgSizeBytes), grpc.ConnectionTimeout(time.Second*time.Duration(grpcIngesterConfig.ServerConnectionTimeoutSeconds)))
+	// Encrypt the transport and, if client CA is configured, enforce mTLS.
+	if grpcIngesterConfig.TLS.Enabled {
+		transportCredentials, err := newServerTransportCredentials(grpcIngesterConfig.TLS)
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(transportCredentials))
+	}
+
+	// Reject requests that are not signed with the shared secret.
+	if grpcIngesterConfig.Auth.Enabled {
+		authenticator := newIngestAuthenticator(grpcIngesterConfig.Auth, logger)
+		serverOpts = append(serverOpts,
+			grpc.UnaryInterceptor(authenticator.unaryInterceptor),
+			grpc.StreamInterceptor(authenticator.streamInterceptor),
+		)
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	prototypes.RegisterSQSIngesterServer(grpcServer, ingestHandler)
 
+	go ingestHandler.blockProcessDispatcher.Run()
+
 	return grpcServer, nil
 }
 
 // ProcessChainPools implements types.IngesterServer.
 func (i *IngestGRPCHandler) ProcessBlock(ctx context.Context, req *prototypes.ProcessBlockRequest) (*prototypes.ProcessBlockReply, error) {
-	takerFeeMap := sqsdomain.TakerFeeMap{}
-
 	// If there's some metadata in the context, retrieve it.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -82,17 +116,91 @@ func (i *IngestGRPCHandler) ProcessBlock(ctx context.Context, req *prototypes.Pr
 	parentCtx, span := tracer.Start(parentCtx, "IngestGRPCHandler.ProcessBlock", trace.WithSpanKind(trace.SpanKindServer))
 	defer span.End()
 
-	if err := takerFeeMap.UnmarshalJSON(req.TakerFeesMap); err != nil {
+	if err := i.dispatchBlock(parentCtx, getIngestSource(ctx, md), req.BlockHeight, req.TakerFeesMap, req.Pools); err != nil {
 		return nil, err
 	}
 
+	return &prototypes.ProcessBlockReply{}, nil
+}
+
+// ProcessChunkedBlock implements types.IngesterServer.
+// It stages the received pool chunks and dispatches the block for processing
+// only once the commit message is received. If the stream fails or closes before
+// the commit, the staged chunks are discarded.
+func (i *IngestGRPCHandler) ProcessChunkedBlock(stream prototypes.SQSIngester_ProcessChunkedBlockServer) error {
+	ctx := stream.Context()
+
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Extract the existing span context from the incoming request
+	parentCtx := otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(md))
+
+	// Start a new span representing the request
+	// The span ends when the request is complete
+	parentCtx, span := tracer.Start(parentCtx, "IngestGRPCHandler.ProcessChunkedBlock", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	stager := newBlockChunkStager()
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			stager.discard()
+			return status.Error(codes.InvalidArgument, "stream closed before block commit")
+		}
+		if err != nil {
+			stager.discard()
+			return err
+		}
+
+		block, err := stager.stage(chunk)
+		if err != nil {
+			stager.discard()
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// Not yet committed, continue receiving chunks.
+		if block == nil {
+			continue
+		}
+
+		if err := i.dispatchBlock(parentCtx, getIngestSource(ctx, md), block.height, block.takerFeesMap, block.pools); err != nil {
+			return err
+		}
+
+		return stream.SendAndClose(&prototypes.ProcessBlockReply{})
+	}
+}
+
+// dispatchBlock parses the taker fee map and dispatches the block for processing
+// to the block process workers.
+// The block is silently skipped if its height has already been processed from any source.
+// The height is only marked as processed once the block is processed successfully so that,
+// on failure, the same block pushed by another source is processed instead.
+// Returns the first error from previously processed blocks if any.
+func (i *IngestGRPCHandler) dispatchBlock(parentCtx context.Context, source string, height uint64, takerFeesMap []byte, pools []*prototypes.PoolData) error {
+	if !i.ingestUseCase.AcceptBlock(parentCtx, source, height) {
+		return nil
+	}
+
+	takerFeeMap := sqsdomain.TakerFeeMap{}
+	if err := takerFeeMap.UnmarshalJSON(takerFeesMap); err != nil {
+		i.ingestUseCase.CompleteBlock(height, err)
+		return err
+	}
+
 	// Empty result queue and return the first error encountered if any
 	// THis allows to trigger the fallback mechanism, reingesting all data
 	// if any error is detected. Under normal circumstances, this should not
 	// be triggered.
 	err := i.emptyResults()
 	if err != nil {
-		return nil, err
+		i.ingestUseCase.CompleteBlock(height, err)
+		return err
 	}
 
 	// Dispatch block processing
@@ -105,19 +213,40 @@ func (i *IngestGRPCHandler) ProcessBlock(ctx context.Context, req *prototypes.Pr
 			span := trace.SpanFromContext(parentCtx)
 			ctx = trace.ContextWithSpan(ctx, span)
 
-			if err := i.ingestUseCase.ProcessBlockData(ctx, req.BlockHeight, takerFeeMap, req.Pools); err != nil {
+			err := i.ingestUseCase.ProcessBlockData(ctx, height, takerFeeMap, pools)
+			i.ingestUseCase.CompleteBlock(height, err)
+			if err != nil {
 				// Increment error counter
-				i.logger.Error(domain.SQSIngestUsecaseProcessBlockErrorMetricName, zap.Uint64("height", req.BlockHeight), zap.Error(err))
+				i.logger.Error(domain.SQSIngestUsecaseProcessBlockErrorMetricName, zap.Uint64("height", height), zap.Error(err))
 				domain.SQSIngestHandlerProcessBlockErrorCounter.Inc()
 
-				return req.BlockHeight, err
+				return height, err
 			}
 
-			return req.BlockHeight, nil
+			return height, nil
 		},
 	}
 
-	return &prototypes.ProcessBlockReply{}, nil
+	return nil
+}
+
+// getIngestSource returns the source of the ingest request from the metadata.
+// Falls back to the peer host if the source is not set. The port is omitted
+// so that reconnections from the same node are attributed to the same source.
+func getIngestSource(ctx context.Context, md metadata.MD) string {
+	if sources := md.Get(IngestSourceMetadataKey); len(sources) > 0 && sources[0] != "" {
+		return sources[0]
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+
+	return unknownIngestSource
 }
 
 // emptyResults will empty the result queue and return the first error encountered if any.
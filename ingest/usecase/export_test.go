@@ -1,9 +1,12 @@
 package usecase
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
 	"github.com/osmosis-labs/sqs/sqsdomain"
 	"github.com/osmosis-labs/sqs/sqsdomain/cosmwasmpool"
 )
@@ -39,3 +42,23 @@ func ComputeNormalizationScalingFactors(standardNormalizationFactor osmomath.Int
 func ProcessAlloyedPool(sqsModel *sqsdomain.SQSPool) error {
 	return processAlloyedPool(sqsModel)
 }
+
+func (p *ingestUseCase) SetCreationHeightMut(sqsModel *sqsdomain.SQSPool, poolID uint64, height uint64) {
+	p.setCreationHeightMut(sqsModel, poolID, height)
+}
+
+func NewIngestSourceTracker() *ingestSourceTracker {
+	return newIngestSourceTracker(&log.NoOpLogger{})
+}
+
+func (t *ingestSourceTracker) Accept(ctx context.Context, source string, height uint64) bool {
+	return t.accept(ctx, source, height)
+}
+
+func (t *ingestSourceTracker) Complete(height uint64, err error) {
+	t.complete(height, err)
+}
+
+func (t *ingestSourceTracker) Status() []domain.IngestSourceStatus {
+	return t.status()
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+// ingestSourceTracker tracks the heights pushed by multiple ingest sources.
+// It guarantees that each height is processed successfully at most once, in increasing order,
+// regardless of the number of sources pushing the same blocks.
+//
+// A height is claimed by the first source that pushes it and is only accepted once its processing
+// completes successfully. If the processing fails, the claim is released so that the copy of the
+// same height pushed by another source is processed instead.
+type ingestSourceTracker struct {
+	mu sync.Mutex
+
+	// latestAcceptedHeight is the latest height processed successfully across all sources.
+	latestAcceptedHeight uint64
+	// activeSource is the source that delivered the latest accepted height.
+	activeSource string
+	// sourceHeights is the latest height received from each source.
+	sourceHeights map[string]uint64
+	// claims are the heights being processed.
+	claims map[uint64]*heightClaim
+
+	logger log.Logger
+}
+
+// heightClaim is a height claimed by a source and being processed.
+type heightClaim struct {
+	source string
+	// done is closed once the processing of the height completes.
+	done chan struct{}
+}
+
+// newIngestSourceTracker returns a new ingest source tracker.
+func newIngestSourceTracker(logger log.Logger) *ingestSourceTracker {
+	return &ingestSourceTracker{
+		sourceHeights: make(map[string]uint64),
+		claims:        make(map[uint64]*heightClaim),
+		logger:        logger,
+	}
+}
+
+// accept records the height received from the given source and returns true
+// if the height is claimed for processing by the source. The claim must be completed with complete.
+// Returns false if the height is lower or equal to the latest accepted height or lower than a height being processed.
+// If the same height is being processed from another source, it waits for the processing to complete
+// and claims the height only if the processing failed, or returns false if the context is done first.
+func (t *ingestSourceTracker) accept(ctx context.Context, source string, height uint64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previousSourceHeight, seen := t.sourceHeights[source]
+	if seen && height < previousSourceHeight {
+		t.logger.Warn(domain.SQSIngestSourceHeightRegressionMetricName, zap.String("source", source), zap.Uint64("height", height), zap.Uint64("previous_height", previousSourceHeight))
+		domain.SQSIngestSourceHeightRegressionCounter.WithLabelValues(source).Inc()
+	}
+	t.sourceHeights[source] = height
+
+	for {
+		claim, isClaimed := t.claims[height]
+		if !isClaimed {
+			break
+		}
+
+		// Wait for the processing of the same height from another source to complete.
+		t.mu.Unlock()
+		select {
+		case <-claim.done:
+		case <-ctx.Done():
+		}
+		t.mu.Lock()
+
+		if ctx.Err() != nil {
+			t.skip(source, height)
+			return false
+		}
+	}
+
+	latestHeight := t.latestClaimedOrAcceptedHeight()
+	if height <= t.latestAcceptedHeight || height < latestHeight {
+		t.skip(source, height)
+		return false
+	}
+
+	if latestHeight != 0 && height > latestHeight+1 {
+		t.logger.Warn(domain.SQSIngestHeightGapMetricName, zap.String("source", source), zap.Uint64("height", height), zap.Uint64("latest_accepted_height", latestHeight))
+		domain.SQSIngestHeightGapCounter.Inc()
+	}
+
+	t.claims[height] = &heightClaim{
+		source: source,
+		done:   make(chan struct{}),
+	}
+
+	t.updateLagMetrics()
+	return true
+}
+
+// complete completes the processing of the height claimed by accept.
+// If the processing succeeded, the height is accepted. Otherwise, the claim is released
+// so that the same height pushed by another source can be claimed.
+func (t *ingestSourceTracker) complete(height uint64, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	claim, ok := t.claims[height]
+	if !ok {
+		return
+	}
+	delete(t.claims, height)
+
+	if err == nil && height > t.latestAcceptedHeight {
+		t.latestAcceptedHeight = height
+		t.activeSource = claim.source
+	}
+
+	close(claim.done)
+
+	t.updateLagMetrics()
+}
+
+// skip records that the height received from the given source is skipped.
+// CONTRACT: the caller holds the lock.
+func (t *ingestSourceTracker) skip(source string, height uint64) {
+	t.logger.Debug("skipping already accepted height", zap.String("source", source), zap.Uint64("height", height), zap.Uint64("latest_accepted_height", t.latestAcceptedHeight))
+	domain.SQSIngestSourceSkippedBlockCounter.WithLabelValues(source).Inc()
+
+	t.updateLagMetrics()
+}
+
+// latestClaimedOrAcceptedHeight returns the greater of the latest accepted height and the heights being processed.
+// CONTRACT: the caller holds the lock.
+func (t *ingestSourceTracker) latestClaimedOrAcceptedHeight() uint64 {
+	latestHeight := t.latestAcceptedHeight
+	for height := range t.claims {
+		if height > latestHeight {
+			latestHeight = height
+		}
+	}
+	return latestHeight
+}
+
+// status returns the status of all sources sorted by source.
+func (t *ingestSourceTracker) status() []domain.IngestSourceStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]domain.IngestSourceStatus, 0, len(t.sourceHeights))
+	for source, height := range t.sourceHeights {
+		result = append(result, domain.IngestSourceStatus{
+			Source:       source,
+			LatestHeight: height,
+			Lag:          t.lag(height),
+			IsActive:     source == t.activeSource,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source < result[j].Source
+	})
+
+	return result
+}
+
+// lag returns the number of blocks the given height is behind the latest accepted height.
+// CONTRACT: the caller holds the lock.
+func (t *ingestSourceTracker) lag(height uint64) uint64 {
+	if height >= t.latestAcceptedHeight {
+		return 0
+	}
+	return t.latestAcceptedHeight - height
+}
+
+// updateLagMetrics updates the per-source lag and active source gauges.
+// CONTRACT: the caller holds the lock.
+func (t *ingestSourceTracker) updateLagMetrics() {
+	for source, height := range t.sourceHeights {
+		domain.SQSIngestSourceLagGauge.WithLabelValues(source).Set(float64(t.lag(height)))
+
+		isActive := 0.0
+		if source == t.activeSource {
+			isActive = 1
+		}
+		domain.SQSIngestSourceActiveGauge.WithLabelValues(source).Set(isActive)
+	}
+}
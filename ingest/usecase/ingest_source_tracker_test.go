@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/ingest/usecase"
+)
+
+const (
+	sourceA = "node-a"
+	sourceB = "node-b"
+)
+
+var errProcessBlock = errors.New("process block error")
+
+// Tests that each height is processed successfully at most once across sources
+// and that the per-source status reflects the lag behind the latest accepted height.
+// The accepted blocks are completed right away with the given processing error.
+func (s *IngestUseCaseTestSuite) TestIngestSourceTracker_Accept() {
+	type pushedBlock struct {
+		source     string
+		height     uint64
+		processErr error
+
+		expectedAccepted bool
+	}
+
+	tests := []struct {
+		name string
+
+		blocks []pushedBlock
+
+		expectedStatus []domain.IngestSourceStatus
+	}{
+		{
+			name: "single source in order",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 10, expectedAccepted: true},
+				{source: sourceA, height: 11, expectedAccepted: true},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 11, Lag: 0, IsActive: true},
+			},
+		},
+		{
+			name: "duplicate heights from two sources are processed once",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 10, expectedAccepted: true},
+				{source: sourceB, height: 10, expectedAccepted: false},
+				{source: sourceB, height: 11, expectedAccepted: true},
+				{source: sourceA, height: 11, expectedAccepted: false},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 11, Lag: 0, IsActive: false},
+				{Source: sourceB, LatestHeight: 11, Lag: 0, IsActive: true},
+			},
+		},
+		{
+			name: "lagging source is skipped and reports lag",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 20, expectedAccepted: true},
+				{source: sourceB, height: 15, expectedAccepted: false},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 20, Lag: 0, IsActive: true},
+				{Source: sourceB, LatestHeight: 15, Lag: 5, IsActive: false},
+			},
+		},
+		{
+			name: "regression within a source is skipped",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 20, expectedAccepted: true},
+				{source: sourceA, height: 18, expectedAccepted: false},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 18, Lag: 2, IsActive: true},
+			},
+		},
+		{
+			name: "failed height is processed from the other source",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 10, expectedAccepted: true},
+				{source: sourceA, height: 11, processErr: errProcessBlock, expectedAccepted: true},
+				{source: sourceB, height: 11, expectedAccepted: true},
+				{source: sourceA, height: 12, expectedAccepted: true},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 12, Lag: 0, IsActive: true},
+				{Source: sourceB, LatestHeight: 11, Lag: 1, IsActive: false},
+			},
+		},
+		{
+			name: "failed height is not accepted",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 10, expectedAccepted: true},
+				{source: sourceA, height: 11, processErr: errProcessBlock, expectedAccepted: true},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 11, Lag: 0, IsActive: true},
+			},
+		},
+		{
+			name: "gap is accepted",
+
+			blocks: []pushedBlock{
+				{source: sourceA, height: 20, expectedAccepted: true},
+				{source: sourceB, height: 25, expectedAccepted: true},
+			},
+
+			expectedStatus: []domain.IngestSourceStatus{
+				{Source: sourceA, LatestHeight: 20, Lag: 5, IsActive: false},
+				{Source: sourceB, LatestHeight: 25, Lag: 0, IsActive: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tracker := usecase.NewIngestSourceTracker()
+
+			for _, block := range tt.blocks {
+				accepted := tracker.Accept(context.Background(), block.source, block.height)
+				s.Require().Equal(block.expectedAccepted, accepted, "source %s, height %d", block.source, block.height)
+
+				if accepted {
+					tracker.Complete(block.height, block.processErr)
+				}
+			}
+
+			s.Require().Equal(tt.expectedStatus, tracker.Status())
+		})
+	}
+}
+
+// Tests that a height pushed while the same height is being processed from another source
+// waits for the processing to complete and is only accepted if the processing failed.
+func (s *IngestUseCaseTestSuite) TestIngestSourceTracker_AcceptInFlight() {
+	tests := []struct {
+		name       string
+		processErr error
+
+		expectedAccepted bool
+	}{
+		{
+			name: "processing succeeds",
+
+			expectedAccepted: false,
+		},
+		{
+			name:       "processing fails",
+			processErr: errProcessBlock,
+
+			expectedAccepted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tracker := usecase.NewIngestSourceTracker()
+
+			s.Require().True(tracker.Accept(context.Background(), sourceA, 10))
+
+			acceptedCh := make(chan bool)
+			go func() {
+				acceptedCh <- tracker.Accept(context.Background(), sourceB, 10)
+			}()
+
+			select {
+			case <-acceptedCh:
+				s.FailNow("accepted while the same height is being processed")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			tracker.Complete(10, tt.processErr)
+
+			s.Require().Equal(tt.expectedAccepted, <-acceptedCh)
+		})
+	}
+}
+
+// Tests that waiting for the processing of the same height from another source stops once the context is done.
+func (s *IngestUseCaseTestSuite) TestIngestSourceTracker_AcceptInFlightContextDone() {
+	tracker := usecase.NewIngestSourceTracker()
+
+	s.Require().True(tracker.Accept(context.Background(), sourceA, 10))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Require().False(tracker.Accept(ctx, sourceB, 10))
+}
@@ -37,11 +37,29 @@ type ingestUseCase struct {
 	chainInfoUseCase     mvc.ChainInfoUsecase
 	orderBookUseCase     mvc.OrderBookUsecase
 
-	denomLiquidityMap domain.DenomPoolLiquidityMap
+	// blockStateMx serializes the updates of the pools, taker fees, sorted pools and candidate route
+	// search data by the blocks processed concurrently. See updateBlockState for details.
+	blockStateMx sync.Mutex
+
+	// denomLiquidityMapMx guards the denom liquidity map against
+	// the concurrent recomputation of the search data for all denoms.
+	denomLiquidityMapMx sync.Mutex
+	denomLiquidityMap   domain.DenomPoolLiquidityMap
+
+	// poolRoutingConfigMx guards the pool routing config that may be updated at runtime.
+	poolRoutingConfigMx sync.RWMutex
+	preferredPoolIDs    []uint64
+	deniedPoolIDs       []uint64
+
+	// The latest height for which the search data was computed.
+	latestSearchDataHeight atomic.Uint64
 
 	// Worker that computes prices for all tokens with the default quote.
 	defaultQuotePriceUpdateWorker domain.PricingWorker
 
+	// Workers that compute prices for all tokens with the other pre-computed quotes.
+	quotePriceUpdateWorkers []domain.PricingWorker
+
 	// Worker that computes candidate routes for all tokens.
 	candidateRouteSearchWorker domain.CandidateRouteSearchDataWorker
 
@@ -55,6 +73,10 @@ type ingestUseCase struct {
 	//
 	firstBlockWg sync.WaitGroup
 
+	// Tracks the heights pushed by the ingest sources
+	// to process each height only once.
+	sourceTracker *ingestSourceTracker
+
 	logger log.Logger
 }
 
@@ -83,11 +105,14 @@ var (
 )
 
 var (
-	_ mvc.IngestUsecase = &ingestUseCase{}
+	_ mvc.IngestUsecase                      = &ingestUseCase{}
+	_ domain.PoolRoutingConfigUpdateListener = &ingestUseCase{}
 )
 
 // NewIngestUsecase will create a new pools use case object
-func NewIngestUsecase(poolsUseCase mvc.PoolsUsecase, routerUseCase mvc.RouterUsecase, pricingRouterUsecase mvc.RouterUsecase, tokensUseCase mvc.TokensUsecase, chainInfoUseCase mvc.ChainInfoUsecase, codec codec.Codec, quotePriceUpdateWorker domain.PricingWorker, candidateRouteSearchWorker domain.CandidateRouteSearchDataWorker, orderBookUseCase mvc.OrderBookUsecase, logger log.Logger) (mvc.IngestUsecase, error) {
+func NewIngestUsecase(poolsUseCase mvc.PoolsUsecase, routerUseCase mvc.RouterUsecase, pricingRouterUsecase mvc.RouterUsecase, tokensUseCase mvc.TokensUsecase, chainInfoUseCase mvc.ChainInfoUsecase, codec codec.Codec, quotePriceUpdateWorker domain.PricingWorker, otherQuotePriceUpdateWorkers []domain.PricingWorker, candidateRouteSearchWorker domain.CandidateRouteSearchDataWorker, orderBookUseCase mvc.OrderBookUsecase, logger log.Logger) (mvc.IngestUsecase, error) {
+	routerConfig := routerUseCase.GetConfig()
+
 	return &ingestUseCase{
 		codec: codec,
 
@@ -99,15 +124,21 @@ func NewIngestUsecase(poolsUseCase mvc.PoolsUsecase, routerUseCase mvc.RouterUse
 
 		denomLiquidityMap: make(domain.DenomPoolLiquidityMap),
 
+		preferredPoolIDs: routerConfig.PreferredPoolIDs,
+		deniedPoolIDs:    routerConfig.DeniedPoolIDs,
+
 		logger: logger,
 
 		defaultQuotePriceUpdateWorker: quotePriceUpdateWorker,
+		quotePriceUpdateWorkers:       otherQuotePriceUpdateWorkers,
 
 		orderBookUseCase: orderBookUseCase,
 
 		candidateRouteSearchWorker: candidateRouteSearchWorker,
 
 		firstHeightAfterStartUp: atomic.Uint64{},
+
+		sourceTracker: newIngestSourceTracker(logger),
 	}, nil
 }
 
@@ -125,36 +156,13 @@ func (p *ingestUseCase) ProcessBlockData(ctx context.Context, height uint64, tak
 
 	startProcessingTime := time.Now()
 
-	p.routerUsecase.SetTakerFees(takerFeesMap)
-
 	// Parse the pools
-	pools, uniqueBlockPoolMetadata, err := p.parsePoolData(ctx, poolData)
+	pools, uniqueBlockPoolMetadata, err := p.parsePoolData(ctx, height, poolData)
 	if err != nil {
 		return err
 	}
 
-	// Store the pools
-	if err := p.poolsUseCase.StorePools(pools); err != nil {
-		return err
-	}
-
-	// Get all pools (already updated with the newly ingested pools)
-	allPools, err := p.poolsUseCase.GetAllPools()
-	if err != nil {
-		return err
-	}
-
-	// Sort and store pools.
-	p.logger.Info("sorting pools", zap.Uint64("height", height), zap.Duration("duration_since_start", time.Since(startProcessingTime)))
-
-	p.sortAndStorePools(allPools)
-
-	// If an error occurs, we should return it and not proceed with the next steps.
-	// The pricing relies on the search data. As a result, by returnining an error we trigger a fallback mechanism
-	// Note that compute search data is always synchronous because it is needed for all subsequent pre-computations within a block.
-	// Its latency is estimated to be negligile. As a result, it is not a concern.
-	if err := p.candidateRouteSearchWorker.ComputeSearchDataSync(ctx, height, uniqueBlockPoolMetadata); err != nil {
-		p.logger.Error("failed to compute search data", zap.Error(err))
+	if err := p.updateBlockState(ctx, height, takerFeesMap, pools, uniqueBlockPoolMetadata, startProcessingTime); err != nil {
 		return err
 	}
 
@@ -173,8 +181,11 @@ func (p *ingestUseCase) ProcessBlockData(ctx context.Context, height uint64, tak
 		// That results in a suboptimal price.
 		p.defaultQuotePriceUpdateWorker.UpdatePricesAsync(height, uniqueBlockPoolMetadata)
 
+		// The prices for the other quotes are not needed by the search data.
+		p.updateQuotePricesAsync(height, uniqueBlockPoolMetadata)
+
 		// Recompute search data given the availability of pool liquidity pricing.
-		if err := p.candidateRouteSearchWorker.ComputeSearchDataSync(ctx, height, uniqueBlockPoolMetadata); err != nil {
+		if err := p.computeSearchDataSync(ctx, height, uniqueBlockPoolMetadata); err != nil {
 			p.logger.Error("failed to compute search data", zap.Error(err))
 			return err
 		}
@@ -185,6 +196,7 @@ func (p *ingestUseCase) ProcessBlockData(ctx context.Context, height uint64, tak
 
 		// For any block after the first block, we can update the prices asynchronously.
 		p.defaultQuotePriceUpdateWorker.UpdatePricesAsync(height, uniqueBlockPoolMetadata)
+		p.updateQuotePricesAsync(height, uniqueBlockPoolMetadata)
 	}
 
 	// Store the latest ingested height.
@@ -205,11 +217,75 @@ func (p *ingestUseCase) ProcessBlockData(ctx context.Context, height uint64, tak
 	return nil
 }
 
+// updateBlockState stores the taker fees and the pools of the block, sorts all pools and computes the
+// candidate route search data, which publishes the state snapshot of the block.
+// The block state lock is held throughout so that the blocks processed concurrently do not interleave
+// their updates and the state snapshot of the block never reads the pools or taker fees of another block.
+func (p *ingestUseCase) updateBlockState(ctx context.Context, height uint64, takerFeesMap sqsdomain.TakerFeeMap, pools []sqsdomain.PoolI, uniqueBlockPoolMetadata domain.BlockPoolMetadata, startProcessingTime time.Time) error {
+	p.blockStateMx.Lock()
+	defer p.blockStateMx.Unlock()
+
+	p.routerUsecase.SetTakerFees(takerFeesMap)
+
+	// Store the pools
+	if err := p.poolsUseCase.StorePools(pools); err != nil {
+		return err
+	}
+
+	// Get all pools (already updated with the newly ingested pools)
+	allPools, err := p.poolsUseCase.GetAllPools()
+	if err != nil {
+		return err
+	}
+
+	// Sort and store pools.
+	p.logger.Info("sorting pools", zap.Uint64("height", height), zap.Duration("duration_since_start", time.Since(startProcessingTime)))
+
+	p.sortAndStorePools(allPools)
+
+	// If an error occurs, we should return it and not proceed with the next steps.
+	// The pricing relies on the search data. As a result, by returnining an error we trigger a fallback mechanism
+	// Note that compute search data is always synchronous because it is needed for all subsequent pre-computations within a block.
+	// Its latency is estimated to be negligile. As a result, it is not a concern.
+	if err := p.candidateRouteSearchWorker.ComputeSearchDataSync(ctx, height, uniqueBlockPoolMetadata); err != nil {
+		p.logger.Error("failed to compute search data", zap.Error(err))
+		return err
+	}
+
+	p.updateLatestSearchDataHeight(height)
+
+	return nil
+}
+
+// computeSearchDataSync recomputes the candidate route search data at the given height
+// while holding the block state lock. See updateBlockState for details.
+func (p *ingestUseCase) computeSearchDataSync(ctx context.Context, height uint64, blockPoolMetadata domain.BlockPoolMetadata) error {
+	p.blockStateMx.Lock()
+	defer p.blockStateMx.Unlock()
+
+	return p.candidateRouteSearchWorker.ComputeSearchDataSync(ctx, height, blockPoolMetadata)
+}
+
 // RegisterEndBlockProcessPlugin implements mvc.IngestUsecase.
 func (p *ingestUseCase) RegisterEndBlockProcessPlugin(plugin domain.EndBlockProcessPlugin) {
 	p.endBlockProcessPlugins = append(p.endBlockProcessPlugins, plugin)
 }
 
+// AcceptBlock implements mvc.IngestUsecase.
+func (p *ingestUseCase) AcceptBlock(ctx context.Context, source string, height uint64) bool {
+	return p.sourceTracker.accept(ctx, source, height)
+}
+
+// CompleteBlock implements mvc.IngestUsecase.
+func (p *ingestUseCase) CompleteBlock(height uint64, err error) {
+	p.sourceTracker.complete(height, err)
+}
+
+// GetSourcesStatus implements mvc.IngestUsecase.
+func (p *ingestUseCase) GetSourcesStatus() []domain.IngestSourceStatus {
+	return p.sourceTracker.status()
+}
+
 // updateAssetsAtHeightIntervalAsync updates the assets at the height interval asynchronously.
 // Any error that occurs during the update is recorded in the error counter.
 func (p *ingestUseCase) updateAssetsAtHeightIntervalAsync(height uint64) {
@@ -221,27 +297,116 @@ func (p *ingestUseCase) updateAssetsAtHeightIntervalAsync(height uint64) {
 	}()
 }
 
+// OnPoolRoutingConfigUpdate implements domain.PoolRoutingConfigUpdateListener.
+// It re-sorts all pools and recomputes the search data for all denoms at the latest processed height
+// so that the updated config takes effect without waiting for the pools to be updated on chain.
+// CONTRACT: the pools usecase and the candidate route search data worker are notified of the update first.
+func (p *ingestUseCase) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	p.poolRoutingConfigMx.Lock()
+	p.preferredPoolIDs = config.PreferredPoolIDs
+	p.deniedPoolIDs = config.DeniedPoolIDs
+	p.poolRoutingConfigMx.Unlock()
+
+	// Hold the block state lock so that the pools are re-sorted and the search data recomputed
+	// from the state of the latest processed height only. See updateBlockState for details.
+	p.blockStateMx.Lock()
+	defer p.blockStateMx.Unlock()
+
+	height := p.latestSearchDataHeight.Load()
+	if height == 0 {
+		// No block has been processed yet. The config applies from the first block.
+		return nil
+	}
+
+	allPools, err := p.poolsUseCase.GetAllPools()
+	if err != nil {
+		return err
+	}
+
+	p.sortAndStorePools(allPools)
+
+	return p.candidateRouteSearchWorker.ComputeSearchDataSync(ctx, height, p.getAllDenomsBlockPoolMetadata())
+}
+
+// getAllDenomsBlockPoolMetadata returns the block pool metadata with all denoms marked as updated.
+// The denom liquidity map is copied so that it is not mutated by the blocks processed concurrently.
+func (p *ingestUseCase) getAllDenomsBlockPoolMetadata() domain.BlockPoolMetadata {
+	p.denomLiquidityMapMx.Lock()
+	defer p.denomLiquidityMapMx.Unlock()
+
+	blockPoolMetadata := domain.BlockPoolMetadata{
+		UpdatedDenoms:         make(map[string]struct{}, len(p.denomLiquidityMap)),
+		DenomPoolLiquidityMap: p.copyDenomLiquidityMap(),
+		PoolIDs:               make(map[uint64]struct{}),
+	}
+
+	for denom := range p.denomLiquidityMap {
+		blockPoolMetadata.UpdatedDenoms[denom] = struct{}{}
+	}
+
+	return blockPoolMetadata
+}
+
+// copyDenomLiquidityMap returns a deep copy of the denom liquidity map.
+// CONTRACT: the caller holds the denom liquidity map lock.
+func (p *ingestUseCase) copyDenomLiquidityMap() domain.DenomPoolLiquidityMap {
+	denomLiquidityMap := make(domain.DenomPoolLiquidityMap, len(p.denomLiquidityMap))
+	for denom, denomLiquidityData := range p.denomLiquidityMap {
+		pools := make(map[uint64]osmomath.Int, len(denomLiquidityData.Pools))
+		for poolID, liquidity := range denomLiquidityData.Pools {
+			pools[poolID] = liquidity
+		}
+
+		denomLiquidityMap[denom] = domain.DenomPoolLiquidityData{
+			TotalLiquidity: denomLiquidityData.TotalLiquidity,
+			Pools:          pools,
+		}
+	}
+	return denomLiquidityMap
+}
+
+// updateQuotePricesAsync updates the prices for the pre-computed quotes other than the default one asynchronously.
+func (p *ingestUseCase) updateQuotePricesAsync(height uint64, uniqueBlockPoolMetadata domain.BlockPoolMetadata) {
+	for _, quotePriceUpdateWorker := range p.quotePriceUpdateWorkers {
+		quotePriceUpdateWorker.UpdatePricesAsync(height, uniqueBlockPoolMetadata)
+	}
+}
+
+// updateLatestSearchDataHeight stores the given height as the latest one
+// for which the search data was computed unless a greater height is already stored.
+func (p *ingestUseCase) updateLatestSearchDataHeight(height uint64) {
+	for {
+		latestHeight := p.latestSearchDataHeight.Load()
+		if height <= latestHeight || p.latestSearchDataHeight.CompareAndSwap(latestHeight, height) {
+			return
+		}
+	}
+}
+
 // sortAndStorePools sorts the pools and stores them in the router.
 // TODO: instead of resorting all pools every block, we should put the updated pools in the correct position
 func (p *ingestUseCase) sortAndStorePools(pools []sqsdomain.PoolI) {
 	cosmWasmPoolConfig := p.poolsUseCase.GetCosmWasmPoolConfig()
-	routerConfig := p.routerUsecase.GetConfig()
 
-	sortedPools, _ := routerusecase.ValidateAndSortPools(pools, cosmWasmPoolConfig, routerConfig.PreferredPoolIDs, p.logger)
+	p.poolRoutingConfigMx.RLock()
+	preferredPoolIDs, deniedPoolIDs := p.preferredPoolIDs, p.deniedPoolIDs
+	p.poolRoutingConfigMx.RUnlock()
+
+	sortedPools, _ := routerusecase.ValidateAndSortPools(pools, cosmWasmPoolConfig, preferredPoolIDs, deniedPoolIDs, p.logger)
 
 	// Sort the pools and store them in the router.
 	p.routerUsecase.SetSortedPools(sortedPools)
 	p.pricingRouterUsecase.SetSortedPools(sortedPools)
 }
 
-// parsePoolData parses the pool data and returns the pool objects.
-func (p *ingestUseCase) parsePoolData(ctx context.Context, poolData []*types.PoolData) ([]sqsdomain.PoolI, domain.BlockPoolMetadata, error) {
+// parsePoolData parses the pool data of the block at the given height and returns the pool objects.
+func (p *ingestUseCase) parsePoolData(ctx context.Context, height uint64, poolData []*types.PoolData) ([]sqsdomain.PoolI, domain.BlockPoolMetadata, error) {
 	poolResultChan := make(chan poolResult, len(poolData))
 
 	// Parse the pools concurrently
 	for _, pool := range poolData {
 		go func(pool *types.PoolData) {
-			poolResultData, err := p.parsePool(pool)
+			poolResultData, err := p.parsePool(pool, height)
 
 			poolResultChan <- poolResult{
 				pool: poolResultData,
@@ -322,10 +487,12 @@ func (p *ingestUseCase) parsePoolData(ctx context.Context, poolData []*types.Poo
 	// Transfer the updated block denom liquidity data to the global map.
 	// Note, the updated liquidity data contains updates only for the pools updated
 	// in the current block. We need to merge this data with the holistic existing data.
+	// The block metadata gets a copy so that it is not mutated by the blocks processed concurrently
+	// while it is read by the pricing workers and the end block plugins.
+	p.denomLiquidityMapMx.Lock()
 	p.denomLiquidityMap = transferDenomLiquidityMap(p.denomLiquidityMap, currentBlockLiquidityMap)
-
-	// Update unique denoms.
-	uniqueData.DenomPoolLiquidityMap = p.denomLiquidityMap
+	uniqueData.DenomPoolLiquidityMap = p.copyDenomLiquidityMap()
+	p.denomLiquidityMapMx.Unlock()
 
 	return parsedPools, uniqueData, nil
 }
@@ -448,9 +615,9 @@ func transferDenomLiquidityMap(transferTo, transferFrom domain.DenomPoolLiquidit
 	return transferTo
 }
 
-// parsePool parses the pool data and returns the pool object
+// parsePool parses the pool data of the block at the given height and returns the pool object
 // For concentrated pools, it also processes the tick model
-func (p *ingestUseCase) parsePool(pool *types.PoolData) (sqsdomain.PoolI, error) {
+func (p *ingestUseCase) parsePool(pool *types.PoolData, height uint64) (sqsdomain.PoolI, error) {
 	poolWrapper := sqsdomain.PoolWrapper{}
 
 	if err := p.codec.UnmarshalInterfaceJSON(pool.ChainModel, &poolWrapper.ChainModel); err != nil {
@@ -473,9 +640,30 @@ func (p *ingestUseCase) parsePool(pool *types.PoolData) (sqsdomain.PoolI, error)
 		p.logger.Error("error processing SQS model", zap.Error(err))
 	}
 
+	p.setCreationHeightMut(&poolWrapper.SQSModel, poolWrapper.GetId(), height)
+
 	return &poolWrapper, nil
 }
 
+// setCreationHeightMut sets the creation height of the pool with the given ID ingested at the given height.
+// Since the ingested pool model does not carry it, the creation height of the already stored pool is kept.
+// A pool that is not stored yet is created at the given height unless no block has been processed yet,
+// in which case the first block contains all pools and their creation height is unknown.
+func (p *ingestUseCase) setCreationHeightMut(sqsModel *sqsdomain.SQSPool, poolID uint64, height uint64) {
+	if sqsModel.CreationHeight != 0 {
+		return
+	}
+
+	if storedPool, err := p.poolsUseCase.GetPool(poolID); err == nil {
+		sqsModel.CreationHeight = storedPool.GetSQSPoolModel().CreationHeight
+		return
+	}
+
+	if p.latestSearchDataHeight.Load() > 0 {
+		sqsModel.CreationHeight = height
+	}
+}
+
 // executeEndBlockProcessPlugins executes the end block process plugins.
 func (p *ingestUseCase) executeEndBlockProcessPlugins(ctx context.Context, blockHeight uint64, metadata domain.BlockPoolMetadata) {
 	for _, plugin := range p.endBlockProcessPlugins {
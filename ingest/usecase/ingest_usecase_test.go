@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -438,6 +439,7 @@ func (s *IngestUseCaseTestSuite) TestCallUpdateAssetsAtHeightIntervalSync() {
 						// do nothing
 					},
 				},
+				nil,
 				&mocks.CandidateRouteSearchDataWorkerMock{},
 				nil,
 				noOpLogger,
@@ -456,6 +458,127 @@ func (s *IngestUseCaseTestSuite) TestCallUpdateAssetsAtHeightIntervalSync() {
 	}
 }
 
+// Validates that the blocks processed concurrently do not interleave their state updates,
+// so that the search data of a block, upon which the state snapshot is built, is computed
+// with the taker fees of the same block.
+func (s *IngestUseCaseTestSuite) TestProcessBlockData_ConcurrentBlocks() {
+	const numBlocks = 20
+
+	var (
+		mu sync.Mutex
+		// takerFees are the taker fees set by the latest block.
+		takerFees sqsdomain.TakerFeeMap
+		// mismatchedHeights are the heights whose search data is computed with the taker fees of another block.
+		mismatchedHeights []uint64
+	)
+
+	denomPair := sqsdomain.DenomPair{Denom0: UOSMO, Denom1: USDC}
+
+	ingester, err := usecase.NewIngestUsecase(
+		&mocks.PoolsUsecaseMock{
+			StorePoolsFunc: func(pools []sqsdomain.PoolI) error {
+				return nil
+			},
+		},
+		&mocks.RouterUsecaseMock{
+			SetTakerFeesFunc: func(blockTakerFees sqsdomain.TakerFeeMap) {
+				mu.Lock()
+				defer mu.Unlock()
+				takerFees = blockTakerFees
+			},
+		},
+		&mocks.RouterUsecaseMock{},
+		&mocks.TokensUsecaseMock{},
+		&mocks.ChainInfoUsecaseMock{},
+		nil,
+		&mocks.PricingWorkerMock{},
+		nil,
+		&mocks.CandidateRouteSearchDataWorkerMock{
+			ComputeSearchDataSyncFunc: func(ctx context.Context, height uint64, uniqueBlockPoolMetaData domain.BlockPoolMetadata) error {
+				// Let the other blocks progress if they are not serialized.
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if !takerFees[denomPair].Equal(osmomath.NewDec(int64(height))) {
+					mismatchedHeights = append(mismatchedHeights, height)
+				}
+				return nil
+			},
+		},
+		nil,
+		noOpLogger,
+	)
+	s.Require().NoError(err)
+
+	var wg sync.WaitGroup
+	for height := uint64(1); height <= numBlocks; height++ {
+		wg.Add(1)
+		go func(height uint64) {
+			defer wg.Done()
+			blockTakerFees := sqsdomain.TakerFeeMap{denomPair: osmomath.NewDec(int64(height))}
+			s.Require().NoError(ingester.ProcessBlockData(context.TODO(), height, blockTakerFees, nil))
+		}(height)
+	}
+	wg.Wait()
+
+	s.Require().Empty(mismatchedHeights)
+}
+
+// Validates that the pools not stored yet are created at the ingested height unless no block has been processed yet
+// and that the creation height of the stored pools is carried over.
+func (s *IngestUseCaseTestSuite) TestSetCreationHeightMut() {
+	const (
+		storedPoolID         = uint64(1)
+		storedCreationHeight = uint64(5)
+		newPoolID            = uint64(2)
+		height               = uint64(10)
+	)
+
+	ingesterI, err := usecase.NewIngestUsecase(
+		&mocks.PoolsUsecaseMock{
+			StorePoolsFunc: func(pools []sqsdomain.PoolI) error {
+				return nil
+			},
+			GetPoolFunc: func(poolID uint64) (sqsdomain.PoolI, error) {
+				if poolID == storedPoolID {
+					return &mocks.MockRoutablePool{ID: storedPoolID, CreationHeight: storedCreationHeight}, nil
+				}
+				return nil, domain.PoolNotFoundError{PoolID: poolID}
+			},
+		},
+		&mocks.RouterUsecaseMock{},
+		&mocks.RouterUsecaseMock{},
+		&mocks.TokensUsecaseMock{},
+		&mocks.ChainInfoUsecaseMock{},
+		nil,
+		&mocks.PricingWorkerMock{},
+		nil,
+		&mocks.CandidateRouteSearchDataWorkerMock{},
+		nil,
+		noOpLogger,
+	)
+	s.Require().NoError(err)
+	ingester := ingesterI.(*usecase.IngestUseCaseImpl)
+
+	// No block processed yet.
+	sqsModel := sqsdomain.SQSPool{}
+	ingester.SetCreationHeightMut(&sqsModel, newPoolID, height)
+	s.Require().Zero(sqsModel.CreationHeight)
+
+	s.Require().NoError(ingester.ProcessBlockData(context.TODO(), height-1, nil, nil))
+
+	// New pool.
+	sqsModel = sqsdomain.SQSPool{}
+	ingester.SetCreationHeightMut(&sqsModel, newPoolID, height)
+	s.Require().Equal(height, sqsModel.CreationHeight)
+
+	// Stored pool.
+	sqsModel = sqsdomain.SQSPool{}
+	ingester.SetCreationHeightMut(&sqsModel, storedPoolID, height)
+	s.Require().Equal(storedCreationHeight, sqsModel.CreationHeight)
+}
+
 func (s *IngestUseCaseTestSuite) TestProcessSQSModelMut() {
 
 	var (
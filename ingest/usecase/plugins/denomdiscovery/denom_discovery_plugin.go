@@ -0,0 +1,263 @@
+package denomdiscovery
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+const (
+	ibcDenomPrefix          = "ibc/"
+	tokenFactoryDenomPrefix = "factory/"
+)
+
+// siPrefixExponents are the exponents implied by the SI prefixes of the base denoms by convention.
+// For example, uatom has 6 decimals and aevmos has 18 decimals.
+var siPrefixExponents = map[byte]int{
+	'u': 6,
+	'n': 9,
+	'a': 18,
+}
+
+// denomDiscoveryPlugin resolves the metadata of the pool denoms that are not in the token registry from the chain
+// at the end of the block in which they are first seen.
+// The IBC denoms are resolved from their ibc-transfer denom trace and the tokenfactory denoms from their bank denom metadata.
+// The resolved tokens are loaded into the tokens use case as unverified.
+type denomDiscoveryPlugin struct {
+	tokensUsecase mvc.TokensUsecase
+
+	transferClient transfertypes.QueryClient
+	bankClient     banktypes.QueryClient
+
+	config domain.DenomDiscoveryConfig
+
+	// mx guards inFlight and failedAttempts.
+	mx sync.Mutex
+	// inFlight are the denoms being resolved so that the
+	// concurrent end block runs do not resolve them twice.
+	inFlight map[string]struct{}
+	// failedAttempts are the number of failed resolution attempts by denom.
+	failedAttempts map[string]int
+
+	logger log.Logger
+}
+
+var _ domain.EndBlockProcessPlugin = &denomDiscoveryPlugin{}
+
+// NewEndBlockDenomDiscoveryPlugin creates a new denom discovery plugin.
+func NewEndBlockDenomDiscoveryPlugin(tokensUsecase mvc.TokensUsecase, transferClient transfertypes.QueryClient, bankClient banktypes.QueryClient, config domain.DenomDiscoveryConfig, logger log.Logger) *denomDiscoveryPlugin {
+	return &denomDiscoveryPlugin{
+		tokensUsecase: tokensUsecase,
+
+		transferClient: transferClient,
+		bankClient:     bankClient,
+
+		config: config,
+
+		inFlight:       make(map[string]struct{}),
+		failedAttempts: make(map[string]int),
+
+		logger: logger,
+	}
+}
+
+// ProcessEndBlock resolves the unknown denoms updated in the block, up to the configured max per block.
+// The denoms that fail to resolve are retried in the subsequent blocks in which they are updated
+// until the max attempts are reached.
+func (p *denomDiscoveryPlugin) ProcessEndBlock(ctx context.Context, blockHeight uint64, metadata domain.BlockPoolMetadata) error {
+	denoms := p.acquireUnknownDenoms(metadata.UpdatedDenoms)
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	resolvedTokens := make(map[string]domain.Token, len(denoms))
+	for _, denom := range denoms {
+		token, err := p.resolveDenom(ctx, denom)
+
+		p.mx.Lock()
+		delete(p.inFlight, denom)
+		if err != nil {
+			p.failedAttempts[denom]++
+		} else {
+			delete(p.failedAttempts, denom)
+		}
+		p.mx.Unlock()
+
+		if err != nil {
+			p.logger.Debug("failed to resolve denom", zap.String("denom", denom), zap.Uint64("block_height", blockHeight), zap.Error(err))
+			continue
+		}
+
+		resolvedTokens[denom] = token
+	}
+
+	if len(resolvedTokens) > 0 {
+		p.tokensUsecase.LoadUnverifiedTokens(resolvedTokens)
+
+		p.logger.Info("resolved unknown denoms", zap.Int("count", len(resolvedTokens)), zap.Uint64("block_height", blockHeight))
+	}
+
+	return nil
+}
+
+// acquireUnknownDenoms returns the sorted denoms out of the given ones that are not known to the tokens use case,
+// are resolvable, are not being resolved and have not exhausted their attempts.
+// The returned denoms are marked as in flight and are capped at the configured max per block.
+func (p *denomDiscoveryPlugin) acquireUnknownDenoms(updatedDenoms map[string]struct{}) []string {
+	denoms := make([]string, 0)
+	for denom := range updatedDenoms {
+		if !strings.HasPrefix(denom, ibcDenomPrefix) && !strings.HasPrefix(denom, tokenFactoryDenomPrefix) {
+			continue
+		}
+
+		if _, err := p.tokensUsecase.GetMetadataByChainDenom(denom); err == nil {
+			continue
+		}
+
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	acquiredDenoms := make([]string, 0, len(denoms))
+	for _, denom := range denoms {
+		if len(acquiredDenoms) >= p.config.MaxDenomsPerBlock {
+			break
+		}
+
+		if _, ok := p.inFlight[denom]; ok {
+			continue
+		}
+
+		if p.failedAttempts[denom] >= p.config.MaxAttempts {
+			continue
+		}
+
+		p.inFlight[denom] = struct{}{}
+		acquiredDenoms = append(acquiredDenoms, denom)
+	}
+
+	return acquiredDenoms
+}
+
+// resolveDenom resolves the token metadata of the given IBC or tokenfactory denom from the chain.
+func (p *denomDiscoveryPlugin) resolveDenom(ctx context.Context, denom string) (domain.Token, error) {
+	if strings.HasPrefix(denom, ibcDenomPrefix) {
+		return p.resolveIBCDenom(ctx, denom)
+	}
+
+	return p.resolveTokenFactoryDenom(ctx, denom)
+}
+
+// resolveIBCDenom resolves the token metadata of the given IBC denom from its denom trace.
+// The precision is inferred from the SI prefix of the base denom.
+// Returns error if the precision cannot be inferred.
+func (p *denomDiscoveryPlugin) resolveIBCDenom(ctx context.Context, denom string) (domain.Token, error) {
+	response, err := p.transferClient.DenomTrace(ctx, &transfertypes.QueryDenomTraceRequest{
+		Hash: strings.TrimPrefix(denom, ibcDenomPrefix),
+	})
+	if err != nil {
+		return domain.Token{}, err
+	}
+
+	denomTrace := response.DenomTrace
+	if denomTrace == nil || denomTrace.BaseDenom == "" {
+		return domain.Token{}, fmt.Errorf("no denom trace for (%s)", denom)
+	}
+
+	symbol, precision, err := inferPrecision(denomTrace.BaseDenom)
+	if err != nil {
+		return domain.Token{}, err
+	}
+
+	return domain.Token{
+		Name:             denomTrace.GetFullDenomPath(),
+		HumanDenom:       symbol,
+		CoinMinimalDenom: denom,
+		Precision:        precision,
+	}, nil
+}
+
+// resolveTokenFactoryDenom resolves the token metadata of the given tokenfactory denom from its bank denom metadata.
+// The precision is the exponent of the display denom unit.
+// Returns error if there is no display denom unit with a positive exponent. In particular, the tokenfactory module
+// defaults the metadata to the base unit alone, which does not tell the precision. Loading such tokens with a zero
+// precision would misscale their human prices and amounts, so they are kept unresolved until the creator sets the metadata.
+func (p *denomDiscoveryPlugin) resolveTokenFactoryDenom(ctx context.Context, denom string) (domain.Token, error) {
+	response, err := p.bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return domain.Token{}, err
+	}
+
+	metadata := response.Metadata
+
+	precision := 0
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit != nil && denomUnit.Denom == metadata.Display {
+			precision = int(denomUnit.Exponent)
+			break
+		}
+	}
+
+	if precision <= 0 {
+		return domain.Token{}, fmt.Errorf("no display denom unit with a positive exponent for (%s)", denom)
+	}
+
+	// The tokenfactory module defaults the symbol and name to the denom itself.
+	symbol := metadata.Symbol
+	if symbol == "" || symbol == denom {
+		symbol = denom[strings.LastIndex(denom, "/")+1:]
+	}
+
+	name := metadata.Name
+	if name == "" || name == denom {
+		name = symbol
+	}
+
+	return domain.Token{
+		Name:             name,
+		HumanDenom:       symbol,
+		CoinMinimalDenom: denom,
+		Precision:        precision,
+	}, nil
+}
+
+// inferPrecision returns the symbol and the precision implied by the SI prefix of the given base denom.
+// For example, uatom returns ATOM and 6.
+// Returns error if the base denom has no SI prefix.
+func inferPrecision(baseDenom string) (string, int, error) {
+	// The base denom may itself be a path such as a tokenfactory denom.
+	baseDenom = baseDenom[strings.LastIndex(baseDenom, "/")+1:]
+
+	if len(baseDenom) < 3 {
+		return "", 0, fmt.Errorf("cannot infer precision of base denom (%s)", baseDenom)
+	}
+
+	exponent, ok := siPrefixExponents[baseDenom[0]]
+	if !ok {
+		return "", 0, fmt.Errorf("cannot infer precision of base denom (%s)", baseDenom)
+	}
+
+	for _, r := range baseDenom[1:] {
+		if r < 'a' || r > 'z' {
+			return "", 0, fmt.Errorf("cannot infer precision of base denom (%s)", baseDenom)
+		}
+	}
+
+	return strings.ToUpper(baseDenom[1:]), exponent, nil
+}
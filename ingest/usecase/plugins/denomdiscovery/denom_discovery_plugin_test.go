@@ -0,0 +1,202 @@
+package denomdiscovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/ingest/usecase/plugins/denomdiscovery"
+	"github.com/osmosis-labs/sqs/log"
+	tokensusecase "github.com/osmosis-labs/sqs/tokens/usecase"
+)
+
+const (
+	ibcATOM      = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	ibcUnknown   = "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	ibcNoPrefix  = "ibc/1111111111111111111111111111111111111111111111111111111111111111"
+	factoryPEPE  = "factory/osmo1creator/pepe"
+	factoryNoMD  = "factory/osmo1creator/nometadata"
+	registryOSMO = "uosmo"
+)
+
+// mockTransferQueryClient is a transfer query client returning the denom traces by hash.
+type mockTransferQueryClient struct {
+	transfertypes.QueryClient
+
+	denomTraces map[string]*transfertypes.DenomTrace
+	calls       int
+}
+
+// DenomTrace implements transfertypes.QueryClient.
+func (m *mockTransferQueryClient) DenomTrace(ctx context.Context, in *transfertypes.QueryDenomTraceRequest, opts ...grpc.CallOption) (*transfertypes.QueryDenomTraceResponse, error) {
+	m.calls++
+
+	denomTrace, ok := m.denomTraces[in.Hash]
+	if !ok {
+		return nil, errors.New("denom trace not found")
+	}
+	return &transfertypes.QueryDenomTraceResponse{DenomTrace: denomTrace}, nil
+}
+
+// mockBankQueryClient is a bank query client returning the denom metadata by denom.
+type mockBankQueryClient struct {
+	banktypes.QueryClient
+
+	metadata map[string]banktypes.Metadata
+}
+
+// DenomMetadata implements banktypes.QueryClient.
+func (m *mockBankQueryClient) DenomMetadata(ctx context.Context, in *banktypes.QueryDenomMetadataRequest, opts ...grpc.CallOption) (*banktypes.QueryDenomMetadataResponse, error) {
+	metadata, ok := m.metadata[in.Denom]
+	if !ok {
+		return nil, errors.New("denom metadata not found")
+	}
+	return &banktypes.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// TestProcessEndBlock validates that the unknown IBC and tokenfactory denoms are resolved from the chain
+// and loaded as unverified tokens, that the known denoms and the denoms of unknown precision are not resolved
+// and that the failing denoms are no longer resolved after the max attempts.
+func TestProcessEndBlock(t *testing.T) {
+	transferClient := &mockTransferQueryClient{
+		denomTraces: map[string]*transfertypes.DenomTrace{
+			ibcATOM[len("ibc/"):]:     {Path: "transfer/channel-0", BaseDenom: "uatom"},
+			ibcNoPrefix[len("ibc/"):]: {Path: "transfer/channel-1", BaseDenom: "inj"},
+		},
+	}
+
+	bankClient := &mockBankQueryClient{
+		metadata: map[string]banktypes.Metadata{
+			factoryPEPE: {
+				DenomUnits: []*banktypes.DenomUnit{{Denom: factoryPEPE, Exponent: 0}, {Denom: "pepe", Exponent: 6}},
+				Base:       factoryPEPE,
+				Display:    "pepe",
+				Name:       "Pepe",
+				Symbol:     "PEPE",
+			},
+			// The tokenfactory module defaults.
+			factoryNoMD: {
+				DenomUnits: []*banktypes.DenomUnit{{Denom: factoryNoMD, Exponent: 0}},
+				Base:       factoryNoMD,
+				Display:    factoryNoMD,
+				Name:       factoryNoMD,
+				Symbol:     factoryNoMD,
+			},
+		},
+	}
+
+	tokensUsecase := tokensusecase.NewTokensUsecase(map[string]domain.Token{
+		registryOSMO: {HumanDenom: "osmo", CoinMinimalDenom: registryOSMO, Precision: 6},
+	}, 0, nil)
+
+	config := domain.DenomDiscoveryConfig{
+		Enabled:           true,
+		MaxDenomsPerBlock: 10,
+		MaxAttempts:       2,
+	}
+
+	plugin := denomdiscovery.NewEndBlockDenomDiscoveryPlugin(tokensUsecase, transferClient, bankClient, config, &log.NoOpLogger{})
+
+	metadata := domain.BlockPoolMetadata{
+		UpdatedDenoms: map[string]struct{}{
+			registryOSMO: {},
+			ibcATOM:      {},
+			ibcUnknown:   {},
+			ibcNoPrefix:  {},
+			factoryPEPE:  {},
+			factoryNoMD:  {},
+		},
+	}
+
+	err := plugin.ProcessEndBlock(context.Background(), 1, metadata)
+	require.NoError(t, err)
+
+	expectedTokens := map[string]domain.Token{
+		ibcATOM: {
+			Name:             "transfer/channel-0/uatom",
+			HumanDenom:       "ATOM",
+			CoinMinimalDenom: ibcATOM,
+			Precision:        6,
+			IsUnverified:     true,
+		},
+		factoryPEPE: {
+			Name:             "Pepe",
+			HumanDenom:       "PEPE",
+			CoinMinimalDenom: factoryPEPE,
+			Precision:        6,
+			IsUnverified:     true,
+		},
+	}
+
+	for denom, expectedToken := range expectedTokens {
+		token, err := tokensUsecase.GetMetadataByChainDenom(denom)
+		require.NoError(t, err)
+		require.Equal(t, expectedToken, token)
+	}
+
+	// The tokenfactory default metadata does not tell the precision.
+	for _, denom := range []string{ibcUnknown, ibcNoPrefix, factoryNoMD} {
+		_, err := tokensUsecase.GetMetadataByChainDenom(denom)
+		require.Error(t, err)
+	}
+
+	// Only the failing IBC denoms are retried.
+	require.Equal(t, 3, transferClient.calls)
+	err = plugin.ProcessEndBlock(context.Background(), 2, metadata)
+	require.NoError(t, err)
+	require.Equal(t, 5, transferClient.calls)
+
+	// The failing IBC denoms are no longer resolved after the max attempts.
+	err = plugin.ProcessEndBlock(context.Background(), 3, metadata)
+	require.NoError(t, err)
+	require.Equal(t, 5, transferClient.calls)
+}
+
+// TestProcessEndBlock_MaxDenomsPerBlock validates that the denoms beyond the max per block
+// are resolved at the end of the subsequent blocks.
+func TestProcessEndBlock_MaxDenomsPerBlock(t *testing.T) {
+	bankClient := &mockBankQueryClient{
+		metadata: map[string]banktypes.Metadata{
+			factoryPEPE: {DenomUnits: []*banktypes.DenomUnit{{Denom: "pepe", Exponent: 6}}, Display: "pepe"},
+			factoryNoMD: {DenomUnits: []*banktypes.DenomUnit{{Denom: "nometadata", Exponent: 6}}, Display: "nometadata"},
+		},
+	}
+
+	tokensUsecase := tokensusecase.NewTokensUsecase(nil, 0, nil)
+
+	config := domain.DenomDiscoveryConfig{
+		Enabled:           true,
+		MaxDenomsPerBlock: 1,
+		MaxAttempts:       1,
+	}
+
+	plugin := denomdiscovery.NewEndBlockDenomDiscoveryPlugin(tokensUsecase, &mockTransferQueryClient{}, bankClient, config, &log.NoOpLogger{})
+
+	metadata := domain.BlockPoolMetadata{
+		UpdatedDenoms: map[string]struct{}{
+			factoryPEPE: {},
+			factoryNoMD: {},
+		},
+	}
+
+	err := plugin.ProcessEndBlock(context.Background(), 1, metadata)
+	require.NoError(t, err)
+
+	// The denoms are resolved in sorted order.
+	_, err = tokensUsecase.GetMetadataByChainDenom(factoryNoMD)
+	require.NoError(t, err)
+	_, err = tokensUsecase.GetMetadataByChainDenom(factoryPEPE)
+	require.Error(t, err)
+
+	err = plugin.ProcessEndBlock(context.Background(), 2, metadata)
+	require.NoError(t, err)
+
+	_, err = tokensUsecase.GetMetadataByChainDenom(factoryPEPE)
+	require.NoError(t, err)
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+// retainedStateSnapshots is the number of the most recent heights whose snapshots are retained
+// so that the end block process plugins, running asynchronously, read the state at their block height.
+const retainedStateSnapshots = 16
+
+// stateSnapshotUseCase builds a state snapshot at the end of each block
+// and publishes it with an atomic pointer swap.
+type stateSnapshotUseCase struct {
+	poolsUseCase     mvc.PoolsUsecase
+	routerUsecase    mvc.RouterUsecase
+	routerRepository mvc.RouterRepository
+	tokensUseCase    mvc.TokensUsecase
+
+	latest atomic.Pointer[domain.StateSnapshot]
+
+	// recentMx guards recent.
+	recentMx sync.RWMutex
+	// recent are the snapshots of the most recent heights by height.
+	recent map[uint64]*domain.StateSnapshot
+
+	logger log.Logger
+}
+
+var (
+	_ mvc.StateSnapshotUsecase = &stateSnapshotUseCase{}
+)
+
+// NewStateSnapshotUsecase returns a new state snapshot use case.
+func NewStateSnapshotUsecase(poolsUseCase mvc.PoolsUsecase, routerUsecase mvc.RouterUsecase, routerRepository mvc.RouterRepository, tokensUseCase mvc.TokensUsecase, logger log.Logger) mvc.StateSnapshotUsecase {
+	return &stateSnapshotUseCase{
+		poolsUseCase:     poolsUseCase,
+		routerUsecase:    routerUsecase,
+		routerRepository: routerRepository,
+		tokensUseCase:    tokensUseCase,
+
+		recent: make(map[uint64]*domain.StateSnapshot, retainedStateSnapshots),
+
+		logger: logger,
+	}
+}
+
+// OnSearchDataUpdate implements domain.CandidateRouteSearchDataUpdateListener.
+// The candidate route search data is the last piece of state updated within a block.
+// As a result, the pools, taker fees and sorted pools are already at the given height.
+// CONTRACT: the ingester holds its block state lock while computing the search data
+// so that no other block updates the pools, taker fees or sorted pools until the snapshot is built.
+// The pool denom metadata is repriced asynchronously after the search data is computed.
+// As a result, the snapshot captures the latest pool denom metadata, which may lag behind the given height.
+// The snapshot is only published if its height is not below the height of the latest published snapshot
+// so that the published height never goes backwards, e.g. when the search data of an older block
+// or the search data recomputed on a pool routing config update completes after a newer block.
+func (s *stateSnapshotUseCase) OnSearchDataUpdate(ctx context.Context, height uint64) error {
+	allPools, err := s.poolsUseCase.GetAllPools()
+	if err != nil {
+		return err
+	}
+
+	snapshot := domain.NewStateSnapshot(
+		height,
+		allPools,
+		s.routerUsecase.GetSortedPools(),
+		s.routerRepository.GetAllTakerFees(),
+		s.routerRepository.GetCandidateRouteSearchData(),
+	).WithPoolDenomMetadata(s.tokensUseCase.GetPoolDenomMetadataSnapshot())
+
+	if !s.publish(snapshot) {
+		// The snapshot is still retained for the readers of its height unless one is already retained.
+		s.retain(snapshot, false)
+
+		s.logger.Debug("skipped publishing state snapshot below the latest height", zap.Uint64("height", height))
+		return nil
+	}
+
+	s.retain(snapshot, true)
+
+	s.logger.Debug("published state snapshot", zap.Uint64("height", height), zap.Int("num_pools", len(allPools)))
+	domain.SQSStateSnapshotHeightGauge.Set(float64(height))
+
+	return nil
+}
+
+// publish stores the snapshot as the latest one unless the latest one has a greater height.
+// Returns true if the snapshot is stored.
+func (s *stateSnapshotUseCase) publish(snapshot *domain.StateSnapshot) bool {
+	for {
+		latest := s.latest.Load()
+		if latest != nil && snapshot.Height() < latest.Height() {
+			return false
+		}
+
+		if s.latest.CompareAndSwap(latest, snapshot) {
+			return true
+		}
+	}
+}
+
+// retain retains the snapshot by its height and drops the snapshots
+// that are no longer among the most recent heights.
+// If replace is false, the snapshot already retained for the height, if any, is kept.
+func (s *stateSnapshotUseCase) retain(snapshot *domain.StateSnapshot, replace bool) {
+	s.recentMx.Lock()
+	defer s.recentMx.Unlock()
+
+	if _, ok := s.recent[snapshot.Height()]; ok && !replace {
+		return
+	}
+
+	s.recent[snapshot.Height()] = snapshot
+
+	var latestHeight uint64
+	for height := range s.recent {
+		if height > latestHeight {
+			latestHeight = height
+		}
+	}
+
+	for height := range s.recent {
+		if height+retainedStateSnapshots <= latestHeight {
+			delete(s.recent, height)
+		}
+	}
+}
+
+// GetStateSnapshot implements mvc.StateSnapshotUsecase.
+func (s *stateSnapshotUseCase) GetStateSnapshot(height uint64) (*domain.StateSnapshot, bool) {
+	s.recentMx.RLock()
+	defer s.recentMx.RUnlock()
+
+	snapshot, ok := s.recent[height]
+	return snapshot, ok
+}
+
+// GetLatestStateSnapshot implements mvc.StateSnapshotUsecase.
+func (s *stateSnapshotUseCase) GetLatestStateSnapshot() (*domain.StateSnapshot, bool) {
+	snapshot := s.latest.Load()
+	return snapshot, snapshot != nil
+}
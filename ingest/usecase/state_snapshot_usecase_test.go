@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/ingest/usecase"
+	"github.com/osmosis-labs/sqs/log"
+	routerrepo "github.com/osmosis-labs/sqs/router/repository"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// Tests that the published snapshot height never goes backwards
+// while the snapshots of older heights remain retained for their readers,
+// and that the snapshots capture the pool denom metadata.
+func (s *IngestUseCaseTestSuite) TestStateSnapshotUsecase_OnSearchDataUpdate() {
+	poolDenomMetadata := domain.PoolDenomMetaDataMap{
+		"uosmo": {TotalLiquidity: osmomath.NewInt(1_000)},
+	}
+
+	stateSnapshotUsecase := usecase.NewStateSnapshotUsecase(
+		&mocks.PoolsUsecaseMock{
+			GetAllPoolsFunc: func() ([]sqsdomain.PoolI, error) {
+				return nil, nil
+			},
+		},
+		&mocks.RouterUsecaseMock{},
+		routerrepo.New(&log.NoOpLogger{}),
+		&mocks.TokensUsecaseMock{
+			GetPoolDenomMetadataSnapshotFunc: func() (domain.PoolDenomMetaDataMap, map[string]domain.PoolDenomMetaDataMap) {
+				return poolDenomMetadata, nil
+			},
+		},
+		&log.NoOpLogger{},
+	)
+
+	requireLatestHeight := func(expectedHeight uint64) {
+		snapshot, ok := stateSnapshotUsecase.GetLatestStateSnapshot()
+		s.Require().True(ok)
+		s.Require().Equal(expectedHeight, snapshot.Height())
+	}
+
+	s.Require().NoError(stateSnapshotUsecase.OnSearchDataUpdate(context.Background(), 10))
+	requireLatestHeight(10)
+
+	// Recomputed at the same height, e.g. on a pool routing config update.
+	s.Require().NoError(stateSnapshotUsecase.OnSearchDataUpdate(context.Background(), 10))
+	requireLatestHeight(10)
+
+	s.Require().NoError(stateSnapshotUsecase.OnSearchDataUpdate(context.Background(), 12))
+	requireLatestHeight(12)
+
+	// Completed after a newer block.
+	s.Require().NoError(stateSnapshotUsecase.OnSearchDataUpdate(context.Background(), 11))
+	requireLatestHeight(12)
+
+	snapshot, ok := stateSnapshotUsecase.GetStateSnapshot(11)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(11), snapshot.Height())
+
+	// The pool denom metadata is captured by the snapshot.
+	actualPoolDenomMetadata, ok := snapshot.GetPoolDenomMetadata()
+	s.Require().True(ok)
+	s.Require().Equal(poolDenomMetadata, actualPoolDenomMetadata)
+
+	_, ok = stateSnapshotUsecase.GetStateSnapshot(13)
+	s.Require().False(ok)
+}
@@ -2,8 +2,13 @@ package middleware
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 
 	"time"
@@ -13,6 +18,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
 	"github.com/osmosis-labs/sqs/log"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -125,3 +131,102 @@ func (m *GoMiddleware) TraceWithParamsMiddleware() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// StateSnapshotMiddleware pins the latest state snapshot into the request context
+// so that all reads within a request observe the same height.
+// The height of the pinned snapshot is reported in the X-SQS-Height response header.
+// If no snapshot has been published yet, the request reads the live state.
+// The routes in excludedRoutes are not pinned, e.g. the long-lived streams that would otherwise
+// keep a stale snapshot alive for their whole duration.
+func (m *GoMiddleware) StateSnapshotMiddleware(stateSnapshotUsecase mvc.StateSnapshotUsecase, excludedRoutes ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if slices.Contains(excludedRoutes, c.Path()) {
+				return next(c)
+			}
+
+			snapshot, ok := stateSnapshotUsecase.GetLatestStateSnapshot()
+			if ok {
+				c.SetRequest(c.Request().WithContext(domain.ContextWithStateSnapshot(c.Request().Context(), snapshot)))
+				c.Response().Header().Set(domain.HeightHeader, strconv.FormatUint(snapshot.Height(), 10))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// minHeightPollInterval is the interval at which the latest height is polled
+// while a request waits for its minHeight to be ingested.
+const minHeightPollInterval = 50 * time.Millisecond
+
+// MinHeightMiddleware implements a read barrier for requests with the minHeight query parameter.
+// If the height of the latest state snapshot is below minHeight, the request waits up to maxWait for it
+// to be published. If it is still not published, the request fails with a retryable 503.
+// The height is compared against the latest state snapshot, which the request is served at,
+// rather than the healthcheck height, which is reported as stale when no block is ingested for a while.
+// Requests without the parameter are not affected.
+func (m *GoMiddleware) MinHeightMiddleware(stateSnapshotUsecase mvc.StateSnapshotUsecase, maxWait time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			minHeightStr := c.QueryParam(domain.MinHeightQueryParam)
+			if minHeightStr == "" {
+				return next(c)
+			}
+
+			minHeight, err := strconv.ParseUint(minHeightStr, 10, 64)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: fmt.Sprintf("invalid %s: %s", domain.MinHeightQueryParam, err)})
+			}
+
+			ctx := c.Request().Context()
+			deadline := time.Now().Add(maxWait)
+
+			for {
+				var latestHeight uint64
+				if snapshot, ok := stateSnapshotUsecase.GetLatestStateSnapshot(); ok {
+					latestHeight = snapshot.Height()
+				}
+
+				if latestHeight >= minHeight {
+					return next(c)
+				}
+
+				remaining := time.Until(deadline)
+				if remaining <= 0 {
+					c.Response().Header().Set(echo.HeaderRetryAfter, "1")
+					return c.JSON(http.StatusServiceUnavailable, domain.ResponseError{Message: fmt.Sprintf("height (%d) is not yet ingested, latest height (%d)", minHeight, latestHeight)})
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(min(remaining, minHeightPollInterval)):
+				}
+			}
+		}
+	}
+}
+
+// adminAuthScheme is the scheme of the Authorization header expected by the admin endpoints.
+const adminAuthScheme = "Bearer "
+
+// AdminAuthMiddleware rejects the requests without the "Authorization: Bearer <apiKey>" header with 401.
+// The key is compared in constant time so that it cannot be recovered from the response latency.
+func (m *GoMiddleware) AdminAuthMiddleware(apiKey string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+
+			requestKey, hasScheme := strings.CutPrefix(authorization, adminAuthScheme)
+			if !hasScheme || subtle.ConstantTimeCompare([]byte(requestKey), []byte(apiKey)) != 1 {
+				m.logger.Warn(domain.SQSAdminUnauthenticatedRequestMetricName, zap.String("path", c.Path()), zap.String("remote_ip", c.RealIP()))
+				domain.SQSAdminUnauthenticatedRequestCounter.Inc()
+
+				return c.JSON(http.StatusUnauthorized, domain.ResponseError{Message: "unauthenticated admin request"})
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/middleware"
+)
+
+// TestMinHeightMiddleware validates that requests with the minHeight parameter
+// are served once the height is ingested and fail with a retryable 503 otherwise.
+func TestMinHeightMiddleware(t *testing.T) {
+	const maxWait = 200 * time.Millisecond
+
+	tests := []struct {
+		name string
+
+		query string
+		// heights of the latest state snapshot returned on subsequent calls, zero if none is published.
+		// The last height is returned for all calls beyond the length.
+		heights []uint64
+
+		expectedStatusCode int
+		expectRetryAfter   bool
+	}{
+		{
+			name:               "no min height",
+			query:              "",
+			heights:            []uint64{0},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "min height already ingested",
+			query:              "?minHeight=10",
+			heights:            []uint64{10},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "min height ingested while waiting",
+			query:              "?minHeight=11",
+			heights:            []uint64{10, 10, 11},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "no state snapshot published within max wait",
+			query:              "?minHeight=1",
+			heights:            []uint64{0},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectRetryAfter:   true,
+		},
+		{
+			name:               "min height not ingested within max wait",
+			query:              "?minHeight=12",
+			heights:            []uint64{10},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectRetryAfter:   true,
+		},
+		{
+			name:               "invalid min height",
+			query:              "?minHeight=abc",
+			heights:            []uint64{10},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int64
+			stateSnapshotUsecase := &mocks.StateSnapshotUsecaseMock{
+				GetLatestStateSnapshotFunc: func() (*domain.StateSnapshot, bool) {
+					i := int(calls.Add(1) - 1)
+					if i >= len(tc.heights) {
+						i = len(tc.heights) - 1
+					}
+					if tc.heights[i] == 0 {
+						return nil, false
+					}
+					return domain.NewStateSnapshot(tc.heights[i], nil, nil, nil, nil), true
+				},
+			}
+
+			m := middleware.InitMiddleware(&domain.CORSConfig{}, &domain.FlightRecordConfig{}, &log.NoOpLogger{})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/pools"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler := m.MinHeightMiddleware(stateSnapshotUsecase, maxWait)(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := handler(c)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedStatusCode, rec.Code)
+			if tc.expectRetryAfter {
+				require.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+			}
+		})
+	}
+}
+
+// TestAdminAuthMiddleware validates that only the requests with the configured API key reach the admin endpoints.
+func TestAdminAuthMiddleware(t *testing.T) {
+	const apiKey = "secret"
+
+	tests := []struct {
+		name string
+
+		authorization string
+
+		expectedStatusCode int
+	}{
+		{
+			name:               "valid api key",
+			authorization:      "Bearer secret",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "missing header",
+			authorization:      "",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "invalid api key",
+			authorization:      "Bearer secre",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "missing scheme",
+			authorization:      "secret",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := middleware.InitMiddleware(&domain.CORSConfig{}, &domain.FlightRecordConfig{}, &log.NoOpLogger{})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/pool-routing/denied-pool-ids/1", nil)
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler := m.AdminAuthMiddleware(apiKey)(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := handler(c)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedStatusCode, rec.Code)
+		})
+	}
+}
+
+// TestStateSnapshotMiddleware validates that the latest state snapshot is pinned
+// for all routes except the excluded ones.
+func TestStateSnapshotMiddleware(t *testing.T) {
+	const (
+		pinnedRoute   = "/pools"
+		excludedRoute = "/pools/changes"
+	)
+
+	stateSnapshotUsecase := &mocks.StateSnapshotUsecaseMock{
+		GetLatestStateSnapshotFunc: func() (*domain.StateSnapshot, bool) {
+			return domain.NewStateSnapshot(10, nil, nil, nil, nil), true
+		},
+	}
+
+	tests := []struct {
+		name  string
+		route string
+
+		expectedHeight uint64
+		expectedHeader string
+	}{
+		{
+			name:  "pinned",
+			route: pinnedRoute,
+
+			expectedHeight: 10,
+			expectedHeader: "10",
+		},
+		{
+			name:  "excluded",
+			route: excludedRoute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := middleware.InitMiddleware(&domain.CORSConfig{}, &domain.FlightRecordConfig{}, &log.NoOpLogger{})
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, tc.route, nil), rec)
+			c.SetPath(tc.route)
+
+			var height uint64
+			handler := m.StateSnapshotMiddleware(stateSnapshotUsecase, excludedRoute)(func(c echo.Context) error {
+				height = domain.GetHeightFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			require.NoError(t, handler(c))
+
+			require.Equal(t, tc.expectedHeight, height)
+			require.Equal(t, tc.expectedHeader, rec.Header().Get(domain.HeightHeader))
+		})
+	}
+}
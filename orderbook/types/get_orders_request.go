@@ -79,6 +79,8 @@ func defaultSortOrder(orderA, orderB orderbookdomain.LimitOrder) int {
 type GetActiveOrdersResponse struct {
 	Orders       []orderbookdomain.LimitOrder `json:"orders"`
 	IsBestEffort bool                         `json:"is_best_effort"`
+	// Height is the height of the state the request was served at.
+	Height uint64 `json:"height,omitempty"`
 }
 
 // NewGetAllOrderResponse creates a new GetActiveOrdersResponse.
@@ -68,6 +68,8 @@ func (a *PassthroughHandler) GetPortfolioAssetsByAddress(c echo.Context) error {
 		return c.JSON(http.StatusPartialContent, domain.ResponseError{Message: err.Error()})
 	}
 
+	portfolioAssetsResult.Height = domain.GetHeightFromContext(c.Request().Context())
+
 	return c.JSON(http.StatusOK, portfolioAssetsResult)
 }
 
@@ -150,6 +152,7 @@ func (a *PassthroughHandler) GetActiveOrders(c echo.Context) error {
 	}
 
 	resp := types.NewGetAllOrderResponse(orders, isBestEffort)
+	resp.Height = domain.GetHeightFromContext(ctx)
 
 	return c.JSON(http.StatusOK, resp)
 }
@@ -2,9 +2,12 @@ package pools
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/osmosis-labs/sqs/delivery/http"
+	"github.com/osmosis-labs/sqs/domain/expression"
 	"github.com/osmosis-labs/sqs/domain/number"
 	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
 
@@ -13,8 +16,12 @@ import (
 
 const (
 	maxSearchQueryLength = 50
+	maxRangeFilters      = 10
 )
 
+// queryFilterRangeRegex matches the range filter query parameters, e.g. filter[market.volume24hUsd][min].
+var queryFilterRangeRegex = regexp.MustCompile(`^filter\[([^\[\]]+)\]\[(min|max)\]$`)
+
 const (
 	queryIDs                        = "IDs"                    // Deprecated: use filter[id]
 	queryMinLiquidityCap            = "min_liquidity_cap"      // Deprecated: use filter[min_liquidity_cap]
@@ -26,6 +33,7 @@ const (
 	queryFilterMinLiquidityCap      = "filter[min_liquidity_cap]"
 	queryFilterWithMarketIncentives = "filter[with_market_incentives]"
 	queryFilterSearch               = "filter[search]"
+	queryWhere                      = "where"
 )
 
 // UnmarshalHTTPRequest imlpements RequestUnmarshaler interface.
@@ -68,7 +76,19 @@ func (r *GetPoolsRequestFilter) IsPresent(c echo.Context) bool {
 		c.QueryParam(queryFilterMinLiquidityCap) != "" ||
 		c.QueryParam(queryWithMarketIncentives) != "" ||
 		c.QueryParam(queryFilterWithMarketIncentives) != "" ||
-		c.QueryParam(queryFilterSearch) != ""
+		c.QueryParam(queryFilterSearch) != "" ||
+		c.QueryParam(queryWhere) != "" ||
+		hasRangeFilterQueryParam(c)
+}
+
+// hasRangeFilterQueryParam returns true if any range filter query parameter is present.
+func hasRangeFilterQueryParam(c echo.Context) bool {
+	for key := range c.QueryParams() {
+		if queryFilterRangeRegex.MatchString(key) {
+			return true
+		}
+	}
+	return false
 }
 
 // UnmarshalHTTPRequest imlpements RequestUnmarshaler interface.
@@ -155,5 +175,64 @@ func (r *GetPoolsRequestFilter) UnmarshalHTTPRequest(c echo.Context) error {
 		r.Search = p
 	}
 
+	// Parse the filter expression. It is compiled against the pool attributes by the pools use case.
+	if p := c.QueryParam(queryWhere); p != "" {
+		if len(p) > expression.MaxExpressionLength {
+			return fmt.Errorf("where expression is too long")
+		}
+		r.Where = p
+	}
+
+	// Parse range filters
+	r.Ranges, err = parseRangeFilters(c)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// parseRangeFilters parses the filter[<field>][min] and filter[<field>][max] query parameters
+// into range filters sorted by field name.
+func parseRangeFilters(c echo.Context) ([]*RangeFilter, error) {
+	rangesByField := map[string]*RangeFilter{}
+	for key, values := range c.QueryParams() {
+		match := queryFilterRangeRegex.FindStringSubmatch(key)
+		if match == nil || len(values) == 0 {
+			continue
+		}
+
+		field, bound := match[1], match[2]
+
+		value, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value '%s' for range filter '%s': %w", bound, values[0], field, err)
+		}
+
+		rangeFilter, ok := rangesByField[field]
+		if !ok {
+			rangeFilter = &RangeFilter{Field: field}
+			rangesByField[field] = rangeFilter
+		}
+
+		if bound == "min" {
+			rangeFilter.Min, rangeFilter.HasMin = value, true
+		} else {
+			rangeFilter.Max, rangeFilter.HasMax = value, true
+		}
+	}
+
+	if len(rangesByField) > maxRangeFilters {
+		return nil, fmt.Errorf("too many range filters, maximum is %d", maxRangeFilters)
+	}
+
+	var ranges []*RangeFilter
+	for _, rangeFilter := range rangesByField {
+		ranges = append(ranges, rangeFilter)
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Field < ranges[j].Field
+	})
+
+	return ranges, nil
+}
@@ -3,10 +3,13 @@ package pools
 import (
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/osmosis-labs/sqs/domain/expression"
 )
 
 func TestGetPoolsRequestFilter_IsPresent(t *testing.T) {
@@ -50,6 +53,16 @@ func TestGetPoolsRequestFilter_IsPresent(t *testing.T) {
 			queryParams:    map[string]string{queryFilterSearch: "search"},
 			expectedResult: true,
 		},
+		{
+			name:           "With range filter",
+			queryParams:    map[string]string{"filter[market.volume24hUsd][min]": "1000"},
+			expectedResult: true,
+		},
+		{
+			name:           "With where",
+			queryParams:    map[string]string{queryWhere: "liquidity_cap > 1e6"},
+			expectedResult: true,
+		},
 		{
 			name:           "With min_liquidity_cap",
 			queryParams:    map[string]string{queryMinLiquidityCap: "1000"},
@@ -108,16 +121,21 @@ func TestGetPoolsRequestFilter_UnmarshalHTTPRequest(t *testing.T) {
 		{
 			name: "All parameters",
 			queryParams: map[string]string{
-				queryIDs:                        "1,2,3",
-				queryFilterID:                   "4,5",
-				queryFilterIDNotIn:              "6,7",
-				queryFilterType:                 "8,9",
-				queryFilterIncentive:            "0,1",
-				queryMinLiquidityCap:            "1000",
-				queryFilterMinLiquidityCap:      "2000",
-				queryWithMarketIncentives:       "true",
-				queryFilterWithMarketIncentives: "true",
-				queryFilterSearch:               "search",
+				queryIDs:                           "1,2,3",
+				queryFilterID:                      "4,5",
+				queryFilterIDNotIn:                 "6,7",
+				queryFilterType:                    "8,9",
+				queryFilterIncentive:               "0,1",
+				queryMinLiquidityCap:               "1000",
+				queryFilterMinLiquidityCap:         "2000",
+				queryWithMarketIncentives:          "true",
+				queryFilterWithMarketIncentives:    "true",
+				queryFilterSearch:                  "search",
+				queryWhere:                         `"uosmo" in denoms`,
+				"filter[market.volume24hUsd][min]": "1000",
+				"filter[market.volume24hUsd][max]": "1e6",
+				"filter[incentives.aprBreakdown.total.upper][min]": "0.1",
+				"filter[market.volume7dUsd][max]":                  "0",
 			},
 			expectedFilter: GetPoolsRequestFilter{
 				PoolId:               []uint64{1, 2, 3, 4, 5},
@@ -127,6 +145,12 @@ func TestGetPoolsRequestFilter_UnmarshalHTTPRequest(t *testing.T) {
 				MinLiquidityCap:      2000,
 				WithMarketIncentives: true,
 				Search:               "search",
+				Where:                `"uosmo" in denoms`,
+				Ranges: []*RangeFilter{
+					{Field: "incentives.aprBreakdown.total.upper", Min: 0.1, HasMin: true},
+					{Field: "market.volume24hUsd", Min: 1000, HasMin: true, Max: 1e6, HasMax: true},
+					{Field: "market.volume7dUsd", Max: 0, HasMax: true},
+				},
 			},
 			expectError: false,
 		},
@@ -205,6 +229,20 @@ func TestGetPoolsRequestFilter_UnmarshalHTTPRequest(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "Invalid range filter value",
+			queryParams: map[string]string{
+				"filter[market.volume24hUsd][min]": "invalid",
+			},
+			expectError: true,
+		},
+		{
+			name: "Invalid Where ( too long )",
+			queryParams: map[string]string{
+				queryWhere: strings.Repeat("a", expression.MaxExpressionLength+1),
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,7 +4,13 @@
 package pools
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
 	io "io"
@@ -73,6 +79,11 @@ type GetPoolsRequestFilter struct {
 	WithMarketIncentives bool `protobuf:"varint,6,opt,name=with_market_incentives,json=withMarketIncentives,proto3" json:"with_market_incentives,omitempty"`
 	// search is the search string to filter pools by.
 	Search string `protobuf:"bytes,7,opt,name=search,proto3" json:"search,omitempty"`
+	// where is an expression over pool attributes to filter pools by,
+	// e.g. `liquidity_cap > 1e6 && "uosmo" in denoms`.
+	Where string `protobuf:"bytes,8,opt,name=where,proto3" json:"where,omitempty"`
+	// ranges are the ranges of numeric pool fields to filter pools by.
+	Ranges []*RangeFilter `protobuf:"bytes,9,rep,name=ranges,proto3" json:"ranges,omitempty"`
 }
 
 func (m *GetPoolsRequestFilter) Reset()         { *m = GetPoolsRequestFilter{} }
@@ -157,6 +168,103 @@ func (m *GetPoolsRequestFilter) GetSearch() string {
 	return ""
 }
 
+func (m *GetPoolsRequestFilter) GetWhere() string {
+	if m != nil {
+		return m.Where
+	}
+	return ""
+}
+
+func (m *GetPoolsRequestFilter) GetRanges() []*RangeFilter {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+// RangeFilter filters pools by the range of a numeric pool field.
+type RangeFilter struct {
+	// field is the name of the pool field. Accepts the same names as the sort
+	// fields, e.g. market.volume24hUsd.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// min is the inclusive lower bound. Only applied if has_min is set.
+	Min float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the inclusive upper bound. Only applied if has_max is set.
+	Max float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	// has_min defines whether the lower bound is set.
+	HasMin bool `protobuf:"varint,4,opt,name=has_min,json=hasMin,proto3" json:"has_min,omitempty"`
+	// has_max defines whether the upper bound is set.
+	HasMax bool `protobuf:"varint,5,opt,name=has_max,json=hasMax,proto3" json:"has_max,omitempty"`
+}
+
+func (m *RangeFilter) Reset()         { *m = RangeFilter{} }
+func (m *RangeFilter) String() string { return proto.CompactTextString(m) }
+func (*RangeFilter) ProtoMessage()    {}
+func (*RangeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f2696e6c186971, []int{1}
+}
+func (m *RangeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeFilter.Merge(m, src)
+}
+func (m *RangeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeFilter proto.InternalMessageInfo
+
+func (m *RangeFilter) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *RangeFilter) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *RangeFilter) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *RangeFilter) GetHasMin() bool {
+	if m != nil {
+		return m.HasMin
+	}
+	return false
+}
+
+func (m *RangeFilter) GetHasMax() bool {
+	if m != nil {
+		return m.HasMax
+	}
+	return false
+}
+
 // GetPoolsRequest is the request type for the Service.Get RPC method.
 type GetPoolsRequest struct {
 	// Filter options for the result set
@@ -171,7 +279,7 @@ func (m *GetPoolsRequest) Reset()         { *m = GetPoolsRequest{} }
 func (m *GetPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPoolsRequest) ProtoMessage()    {}
 func (*GetPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_30f2696e6c186971, []int{1}
+	return fileDescriptor_30f2696e6c186971, []int{2}
 }
 func (m *GetPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,15 +329,118 @@ func (m *GetPoolsRequest) GetSort() *v1beta1.SortRequest {
 	return nil
 }
 
-// TODO: Add more fields to the response
+// Pool is the pool representation returned to clients.
+type Pool struct {
+	// id is the pool id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the pool type as defined by the poolmanager module.
+	Type uint64 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// chain_model is the pool model as stored on chain.
+	ChainModel *types.Any `protobuf:"bytes,3,opt,name=chain_model,json=chainModel,proto3" json:"chain_model,omitempty"`
+	// balances are the pool balances.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// spread_factor is the pool spread factor.
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor"`
+	// liquidity_cap is the pool liquidity capitalization in the default quote
+	// denom.
+	LiquidityCap cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=liquidity_cap,json=liquidityCap,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity_cap"`
+	// liquidity_cap_error is the error that occurred while computing the
+	// liquidity capitalization, if any.
+	LiquidityCapError string `protobuf:"bytes,7,opt,name=liquidity_cap_error,json=liquidityCapError,proto3" json:"liquidity_cap_error,omitempty"`
+	// incentive is the incentive type of the pool.
+	Incentive IncentiveType `protobuf:"varint,8,opt,name=incentive,proto3,enum=sqs.pools.v1beta1.IncentiveType" json:"incentive,omitempty"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f2696e6c186971, []int{3}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Pool) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Pool) GetChainModel() *types.Any {
+	if m != nil {
+		return m.ChainModel
+	}
+	return nil
+}
+
+func (m *Pool) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *Pool) GetLiquidityCapError() string {
+	if m != nil {
+		return m.LiquidityCapError
+	}
+	return ""
+}
+
+func (m *Pool) GetIncentive() IncentiveType {
+	if m != nil {
+		return m.Incentive
+	}
+	return IncentiveType_SUPERFLUID
+}
+
+// GetPoolsResponse is the response type for the Query.Pools RPC method.
 type GetPoolsResponse struct {
+	// pools are the pools matching the request.
+	Pools []Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	// meta is the pagination metadata of the result set.
+	Meta *v1beta1.PaginationResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	// height is the height of the state the pools were read at.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *GetPoolsResponse) Reset()         { *m = GetPoolsResponse{} }
 func (m *GetPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPoolsResponse) ProtoMessage()    {}
 func (*GetPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_30f2696e6c186971, []int{2}
+	return fileDescriptor_30f2696e6c186971, []int{4}
 }
 func (m *GetPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,48 +469,96 @@ func (m *GetPoolsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetPoolsResponse proto.InternalMessageInfo
 
+func (m *GetPoolsResponse) GetPools() []Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *GetPoolsResponse) GetMeta() *v1beta1.PaginationResponse {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *GetPoolsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("sqs.pools.v1beta1.IncentiveType", IncentiveType_name, IncentiveType_value)
 	proto.RegisterType((*GetPoolsRequestFilter)(nil), "sqs.pools.v1beta1.GetPoolsRequestFilter")
+	proto.RegisterType((*RangeFilter)(nil), "sqs.pools.v1beta1.RangeFilter")
 	proto.RegisterType((*GetPoolsRequest)(nil), "sqs.pools.v1beta1.GetPoolsRequest")
+	proto.RegisterType((*Pool)(nil), "sqs.pools.v1beta1.Pool")
 	proto.RegisterType((*GetPoolsResponse)(nil), "sqs.pools.v1beta1.GetPoolsResponse")
 }
 
 func init() { proto.RegisterFile("sqs/pools/v1beta1/pools.proto", fileDescriptor_30f2696e6c186971) }
 
 var fileDescriptor_30f2696e6c186971 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xda, 0x3e,
-	0x18, 0xc7, 0x31, 0xa4, 0x50, 0x1e, 0xf4, 0xa3, 0xd4, 0xfa, 0xad, 0xb3, 0xaa, 0x2d, 0x8a, 0xd8,
-	0x0e, 0x51, 0xa5, 0x81, 0x60, 0x3b, 0x4f, 0x5b, 0xd7, 0x76, 0x42, 0x6b, 0x09, 0x4a, 0xda, 0xcb,
-	0x2e, 0x51, 0x00, 0x0f, 0xac, 0x12, 0x3b, 0x89, 0x4d, 0x27, 0xde, 0xc5, 0xde, 0xc3, 0xde, 0xcc,
-	0x2e, 0x93, 0x7a, 0xdc, 0x71, 0x82, 0x37, 0x32, 0xc5, 0x09, 0xb0, 0xae, 0xdc, 0xfc, 0xf8, 0xfb,
-	0x7c, 0xbe, 0xf6, 0xf3, 0x07, 0x9e, 0xcb, 0x58, 0xb6, 0x23, 0x21, 0x66, 0xb2, 0x7d, 0xd7, 0x19,
-	0x52, 0x15, 0x74, 0xb2, 0xa8, 0x15, 0x25, 0x42, 0x09, 0x7c, 0x28, 0x63, 0xd9, 0xca, 0x2e, 0x72,
-	0xf9, 0xb8, 0x99, 0x12, 0xf1, 0x9c, 0x26, 0x8b, 0x2d, 0x11, 0x4c, 0x18, 0x0f, 0x14, 0x13, 0x3c,
-	0xc3, 0x8e, 0x9f, 0x3d, 0xce, 0x91, 0x22, 0x51, 0x99, 0xda, 0xfc, 0x5e, 0x84, 0x27, 0x1f, 0xa9,
-	0x1a, 0xa4, 0xb6, 0x2e, 0x8d, 0xe7, 0x54, 0xaa, 0x0b, 0x36, 0x53, 0x34, 0xc1, 0x4f, 0xa1, 0x92,
-	0x3e, 0xe6, 0xb3, 0x31, 0x41, 0x56, 0xc9, 0x36, 0xdc, 0x72, 0x1a, 0xf6, 0xc6, 0xf8, 0x05, 0xd4,
-	0x73, 0xc1, 0xe7, 0x42, 0xf9, 0x8c, 0x93, 0xa2, 0xd6, 0x6b, 0x99, 0xde, 0x17, 0xaa, 0xc7, 0x31,
-	0x06, 0x43, 0x2d, 0x22, 0x4a, 0x4a, 0x5a, 0xd2, 0x67, 0xfc, 0x16, 0xaa, 0x8c, 0x8f, 0x28, 0x57,
-	0xec, 0x8e, 0x12, 0xc3, 0x2a, 0xd9, 0xf5, 0xae, 0xd5, 0x7a, 0x54, 0x54, 0xab, 0xb7, 0xce, 0xb9,
-	0x5e, 0x44, 0xd4, 0xdd, 0x22, 0xf8, 0x04, 0x0e, 0x43, 0xc6, 0xfd, 0x19, 0x8b, 0xe7, 0x6c, 0xcc,
-	0xd4, 0xc2, 0x1f, 0x05, 0x11, 0xd9, 0xb3, 0x90, 0x6d, 0xb8, 0x07, 0x21, 0xe3, 0x97, 0xeb, 0xfb,
-	0x0f, 0x41, 0x84, 0xdf, 0xc0, 0xd1, 0x57, 0xa6, 0xa6, 0x7e, 0x18, 0x24, 0xb7, 0x34, 0xfd, 0x64,
-	0x6e, 0x22, 0x49, 0xd9, 0x42, 0xf6, 0xbe, 0xfb, 0x7f, 0xaa, 0x5e, 0x69, 0x71, 0xf3, 0x9e, 0xc4,
-	0x47, 0x50, 0x96, 0x34, 0x48, 0x46, 0x53, 0x52, 0xb1, 0x90, 0x5d, 0x75, 0xf3, 0xa8, 0xf9, 0x13,
-	0xc1, 0xc1, 0x3f, 0x5d, 0xc2, 0xef, 0xa0, 0xfc, 0x45, 0x77, 0x8a, 0x20, 0x0b, 0xd9, 0xb5, 0xae,
-	0xbd, 0xa3, 0x94, 0x9d, 0x9d, 0x75, 0x73, 0x0e, 0x9f, 0x01, 0x6c, 0xa7, 0x45, 0x8a, 0xda, 0xe5,
-	0xa5, 0x76, 0xd1, 0xe3, 0xda, 0xb8, 0x0c, 0x36, 0x49, 0xb9, 0x8f, 0xfb, 0x17, 0x87, 0xbb, 0x60,
-	0xa4, 0xf3, 0x24, 0x25, 0xcd, 0x9b, 0x3b, 0x78, 0x4f, 0x24, 0x6a, 0x4d, 0xea, 0xdc, 0x26, 0x86,
-	0xc6, 0xf6, 0x6b, 0x32, 0x12, 0x5c, 0xd2, 0x93, 0xf7, 0xf0, 0xdf, 0x83, 0xce, 0xe3, 0x3a, 0x80,
-	0x77, 0x33, 0x38, 0x77, 0x2f, 0x2e, 0x6f, 0x7a, 0x67, 0x8d, 0x02, 0xae, 0x41, 0xc5, 0xf1, 0xae,
-	0x1c, 0xaf, 0xe7, 0x35, 0x10, 0xae, 0xc2, 0xde, 0xa9, 0xe3, 0x78, 0xd7, 0x8d, 0x22, 0xde, 0x07,
-	0xa3, 0xef, 0xf4, 0xcf, 0x1b, 0xa5, 0xd3, 0x4f, 0x3f, 0x96, 0x26, 0xba, 0x5f, 0x9a, 0xe8, 0xf7,
-	0xd2, 0x44, 0xdf, 0x56, 0x66, 0xe1, 0x7e, 0x65, 0x16, 0x7e, 0xad, 0xcc, 0xc2, 0xe7, 0xce, 0x84,
-	0xa9, 0xe9, 0x7c, 0xd8, 0x1a, 0x89, 0xb0, 0x2d, 0x64, 0x28, 0x24, 0x93, 0xaf, 0x66, 0xc1, 0x50,
-	0xb6, 0xf5, 0xca, 0xdf, 0x4e, 0xda, 0x41, 0xc4, 0x1e, 0x2e, 0xfd, 0xb0, 0xac, 0x17, 0xf4, 0xf5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x1b, 0xcf, 0x81, 0x16, 0x03, 0x00, 0x00,
+	// 884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xd1, 0x6e, 0xdb, 0x36,
+	0x17, 0xb6, 0x6c, 0xd9, 0x89, 0xa9, 0x26, 0x75, 0xf8, 0xa7, 0xad, 0xda, 0x7f, 0x75, 0x0c, 0x77,
+	0x03, 0x84, 0x02, 0x95, 0x16, 0x77, 0x1b, 0xb0, 0x9b, 0x61, 0x75, 0x93, 0x6c, 0xc6, 0x92, 0x38,
+	0xa0, 0xdb, 0x9b, 0xdd, 0x08, 0xb4, 0xcc, 0x48, 0x44, 0x24, 0x52, 0x16, 0xe9, 0x36, 0x7e, 0x8b,
+	0x5d, 0xef, 0x11, 0xf6, 0x24, 0xb9, 0x19, 0xd0, 0xcb, 0x61, 0x17, 0xdd, 0x90, 0x3c, 0xc1, 0xde,
+	0x60, 0x20, 0x25, 0xcb, 0xf6, 0x12, 0x60, 0xd8, 0x95, 0x79, 0xce, 0xf7, 0x9d, 0x8f, 0x87, 0xe4,
+	0x77, 0x2c, 0xf0, 0x54, 0x4c, 0x85, 0x97, 0x72, 0x1e, 0x0b, 0xef, 0xdd, 0xfe, 0x98, 0x48, 0xbc,
+	0x9f, 0x47, 0x6e, 0x9a, 0x71, 0xc9, 0xe1, 0x8e, 0x98, 0x0a, 0x37, 0x4f, 0x14, 0xf0, 0x93, 0xdd,
+	0x90, 0x87, 0x5c, 0xa3, 0x9e, 0x5a, 0xe5, 0xc4, 0x27, 0x8f, 0x43, 0xce, 0xc3, 0x98, 0x78, 0x3a,
+	0x1a, 0xcf, 0xce, 0x3d, 0xcc, 0xe6, 0x05, 0xd4, 0x0e, 0xb8, 0x48, 0xb8, 0xf0, 0xc6, 0x58, 0x90,
+	0x72, 0x93, 0x80, 0x53, 0x56, 0xe0, 0x5d, 0xd5, 0xc2, 0x74, 0x46, 0xb2, 0xf9, 0xb2, 0x05, 0x1c,
+	0x52, 0x86, 0x25, 0xe5, 0x0b, 0xce, 0x27, 0xb7, 0x39, 0x82, 0x67, 0x32, 0x47, 0xbb, 0x7f, 0x55,
+	0xc1, 0x83, 0xef, 0x88, 0x3c, 0x53, 0x7d, 0x22, 0x32, 0x9d, 0x11, 0x21, 0x8f, 0x68, 0x2c, 0x49,
+	0x06, 0x1f, 0x81, 0x0d, 0xd5, 0xbd, 0x4f, 0x27, 0xb6, 0xd1, 0xa9, 0x39, 0x26, 0x6a, 0xa8, 0x70,
+	0x30, 0x81, 0xcf, 0xc0, 0x76, 0x01, 0xf8, 0x8c, 0x4b, 0x9f, 0x32, 0xbb, 0xaa, 0x71, 0x2b, 0xc7,
+	0x4f, 0xb9, 0x1c, 0x30, 0x08, 0x81, 0x29, 0xe7, 0x29, 0xb1, 0x6b, 0x1a, 0xd2, 0x6b, 0xf8, 0x0d,
+	0x68, 0x52, 0x16, 0x10, 0x26, 0xe9, 0x3b, 0x62, 0x9b, 0x9d, 0x9a, 0xb3, 0xdd, 0xeb, 0xb8, 0xb7,
+	0x6e, 0xc9, 0x1d, 0x2c, 0x38, 0x6f, 0xe6, 0x29, 0x41, 0xcb, 0x12, 0xf8, 0x1c, 0xec, 0x24, 0x94,
+	0xf9, 0x31, 0x9d, 0xce, 0xe8, 0x84, 0xca, 0xb9, 0x1f, 0xe0, 0xd4, 0xae, 0x77, 0x0c, 0xc7, 0x44,
+	0xf7, 0x13, 0xca, 0x8e, 0x17, 0xf9, 0xd7, 0x38, 0x85, 0x5f, 0x80, 0x87, 0xef, 0xa9, 0x8c, 0xfc,
+	0x04, 0x67, 0x17, 0x44, 0xfa, 0xa5, 0x88, 0xb0, 0x1b, 0x1d, 0xc3, 0xd9, 0x44, 0xbb, 0x0a, 0x3d,
+	0xd1, 0x60, 0xb9, 0x9f, 0x80, 0x0f, 0x41, 0x43, 0x10, 0x9c, 0x05, 0x91, 0xbd, 0xd1, 0x31, 0x9c,
+	0x26, 0x2a, 0x22, 0xb8, 0x0b, 0xea, 0xef, 0x23, 0x92, 0x11, 0x7b, 0x53, 0xa7, 0xf3, 0x00, 0x7e,
+	0x05, 0x1a, 0x19, 0x66, 0x21, 0x11, 0x76, 0xb3, 0x53, 0x73, 0xac, 0x5e, 0xfb, 0x8e, 0xc3, 0x20,
+	0x45, 0xc8, 0x6f, 0x14, 0x15, 0xec, 0xee, 0x1c, 0x58, 0x2b, 0x69, 0x25, 0x7e, 0x4e, 0x49, 0xac,
+	0xae, 0x59, 0x8b, 0xeb, 0x00, 0xb6, 0x40, 0x2d, 0xd1, 0x57, 0x6b, 0x38, 0x06, 0x52, 0x4b, 0x9d,
+	0xc1, 0x97, 0x76, 0xad, 0xc8, 0xe0, 0x4b, 0xf5, 0x44, 0x11, 0x16, 0xbe, 0xe2, 0x99, 0xfa, 0x54,
+	0x8d, 0x08, 0x8b, 0x13, 0xca, 0x4a, 0x00, 0x5f, 0xda, 0xf5, 0x25, 0x80, 0x2f, 0xbb, 0xbf, 0x1a,
+	0xe0, 0xfe, 0x3f, 0x9e, 0x1b, 0x7e, 0x0b, 0x1a, 0xe7, 0xba, 0x13, 0xdd, 0x80, 0xd5, 0x73, 0xee,
+	0x38, 0xc6, 0x9d, 0x16, 0x41, 0x45, 0x1d, 0x3c, 0x00, 0x60, 0x69, 0x3b, 0xdd, 0xb2, 0xd5, 0xfb,
+	0x54, 0xab, 0x68, 0xdf, 0x95, 0x2a, 0x67, 0x25, 0xa9, 0xd0, 0x41, 0x2b, 0x75, 0xb0, 0x07, 0x4c,
+	0x65, 0x4c, 0x7d, 0xc0, 0xc5, 0x65, 0xae, 0xd7, 0x8f, 0x78, 0x26, 0x17, 0x95, 0x9a, 0xdb, 0xbd,
+	0xaa, 0x01, 0x53, 0x35, 0x06, 0xb7, 0x41, 0x95, 0xe6, 0x37, 0x68, 0xa2, 0x2a, 0x9d, 0x94, 0xfe,
+	0xab, 0xea, 0x8c, 0x5e, 0xc3, 0x2f, 0x81, 0x15, 0x44, 0x98, 0x32, 0x3f, 0xe1, 0x13, 0x12, 0x17,
+	0xfb, 0xec, 0xba, 0xf9, 0xf8, 0xb9, 0x8b, 0xf1, 0x73, 0x5f, 0xb1, 0x39, 0x02, 0x9a, 0x78, 0xa2,
+	0x78, 0x30, 0x04, 0x9b, 0x63, 0x1c, 0x63, 0x16, 0x10, 0xa1, 0x5d, 0x6b, 0xf5, 0x1e, 0xbb, 0xf9,
+	0x5c, 0xba, 0x6a, 0x2e, 0xcb, 0xee, 0x5e, 0x73, 0xca, 0xfa, 0x9f, 0x5f, 0x7d, 0xdc, 0xab, 0xfc,
+	0xf2, 0xc7, 0x9e, 0x13, 0x52, 0x19, 0xcd, 0xc6, 0x6e, 0xc0, 0x13, 0xaf, 0x18, 0xe2, 0xfc, 0xe7,
+	0x85, 0x98, 0x5c, 0x78, 0xaa, 0x1d, 0xa1, 0x0b, 0x04, 0x2a, 0xc5, 0xe1, 0xf7, 0x60, 0x4b, 0xa4,
+	0x19, 0xc1, 0x13, 0xff, 0x1c, 0x07, 0x92, 0x67, 0xfa, 0xed, 0x9a, 0xfd, 0x67, 0x4a, 0xf2, 0xf7,
+	0x8f, 0x7b, 0xff, 0xcf, 0x05, 0xc4, 0xe4, 0xc2, 0xa5, 0xdc, 0x4b, 0xb0, 0x8c, 0xdc, 0x63, 0x12,
+	0xe2, 0x60, 0x7e, 0x40, 0x02, 0x74, 0x2f, 0xaf, 0x3c, 0xd2, 0x85, 0xb0, 0x0f, 0xb6, 0xd6, 0xa7,
+	0xa4, 0xa1, 0x95, 0x9e, 0x16, 0x4a, 0x0f, 0x6e, 0x2b, 0x0d, 0x98, 0x44, 0xf7, 0xe2, 0xd5, 0x09,
+	0x72, 0xc1, 0xff, 0xd6, 0x34, 0x7c, 0x92, 0x65, 0x3c, 0x2b, 0x06, 0x63, 0x67, 0x95, 0x7a, 0xa8,
+	0x80, 0xf5, 0xe9, 0x56, 0x73, 0xf2, 0xdf, 0xa6, 0xbb, 0xfb, 0xb3, 0x01, 0x5a, 0x4b, 0x9b, 0x89,
+	0x94, 0x33, 0x41, 0xe0, 0x4b, 0x50, 0xd7, 0xe5, 0xfa, 0x2f, 0xc8, 0xea, 0x3d, 0xba, 0x43, 0x50,
+	0x15, 0xf4, 0x4d, 0x75, 0x32, 0x94, 0x73, 0xe1, 0xd7, 0xc0, 0x4c, 0x88, 0xc4, 0x85, 0x11, 0x3f,
+	0xfb, 0x17, 0x23, 0xe6, 0x3b, 0x21, 0x5d, 0xa2, 0xfe, 0x00, 0x22, 0x42, 0xc3, 0x28, 0x77, 0xa1,
+	0x89, 0x8a, 0xe8, 0xf9, 0x2b, 0xb0, 0xb5, 0xd6, 0x38, 0xdc, 0x06, 0x60, 0xf4, 0xf6, 0xec, 0x10,
+	0x1d, 0x1d, 0xbf, 0x1d, 0x1c, 0xb4, 0x2a, 0xd0, 0x02, 0x1b, 0xc3, 0xd1, 0xc9, 0x70, 0x34, 0x18,
+	0xb5, 0x0c, 0xd8, 0x04, 0xf5, 0xfe, 0x70, 0x38, 0x7a, 0xd3, 0xaa, 0xc2, 0x4d, 0x60, 0x9e, 0x0e,
+	0x4f, 0x0f, 0x5b, 0xb5, 0xfe, 0x0f, 0x3f, 0xee, 0xaf, 0x78, 0x42, 0x3f, 0x00, 0x15, 0x2f, 0x62,
+	0x3c, 0x16, 0x9e, 0xfe, 0x90, 0x5c, 0x84, 0x1e, 0x4e, 0xe9, 0xfa, 0xa7, 0xe4, 0xea, 0xba, 0x6d,
+	0x7c, 0xb8, 0x6e, 0x1b, 0x7f, 0x5e, 0xb7, 0x8d, 0x9f, 0x6e, 0xda, 0x95, 0x0f, 0x37, 0xed, 0xca,
+	0x6f, 0x37, 0xed, 0xca, 0xb8, 0xa1, 0xdd, 0xfa, 0xf2, 0xef, 0x01, 0x00, 0x6d, 0x7c, 0x9b, 0xc2,
+	0x84, 0x06, 0x00, 0x00,
 }
 
 func (m *GetPoolsRequestFilter) Marshal() (dAtA []byte, err error) {
@@ -322,6 +581,27 @@ func (m *GetPoolsRequestFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Where) > 0 {
+		i -= len(m.Where)
+		copy(dAtA[i:], m.Where)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Where)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Search) > 0 {
 		i -= len(m.Search)
 		copy(dAtA[i:], m.Search)
@@ -419,6 +699,68 @@ func (m *GetPoolsRequestFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RangeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMax {
+		i--
+		if m.HasMax {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasMin {
+		i--
+		if m.HasMin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Max != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Min != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -478,6 +820,97 @@ func (m *GetPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Incentive != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Incentive))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LiquidityCapError) > 0 {
+		i -= len(m.LiquidityCapError)
+		copy(dAtA[i:], m.LiquidityCapError)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.LiquidityCapError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.LiquidityCap.Size()
+		i -= size
+		if _, err := m.LiquidityCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ChainModel != nil {
+		{
+			size, err := m.ChainModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -498,6 +931,37 @@ func (m *GetPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Meta != nil {
+		{
+			size, err := m.Meta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -556,36 +1020,120 @@ func (m *GetPoolsRequestFilter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPools(uint64(l))
 	}
+	l = len(m.Where)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetPoolsRequest) Size() (n int) {
+func (m *RangeFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
+	l = len(m.Field)
+	if l > 0 {
 		n += 1 + l + sovPools(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovPools(uint64(l))
+	if m.Min != 0 {
+		n += 9
 	}
-	if m.Sort != nil {
-		l = m.Sort.Size()
-		n += 1 + l + sovPools(uint64(l))
+	if m.Max != 0 {
+		n += 9
 	}
-	return n
-}
-
+	if m.HasMin {
+		n += 2
+	}
+	if m.HasMax {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Sort != nil {
+		l = m.Sort.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPools(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPools(uint64(m.Type))
+	}
+	if m.ChainModel != nil {
+		l = m.ChainModel.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovPools(uint64(l))
+	l = m.LiquidityCap.Size()
+	n += 1 + l + sovPools(uint64(l))
+	l = len(m.LiquidityCapError)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Incentive != 0 {
+		n += 1 + sovPools(uint64(m.Incentive))
+	}
+	return n
+}
+
 func (m *GetPoolsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.Meta != nil {
+		l = m.Meta.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPools(uint64(m.Height))
+	}
 	return n
 }
 
@@ -992,6 +1540,216 @@ func (m *GetPoolsRequestFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.Search = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Where", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Where = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, &RangeFilter{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMin = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMax", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMax = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPools(dAtA[iNdEx:])
@@ -1171,7 +1929,7 @@ func (m *GetPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,12 +1952,378 @@ func (m *GetPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainModel == nil {
+				m.ChainModel = &types.Any{}
+			}
+			if err := m.ChainModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types1.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCapError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityCapError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+			}
+			m.Incentive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Incentive |= IncentiveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = &v1beta1.PaginationResponse{}
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPools(dAtA[iNdEx:])
@@ -35,7 +35,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("sqs/pools/v1beta1/query.proto", fileDescriptor_05711caafa6f54ad) }
 
 var fileDescriptor_05711caafa6f54ad = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
+	// 296 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xc4, 0x30,
 	0x10, 0xc6, 0x77, 0x85, 0x5d, 0x64, 0x0f, 0xc2, 0x16, 0x41, 0x28, 0x6b, 0x0e, 0xf5, 0x6c, 0x86,
 	0xea, 0x1b, 0x78, 0xf1, 0xe0, 0x45, 0x3d, 0x7a, 0x32, 0xa9, 0x31, 0x06, 0xdb, 0x4c, 0xdb, 0x49,
@@ -53,8 +53,8 @@ var fileDescriptor_05711caafa6f54ad = []byte{
 	0x52, 0xc9, 0xce, 0xe3, 0xeb, 0xc7, 0xf3, 0xd6, 0x76, 0x34, 0x1d, 0x24, 0x9f, 0x9c, 0xbd, 0xb4,
 	0x6c, 0xbc, 0x6a, 0xd9, 0xf8, 0xbd, 0x65, 0xe3, 0xa7, 0x8e, 0x8d, 0x56, 0x1d, 0x1b, 0xbd, 0x75,
 	0x6c, 0x74, 0x95, 0x6a, 0xe3, 0xee, 0x1a, 0xc9, 0x33, 0x2c, 0xc0, 0x0f, 0xc2, 0xd0, 0x61, 0x2e,
-	0x24, 0x81, 0x77, 0x7f, 0xaf, 0xfd, 0xf7, 0xfc, 0xf2, 0x2f, 0xa7, 0x5e, 0xfe, 0xf1, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x36, 0x80, 0xad, 0x0d, 0xf3, 0x01, 0x00, 0x00,
+	0x24, 0x81, 0x77, 0x7f, 0xaf, 0xfd, 0xf7, 0xfc, 0xf2, 0x2f, 0xa7, 0x5e, 0xfe, 0xf1, 0xe7, 0x00,
+	0x36, 0x80, 0xad, 0x0d, 0xf3, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,2537 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sqs/router/v1beta1/query.proto
+
+package router
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetOptimalQuoteRequest is the request type for the Query.GetOptimalQuote
+// RPC method.
+// For the exact amount in swap method, token_in and token_out_denom are
+// required. For the exact amount out swap method, token_out and token_in_denom
+// are required.
+type GetOptimalQuoteRequest struct {
+	// token_in is the input token for the exact amount in swap method.
+	TokenIn *types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	// token_out_denom is the output denom for the exact amount in swap method.
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	// token_out is the output token for the exact amount out swap method.
+	TokenOut *types.Coin `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	// token_in_denom is the input denom for the exact amount out swap method.
+	TokenInDenom string `protobuf:"bytes,4,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty"`
+	// single_route disables split routes if true.
+	SingleRoute bool `protobuf:"varint,5,opt,name=single_route,json=singleRoute,proto3" json:"single_route,omitempty"`
+	// human_denoms indicates whether the given denoms are human readable.
+	HumanDenoms bool `protobuf:"varint,6,opt,name=human_denoms,json=humanDenoms,proto3" json:"human_denoms,omitempty"`
+	// apply_exponents indicates whether to apply exponents to the spot price.
+	ApplyExponents bool `protobuf:"varint,7,opt,name=apply_exponents,json=applyExponents,proto3" json:"apply_exponents,omitempty"`
+}
+
+func (m *GetOptimalQuoteRequest) Reset()         { *m = GetOptimalQuoteRequest{} }
+func (m *GetOptimalQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOptimalQuoteRequest) ProtoMessage()    {}
+func (*GetOptimalQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017b6c1ece743d3e, []int{0}
+}
+func (m *GetOptimalQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOptimalQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOptimalQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOptimalQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOptimalQuoteRequest.Merge(m, src)
+}
+func (m *GetOptimalQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOptimalQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOptimalQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOptimalQuoteRequest proto.InternalMessageInfo
+
+func (m *GetOptimalQuoteRequest) GetTokenIn() *types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return nil
+}
+
+func (m *GetOptimalQuoteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *GetOptimalQuoteRequest) GetTokenOut() *types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return nil
+}
+
+func (m *GetOptimalQuoteRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *GetOptimalQuoteRequest) GetSingleRoute() bool {
+	if m != nil {
+		return m.SingleRoute
+	}
+	return false
+}
+
+func (m *GetOptimalQuoteRequest) GetHumanDenoms() bool {
+	if m != nil {
+		return m.HumanDenoms
+	}
+	return false
+}
+
+func (m *GetOptimalQuoteRequest) GetApplyExponents() bool {
+	if m != nil {
+		return m.ApplyExponents
+	}
+	return false
+}
+
+// GetCustomDirectQuoteRequest is the request type for the
+// Query.GetCustomDirectQuote RPC method.
+// For the exact amount in swap method, token_in and token_out_denom are
+// required. For the exact amount out swap method, token_out and token_in_denom
+// are required. There must be one denom per pool id.
+type GetCustomDirectQuoteRequest struct {
+	// token_in is the input token for the exact amount in swap method.
+	TokenIn *types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	// token_out_denom are the output denoms of each pool for the exact amount in
+	// swap method.
+	TokenOutDenom []string `protobuf:"bytes,2,rep,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	// token_out is the output token for the exact amount out swap method.
+	TokenOut *types.Coin `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	// token_in_denom are the input denoms of each pool for the exact amount out
+	// swap method.
+	TokenInDenom []string `protobuf:"bytes,4,rep,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty"`
+	// pool_id are the ids of the pools to swap over.
+	PoolId []uint64 `protobuf:"varint,5,rep,packed,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// human_denoms indicates whether the given denoms are human readable.
+	HumanDenoms bool `protobuf:"varint,6,opt,name=human_denoms,json=humanDenoms,proto3" json:"human_denoms,omitempty"`
+	// apply_exponents indicates whether to apply exponents to the spot price.
+	ApplyExponents bool `protobuf:"varint,7,opt,name=apply_exponents,json=applyExponents,proto3" json:"apply_exponents,omitempty"`
+}
+
+func (m *GetCustomDirectQuoteRequest) Reset()         { *m = GetCustomDirectQuoteRequest{} }
+func (m *GetCustomDirectQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCustomDirectQuoteRequest) ProtoMessage()    {}
+func (*GetCustomDirectQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017b6c1ece743d3e, []int{1}
+}
+func (m *GetCustomDirectQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCustomDirectQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCustomDirectQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCustomDirectQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCustomDirectQuoteRequest.Merge(m, src)
+}
+func (m *GetCustomDirectQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCustomDirectQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCustomDirectQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCustomDirectQuoteRequest proto.InternalMessageInfo
+
+func (m *GetCustomDirectQuoteRequest) GetTokenIn() *types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return nil
+}
+
+func (m *GetCustomDirectQuoteRequest) GetTokenOutDenom() []string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return nil
+}
+
+func (m *GetCustomDirectQuoteRequest) GetTokenOut() *types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return nil
+}
+
+func (m *GetCustomDirectQuoteRequest) GetTokenInDenom() []string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return nil
+}
+
+func (m *GetCustomDirectQuoteRequest) GetPoolId() []uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return nil
+}
+
+func (m *GetCustomDirectQuoteRequest) GetHumanDenoms() bool {
+	if m != nil {
+		return m.HumanDenoms
+	}
+	return false
+}
+
+func (m *GetCustomDirectQuoteRequest) GetApplyExponents() bool {
+	if m != nil {
+		return m.ApplyExponents
+	}
+	return false
+}
+
+// RoutePool is a pool within a quote route.
+type RoutePool struct {
+	// id is the pool id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the pool type as defined by the poolmanager module.
+	Type uint64 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// spread_factor is the pool spread factor.
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor"`
+	// token_in_denom is the denom swapped into the pool.
+	// Only set for the exact amount out swap method.
+	TokenInDenom string `protobuf:"bytes,4,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty"`
+	// token_out_denom is the denom swapped out of the pool.
+	// Only set for the exact amount in swap method.
+	TokenOutDenom string `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	// taker_fee is the taker fee charged by the pool.
+	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee"`
+	// code_id is the code id of the pool if it is a cosmwasm pool.
+	CodeId uint64 `protobuf:"varint,7,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *RoutePool) Reset()         { *m = RoutePool{} }
+func (m *RoutePool) String() string { return proto.CompactTextString(m) }
+func (*RoutePool) ProtoMessage()    {}
+func (*RoutePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017b6c1ece743d3e, []int{2}
+}
+func (m *RoutePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutePool.Merge(m, src)
+}
+func (m *RoutePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutePool proto.InternalMessageInfo
+
+func (m *RoutePool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoutePool) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *RoutePool) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *RoutePool) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *RoutePool) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+// Route is a single route of a quote.
+type Route struct {
+	// pools are the pools in the route.
+	Pools []RoutePool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	// in_amount is the amount swapped into the route.
+	InAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=in_amount,json=inAmount,proto3,customtype=cosmossdk.io/math.Int" json:"in_amount"`
+	// out_amount is the amount swapped out of the route.
+	OutAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=out_amount,json=outAmount,proto3,customtype=cosmossdk.io/math.Int" json:"out_amount"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017b6c1ece743d3e, []int{3}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return m.Size()
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetPools() []RoutePool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// GetQuoteResponse is the response type for the quote RPC methods.
+type GetQuoteResponse struct {
+	// amount_in is the token swapped in.
+	AmountIn types.Coin `protobuf:"bytes,1,opt,name=amount_in,json=amountIn,proto3" json:"amount_in"`
+	// amount_out is the token swapped out.
+	AmountOut types.Coin `protobuf:"bytes,2,opt,name=amount_out,json=amountOut,proto3" json:"amount_out"`
+	// route are the split routes of the quote.
+	Route []Route `protobuf:"bytes,3,rep,name=route,proto3" json:"route"`
+	// effective_fee is the effective spread factor across all routes.
+	EffectiveFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=effective_fee,json=effectiveFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_fee"`
+	// price_impact is the price impact of the swap.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact"`
+	// in_base_out_quote_spot_price is the spot price with the token in as base
+	// and the token out as quote.
+	InBaseOutQuoteSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=in_base_out_quote_spot_price,json=inBaseOutQuoteSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"in_base_out_quote_spot_price"`
+	// height is the height of the state the quote was computed at.
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetQuoteResponse) Reset()         { *m = GetQuoteResponse{} }
+func (m *GetQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuoteResponse) ProtoMessage()    {}
+func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017b6c1ece743d3e, []int{4}
+}
+func (m *GetQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuoteResponse.Merge(m, src)
+}
+func (m *GetQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuoteResponse proto.InternalMessageInfo
+
+func (m *GetQuoteResponse) GetAmountIn() types.Coin {
+	if m != nil {
+		return m.AmountIn
+	}
+	return types.Coin{}
+}
+
+func (m *GetQuoteResponse) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
+	}
+	return types.Coin{}
+}
+
+func (m *GetQuoteResponse) GetRoute() []Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *GetQuoteResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetOptimalQuoteRequest)(nil), "sqs.router.v1beta1.GetOptimalQuoteRequest")
+	proto.RegisterType((*GetCustomDirectQuoteRequest)(nil), "sqs.router.v1beta1.GetCustomDirectQuoteRequest")
+	proto.RegisterType((*RoutePool)(nil), "sqs.router.v1beta1.RoutePool")
+	proto.RegisterType((*Route)(nil), "sqs.router.v1beta1.Route")
+	proto.RegisterType((*GetQuoteResponse)(nil), "sqs.router.v1beta1.GetQuoteResponse")
+}
+
+func init() { proto.RegisterFile("sqs/router/v1beta1/query.proto", fileDescriptor_017b6c1ece743d3e) }
+
+var fileDescriptor_017b6c1ece743d3e = []byte{
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xf6, 0xec, 0xcb, 0xbb, 0xed, 0x17, 0x6a, 0xe5, 0x31, 0xb1, 0xe3, 0xcd, 0xb2, 0x89, 0x60,
+	0x85, 0xe4, 0x19, 0xc5, 0x3c, 0x24, 0x50, 0x84, 0x60, 0x63, 0x6c, 0x56, 0x8a, 0xe4, 0x64, 0xb8,
+	0x71, 0x19, 0xf5, 0xce, 0x94, 0x67, 0x5b, 0xde, 0xe9, 0x9e, 0x9d, 0xee, 0x89, 0x30, 0x47, 0x7e,
+	0x01, 0x82, 0x0b, 0x67, 0x7e, 0x07, 0xe2, 0xc2, 0x25, 0xc7, 0x48, 0x08, 0x09, 0x71, 0x88, 0x90,
+	0xcd, 0x0f, 0x41, 0x5d, 0x3d, 0x63, 0x09, 0xbc, 0x2b, 0x36, 0x12, 0xbe, 0x4d, 0x57, 0xd7, 0xf7,
+	0x75, 0xd5, 0x57, 0xd5, 0x5d, 0x43, 0xba, 0x6a, 0xa6, 0xfc, 0x5c, 0x16, 0x1a, 0x72, 0xff, 0xf9,
+	0xc3, 0x31, 0x68, 0xf6, 0xd0, 0x9f, 0x15, 0x90, 0x9f, 0x79, 0x59, 0x2e, 0xb5, 0xa4, 0x54, 0xcd,
+	0x94, 0x67, 0xf7, 0xbd, 0x72, 0x7f, 0xfb, 0x6e, 0x22, 0x65, 0x32, 0x05, 0x9f, 0x65, 0xdc, 0x67,
+	0x42, 0x48, 0xcd, 0x34, 0x97, 0x42, 0x59, 0xc4, 0xf6, 0x8d, 0x44, 0x26, 0x12, 0x3f, 0x7d, 0xf3,
+	0x55, 0x5a, 0xbb, 0x91, 0x54, 0xa9, 0x54, 0xfe, 0x98, 0x29, 0xb8, 0x3c, 0x28, 0x92, 0x5c, 0xd8,
+	0xfd, 0xfe, 0x2f, 0x35, 0x72, 0xeb, 0x08, 0xf4, 0x71, 0xa6, 0x79, 0xca, 0xa6, 0xcf, 0x0a, 0xa9,
+	0x21, 0x80, 0x59, 0x01, 0x4a, 0xd3, 0xf7, 0x48, 0x5b, 0xcb, 0x53, 0x10, 0x21, 0x17, 0xae, 0xd3,
+	0x73, 0x06, 0x6b, 0xfb, 0x77, 0x3c, 0xcb, 0xe6, 0x19, 0xb6, 0x2a, 0x2c, 0xef, 0xb1, 0xe4, 0x22,
+	0x58, 0x45, 0xd7, 0x91, 0xa0, 0x6f, 0x91, 0x2d, 0x8b, 0x92, 0x85, 0x0e, 0x63, 0x10, 0x32, 0x75,
+	0x6b, 0x3d, 0x67, 0xd0, 0x09, 0x36, 0xd0, 0x7c, 0x5c, 0xe8, 0x03, 0x63, 0xa4, 0x1f, 0x90, 0xce,
+	0xa5, 0x9f, 0x5b, 0xff, 0x2f, 0xfa, 0x76, 0x05, 0xa6, 0x0f, 0xc8, 0x66, 0x15, 0x55, 0x49, 0xdf,
+	0x40, 0xfa, 0xf5, 0x32, 0x00, 0xcb, 0xfe, 0x26, 0x59, 0x57, 0x5c, 0x24, 0x53, 0x08, 0x51, 0x43,
+	0xb7, 0xd9, 0x73, 0x06, 0xed, 0x60, 0xcd, 0xda, 0x02, 0x63, 0x32, 0x2e, 0x93, 0x22, 0x65, 0x25,
+	0x8b, 0x72, 0x5b, 0xd6, 0x05, 0x6d, 0x48, 0xa2, 0xe8, 0xdb, 0x64, 0x8b, 0x65, 0xd9, 0xf4, 0x2c,
+	0x84, 0xaf, 0x32, 0x29, 0x40, 0x68, 0xe5, 0xae, 0xa2, 0xd7, 0x26, 0x9a, 0x3f, 0xab, 0xac, 0xfd,
+	0x9f, 0x6b, 0x64, 0xe7, 0x08, 0xf4, 0xe3, 0x42, 0x69, 0x99, 0x1e, 0xf0, 0x1c, 0x22, 0x7d, 0x5d,
+	0x52, 0xd6, 0xaf, 0x57, 0xca, 0xfa, 0x15, 0x29, 0x6f, 0x93, 0xd5, 0x4c, 0xca, 0x69, 0xc8, 0x63,
+	0xb7, 0xd9, 0xab, 0x0f, 0x1a, 0x41, 0xcb, 0x2c, 0x47, 0xf1, 0xff, 0x2a, 0xe0, 0x8f, 0x35, 0xd2,
+	0xc1, 0xb2, 0x3c, 0x95, 0x72, 0x4a, 0x37, 0x49, 0x8d, 0xc7, 0x28, 0x54, 0x23, 0xa8, 0xf1, 0x98,
+	0x52, 0xd2, 0xd0, 0x67, 0x19, 0x60, 0x23, 0x35, 0x02, 0xfc, 0xa6, 0x9f, 0x93, 0x0d, 0x95, 0xe5,
+	0xc0, 0xe2, 0xf0, 0x84, 0x45, 0x5a, 0xe6, 0x98, 0x78, 0x67, 0x78, 0xff, 0xc5, 0xab, 0x7b, 0x2b,
+	0x7f, 0xbc, 0xba, 0xb7, 0x63, 0xf3, 0x57, 0xf1, 0xa9, 0xc7, 0xa5, 0x9f, 0x32, 0x3d, 0xf1, 0x9e,
+	0x40, 0xc2, 0xa2, 0xb3, 0x03, 0x88, 0x82, 0x75, 0x8b, 0x3c, 0x44, 0xe0, 0x92, 0x1d, 0x35, 0xa7,
+	0x18, 0xcd, 0x79, 0x7d, 0xfd, 0x09, 0xe9, 0x68, 0x76, 0x0a, 0x79, 0x78, 0x02, 0xe0, 0xb6, 0x96,
+	0x8f, 0xa9, 0x8d, 0xa8, 0x43, 0x00, 0x23, 0x78, 0x24, 0x63, 0x30, 0x82, 0xaf, 0x62, 0xc2, 0x2d,
+	0xb3, 0x1c, 0xc5, 0xfd, 0x9f, 0x1c, 0xd2, 0xb4, 0xbd, 0xfb, 0x21, 0x69, 0x9a, 0x22, 0x28, 0xd7,
+	0xe9, 0xd5, 0x07, 0x6b, 0xfb, 0xbb, 0xde, 0xd5, 0xd7, 0xc2, 0xbb, 0x94, 0x73, 0xd8, 0x30, 0xe7,
+	0x07, 0x16, 0x41, 0x3f, 0x22, 0x1d, 0x2e, 0x42, 0x96, 0xca, 0x42, 0x68, 0x7b, 0x33, 0x87, 0xbb,
+	0x65, 0x7c, 0x37, 0xaf, 0xc6, 0x37, 0x12, 0x3a, 0x68, 0x73, 0xf1, 0x29, 0xba, 0xd3, 0x47, 0x84,
+	0x98, 0xec, 0x4b, 0x70, 0x7d, 0x19, 0x70, 0x47, 0x16, 0xda, 0xa2, 0xfb, 0xbf, 0xd5, 0xc9, 0x1b,
+	0x47, 0x50, 0x5d, 0x0c, 0x95, 0x49, 0xa1, 0x80, 0x3e, 0x22, 0x1d, 0x4b, 0xb7, 0xcc, 0xd5, 0x28,
+	0x33, 0x69, 0x5b, 0xc4, 0x48, 0xd0, 0x8f, 0x09, 0x29, 0xd1, 0xb2, 0xb0, 0xd9, 0x2c, 0x01, 0x2f,
+	0x0f, 0x34, 0x37, 0xe0, 0x7d, 0xd2, 0xb4, 0xef, 0x43, 0x1d, 0x75, 0xbc, 0xb3, 0x50, 0xc7, 0x4a,
+	0x43, 0xdc, 0x33, 0xbd, 0x07, 0x27, 0x27, 0x10, 0x69, 0xfe, 0x1c, 0xb0, 0xce, 0x8d, 0xd7, 0xe8,
+	0xbd, 0x4b, 0xa4, 0xa9, 0xf5, 0x21, 0x59, 0xcf, 0x72, 0x1e, 0x41, 0xc8, 0xd3, 0x8c, 0x45, 0xda,
+	0x6d, 0x2e, 0x4f, 0xb4, 0x86, 0xc0, 0x11, 0xe2, 0xe8, 0x98, 0xdc, 0xe5, 0x22, 0x34, 0x19, 0x63,
+	0x7f, 0xce, 0x8c, 0xc6, 0xa1, 0xca, 0xa4, 0x0e, 0xd1, 0xeb, 0x75, 0x1a, 0xf1, 0x36, 0x17, 0x43,
+	0xa6, 0xe0, 0xb8, 0xb0, 0x95, 0xfa, 0x22, 0x93, 0xfa, 0xa9, 0xe1, 0xa0, 0xb7, 0x48, 0x6b, 0x02,
+	0x3c, 0x99, 0xe8, 0xaa, 0x2d, 0xed, 0x6a, 0xff, 0x87, 0x1a, 0x69, 0x3e, 0x33, 0xa3, 0x8b, 0x7e,
+	0x4d, 0xb6, 0xfe, 0x35, 0x4b, 0xe8, 0x3b, 0xf3, 0x24, 0x9d, 0x3f, 0x70, 0xb6, 0x1f, 0x2c, 0xf0,
+	0xfd, 0x47, 0xc7, 0xf4, 0x6f, 0x7e, 0xf3, 0xeb, 0x5f, 0xdf, 0xd7, 0xb6, 0xe8, 0x46, 0x35, 0x3e,
+	0x31, 0x59, 0xfa, 0x9d, 0x43, 0x6e, 0xcc, 0x7b, 0x82, 0xa9, 0xbf, 0x80, 0x75, 0xd1, 0x63, 0xbd,
+	0x64, 0x18, 0xf7, 0x31, 0x8c, 0x5d, 0xba, 0x53, 0x85, 0x11, 0x21, 0xdf, 0x5e, 0x8c, 0x84, 0x7b,
+	0x18, 0xd4, 0xf0, 0xc9, 0x8b, 0xf3, 0xae, 0xf3, 0xf2, 0xbc, 0xeb, 0xfc, 0x79, 0xde, 0x75, 0xbe,
+	0xbd, 0xe8, 0xae, 0xbc, 0xbc, 0xe8, 0xae, 0xfc, 0x7e, 0xd1, 0x5d, 0xf9, 0x72, 0x3f, 0xe1, 0x7a,
+	0x52, 0x8c, 0xbd, 0x48, 0xa6, 0x3e, 0x16, 0x83, 0xab, 0xbd, 0x29, 0x1b, 0x2b, 0xdf, 0xfc, 0x17,
+	0x64, 0xa7, 0x09, 0x0e, 0xf9, 0x6a, 0x5e, 0xdb, 0x13, 0xc6, 0x2d, 0x1c, 0xd9, 0xef, 0xfe, 0x3d,
+	0x00, 0xaf, 0x3f, 0x91, 0xba, 0x3c, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetOptimalQuote returns the best quote for the given tokens, searching
+	// over all candidate routes. See GetOptimalQuoteRequest for possible query
+	// parameters.
+	GetOptimalQuote(ctx context.Context, in *GetOptimalQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
+	// GetCustomDirectQuote returns the quote over the given pools without
+	// searching for routes. See GetCustomDirectQuoteRequest for possible query
+	// parameters.
+	GetCustomDirectQuote(ctx context.Context, in *GetCustomDirectQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetOptimalQuote(ctx context.Context, in *GetOptimalQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, "/sqs.router.v1beta1.Query/GetOptimalQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCustomDirectQuote(ctx context.Context, in *GetCustomDirectQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, "/sqs.router.v1beta1.Query/GetCustomDirectQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetOptimalQuote returns the best quote for the given tokens, searching
+	// over all candidate routes. See GetOptimalQuoteRequest for possible query
+	// parameters.
+	GetOptimalQuote(context.Context, *GetOptimalQuoteRequest) (*GetQuoteResponse, error)
+	// GetCustomDirectQuote returns the quote over the given pools without
+	// searching for routes. See GetCustomDirectQuoteRequest for possible query
+	// parameters.
+	GetCustomDirectQuote(context.Context, *GetCustomDirectQuoteRequest) (*GetQuoteResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetOptimalQuote(ctx context.Context, req *GetOptimalQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptimalQuote not implemented")
+}
+func (*UnimplementedQueryServer) GetCustomDirectQuote(ctx context.Context, req *GetCustomDirectQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomDirectQuote not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetOptimalQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptimalQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOptimalQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sqs.router.v1beta1.Query/GetOptimalQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOptimalQuote(ctx, req.(*GetOptimalQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCustomDirectQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomDirectQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCustomDirectQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sqs.router.v1beta1.Query/GetCustomDirectQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCustomDirectQuote(ctx, req.(*GetCustomDirectQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sqs.router.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOptimalQuote",
+			Handler:    _Query_GetOptimalQuote_Handler,
+		},
+		{
+			MethodName: "GetCustomDirectQuote",
+			Handler:    _Query_GetCustomDirectQuote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sqs/router/v1beta1/query.proto",
+}
+
+func (m *GetOptimalQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOptimalQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOptimalQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyExponents {
+		i--
+		if m.ApplyExponents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HumanDenoms {
+		i--
+		if m.HumanDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SingleRoute {
+		i--
+		if m.SingleRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TokenOut != nil {
+		{
+			size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TokenIn != nil {
+		{
+			size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCustomDirectQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCustomDirectQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCustomDirectQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyExponents {
+		i--
+		if m.ApplyExponents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HumanDenoms {
+		i--
+		if m.HumanDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PoolId) > 0 {
+		dAtA4 := make([]byte, len(m.PoolId)*10)
+		var j3 int
+		for _, num := range m.PoolId {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenInDenom) > 0 {
+		for iNdEx := len(m.TokenInDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenInDenom[iNdEx])
+			copy(dAtA[i:], m.TokenInDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TokenOut != nil {
+		{
+			size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenOutDenom) > 0 {
+		for iNdEx := len(m.TokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenOutDenom[iNdEx])
+			copy(dAtA[i:], m.TokenOutDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TokenIn != nil {
+		{
+			size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OutAmount.Size()
+		i -= size
+		if _, err := m.OutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InAmount.Size()
+		i -= size
+		if _, err := m.InAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.InBaseOutQuoteSpotPrice.Size()
+		i -= size
+		if _, err := m.InBaseOutQuoteSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EffectiveFee.Size()
+		i -= size
+		if _, err := m.EffectiveFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetOptimalQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenIn != nil {
+		l = m.TokenIn.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TokenOut != nil {
+		l = m.TokenOut.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SingleRoute {
+		n += 2
+	}
+	if m.HumanDenoms {
+		n += 2
+	}
+	if m.ApplyExponents {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetCustomDirectQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenIn != nil {
+		l = m.TokenIn.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TokenOutDenom) > 0 {
+		for _, s := range m.TokenOutDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TokenOut != nil {
+		l = m.TokenOut.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TokenInDenom) > 0 {
+		for _, s := range m.TokenInDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PoolId) > 0 {
+		l = 0
+		for _, e := range m.PoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.HumanDenoms {
+		n += 2
+	}
+	if m.ApplyExponents {
+		n += 2
+	}
+	return n
+}
+
+func (m *RoutePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.InAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.EffectiveFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InBaseOutQuoteSpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetOptimalQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOptimalQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOptimalQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenIn == nil {
+				m.TokenIn = &types.Coin{}
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenOut == nil {
+				m.TokenOut = &types.Coin{}
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleRoute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleRoute = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HumanDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HumanDenoms = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyExponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyExponents = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCustomDirectQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCustomDirectQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCustomDirectQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenIn == nil {
+				m.TokenIn = &types.Coin{}
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = append(m.TokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenOut == nil {
+				m.TokenOut = &types.Coin{}
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = append(m.TokenInDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolId = append(m.PoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolId) == 0 {
+					m.PoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolId = append(m.PoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HumanDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HumanDenoms = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyExponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyExponents = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, RoutePool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, Route{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBaseOutQuoteSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InBaseOutQuoteSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/sqs/delivery/grpc/server"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/pools"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// PoolsGRPCHandler represents the GRPC handler for the pools queries.
+type PoolsGRPCHandler struct {
+	PUsecase mvc.PoolsUsecase
+
+	api.UnimplementedQueryServer
+}
+
+var _ api.QueryServer = &PoolsGRPCHandler{}
+
+// NewPoolsGRPCHandler will register the pools query service on the given GRPC server.
+func NewPoolsGRPCHandler(s *grpc.Server, us mvc.PoolsUsecase) {
+	api.RegisterQueryServer(s, &PoolsGRPCHandler{
+		PUsecase: us,
+	})
+}
+
+// Pools implements api.QueryServer.
+// It mirrors the GET /pools endpoint.
+func (a *PoolsGRPCHandler) Pools(ctx context.Context, req *api.GetPoolsRequest) (*api.GetPoolsResponse, error) {
+	if req.Pagination != nil {
+		if err := req.Pagination.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	filters := []domain.PoolsOption{
+		domain.WithFilter(req.Filter),
+		domain.WithPagination(req.Pagination),
+		domain.WithSort(req.Sort),
+	}
+
+	// Read pools from the state snapshot pinned for the request, if any.
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		filters = append(filters, domain.WithStateSnapshot(snapshot))
+	}
+
+	pools, total, err := a.PUsecase.GetPools(filters...)
+	if err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	resultPools, err := convertPoolsToResponse(pools)
+	if err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	return &api.GetPoolsResponse{
+		Pools:  resultPools,
+		Meta:   v1beta1.NewPaginationResponse(req.Pagination, total),
+		Height: domain.GetHeightFromContext(ctx),
+	}, nil
+}
+
+// convertPoolsToResponse converts the given pools to the GRPC response format.
+func convertPoolsToResponse(pools []sqsdomain.PoolI) ([]api.Pool, error) {
+	resultPools := make([]api.Pool, 0, len(pools))
+	for _, pool := range pools {
+		resultPool, err := convertPoolToResponse(pool)
+		if err != nil {
+			return nil, err
+		}
+		resultPools = append(resultPools, resultPool)
+	}
+	return resultPools, nil
+}
+
+// convertPoolToResponse converts the given pool to the GRPC response format.
+func convertPoolToResponse(pool sqsdomain.PoolI) (api.Pool, error) {
+	chainModel, err := codectypes.NewAnyWithValue(pool.GetUnderlyingPool())
+	if err != nil {
+		return api.Pool{}, err
+	}
+
+	return api.Pool{
+		Id:                pool.GetId(),
+		Type:              uint64(pool.GetType()),
+		ChainModel:        chainModel,
+		Balances:          pool.GetSQSPoolModel().Balances,
+		SpreadFactor:      pool.GetSQSPoolModel().SpreadFactor,
+		LiquidityCap:      pool.GetLiquidityCap(),
+		LiquidityCapError: pool.GetLiquidityCapError(),
+		Incentive:         pool.Incentive(),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	deliveryhttp "github.com/osmosis-labs/sqs/delivery/http"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/number"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+// PoolChangesHandler represent the httphandler for the pool change stream
+type PoolChangesHandler struct {
+	PCUsecase mvc.PoolChangeStreamUsecase
+	Logger    log.Logger
+}
+
+const (
+	// PoolChangesRoute is the route of the pool change stream.
+	PoolChangesRoute = resourcePrefix + "/changes"
+
+	// lastEventIDHeader is the header sent by the SSE clients on reconnect
+	// with the ID of the last received event.
+	lastEventIDHeader = "Last-Event-ID"
+
+	// poolChangesKeepAliveInterval is the interval at which a comment is sent
+	// to keep the idle connections from timing out.
+	poolChangesKeepAliveInterval = 15 * time.Second
+)
+
+// NewPoolChangesHandler will initialize the pools/changes resource endpoint
+func NewPoolChangesHandler(e *echo.Echo, us mvc.PoolChangeStreamUsecase, logger log.Logger) {
+	handler := &PoolChangesHandler{
+		PCUsecase: us,
+		Logger:    logger,
+	}
+
+	e.GET(PoolChangesRoute, handler.GetPoolChangesStream)
+}
+
+// @Summary Stream the pool changes
+// @Description Streams the pools updated within each ingested block as Server-Sent Events.
+// @Description Each event contains the block height and the balances, liquidity cap and spot price of the updated pools.
+// @Description The event ID is the block height. Clients can resume from a height within the retained window
+// @Description either with the fromHeight parameter or with the Last-Event-ID header, resuming after the given event.
+// @Description Clients falling behind are disconnected and have to resume.
+// @Description The events are pushed in height order. If the changes of some blocks are unavailable, for example because
+// @Description the blocks failed to be processed, a gap event with no pools is pushed instead with the range of the missed heights.
+// @ID get-pool-changes-stream
+// @Produce  text/event-stream
+// @Param  filter[id]  query  string  false  "Comma-separated list of pool IDs to stream the changes of, e.g., '1,2,3'"
+// @Param  filter[denom]  query  string  false  "Comma-separated list of denoms to stream the changes of the pools containing any of them"
+// @Param  fromHeight  query  int  false  "Height to resume the stream from"
+// @Success 200  {object}  domain.PoolChangesEvent  "Pool changes of a block"
+// @Failure 410  {object}  ResponseError  "The height to resume from is not retained"
+// @Router /pools/changes [get]
+func (a *PoolChangesHandler) GetPoolChangesStream(c echo.Context) error {
+	poolIDs, err := number.ParseNumbers(c.QueryParam("filter[id]"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: fmt.Sprintf("invalid pool IDs: %s", err)})
+	}
+
+	var denoms []string
+	for _, denom := range strings.Split(c.QueryParam("filter[denom]"), ",") {
+		if denom = strings.TrimSpace(denom); denom != "" {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	fromHeight, err := getPoolChangesFromHeight(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	ctx := c.Request().Context()
+
+	events, err := a.PCUsecase.Subscribe(ctx, domain.PoolChangesFilter{PoolIDs: poolIDs, Denoms: denoms}, fromHeight)
+	if err != nil {
+		if errors.As(err, &domain.PoolChangesHeightNotRetainedError{}) {
+			return c.JSON(http.StatusGone, ResponseError{Message: err.Error()})
+		}
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	keepAlive := time.NewTicker(poolChangesKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if err := deliveryhttp.WriteComment(w, "keep-alive"); err != nil {
+				return nil
+			}
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if err := deliveryhttp.WriteEventWithID(w, strconv.FormatUint(event.Height, 10), event); err != nil {
+				a.Logger.Error("GET "+c.Request().URL.String(), zap.Error(err))
+				return nil
+			}
+		}
+	}
+}
+
+// getPoolChangesFromHeight returns the height to resume the pool changes stream from.
+// The Last-Event-ID header takes precedence over the fromHeight parameter
+// so that the clients reconnecting automatically resume after the last received event.
+// Returns zero if neither is present.
+func getPoolChangesFromHeight(c echo.Context) (uint64, error) {
+	if lastEventID := c.Request().Header.Get(lastEventIDHeader); lastEventID != "" {
+		lastHeight, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s header: %w", lastEventIDHeader, err)
+		}
+		return lastHeight + 1, nil
+	}
+
+	return parseOptionalUintQueryParam(c, "fromHeight")
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
+
+// PoolHistoryHandler represent the httphandler for the pool history
+type PoolHistoryHandler struct {
+	PHUsecase mvc.PoolHistoryUsecase
+}
+
+// PoolHistoryResponse is a structure for serializing the pool history returned to clients.
+type PoolHistoryResponse struct {
+	PoolID  uint64                     `json:"pool_id"`
+	Samples []domain.PoolHistorySample `json:"samples"`
+}
+
+// NewPoolHistoryHandler will initialize the pools/:id/history resource endpoint
+func NewPoolHistoryHandler(e *echo.Echo, us mvc.PoolHistoryUsecase) {
+	handler := &PoolHistoryHandler{
+		PHUsecase: us,
+	}
+
+	e.GET(formatPoolsResource("/:id/history"), handler.GetPoolHistory)
+}
+
+// @Summary Get the history of a pool
+// @Description Returns the liquidity cap, balances, spot price and, for concentrated pools, the current tick
+// @Description of the pool sampled at the end of the ingested blocks, ordered from the oldest to the newest sample.
+// @Description Only the most recent samples are kept. The spot price is the price of the first pool denom
+// @Description in terms of the second pool denom and is zero if it could not be computed.
+// @ID get-pool-history
+// @Produce  json
+// @Param  id  path  int  true  "Pool ID"
+// @Param  fromHeight  query  int  false  "Inclusive lower bound of the sample heights"
+// @Param  toHeight  query  int  false  "Inclusive upper bound of the sample heights"
+// @Param  maxPoints  query  int  false  "Maximum number of samples to return. If exceeded, the latest sample of each equally-sized height bucket is returned"
+// @Success 200  {object}  PoolHistoryResponse  "History of the pool"
+// @Router /pools/{id}/history [get]
+func (a *PoolHistoryHandler) GetPoolHistory(c echo.Context) error {
+	idStr := c.Param("id")
+	poolID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	fromHeight, err := parseOptionalUintQueryParam(c, "fromHeight")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	toHeight, err := parseOptionalUintQueryParam(c, "toHeight")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	if toHeight > 0 && fromHeight > toHeight {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "fromHeight must be less than or equal to toHeight"})
+	}
+
+	maxPoints, err := parseOptionalUintQueryParam(c, "maxPoints")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	samples, err := a.PHUsecase.GetPoolHistory(poolID, fromHeight, toHeight, int(maxPoints))
+	if err != nil {
+		if errors.As(err, &domain.PoolNotFoundError{}) {
+			return c.JSON(http.StatusNotFound, ResponseError{Message: err.Error()})
+		}
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, PoolHistoryResponse{
+		PoolID:  poolID,
+		Samples: samples,
+	})
+}
+
+// parseOptionalUintQueryParam parses the given query parameter as an unsigned integer.
+// Returns zero if the parameter is not present.
+func parseOptionalUintQueryParam(c echo.Context, name string) (uint64, error) {
+	valueStr := c.QueryParam(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return value, nil
+}
@@ -1,8 +1,11 @@
 package http
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/labstack/echo/v4"
@@ -50,6 +53,13 @@ type PoolResponse struct {
 type GetPoolsResponse struct {
 	Data []PoolResponse              `json:"data"`
 	Meta *v1beta1.PaginationResponse `json:"meta"`
+	// Height is the height of the state the pools were read at.
+	Height uint64 `json:"height,omitempty"`
+}
+
+// ExitPoolResponse is a structure for serializing the simulated pool exit returned to clients.
+type ExitPoolResponse struct {
+	TokensOut sdk.Coins `json:"tokens_out"`
 }
 
 const resourcePrefix = "/pools"
@@ -65,6 +75,10 @@ func NewPoolsHandler(e *echo.Echo, us mvc.PoolsUsecase) {
 	}
 
 	e.GET(formatPoolsResource("/ticks/:id"), handler.GetConcentratedPoolTicks)
+	e.GET(formatPoolsResource("/depth-chart/:id"), handler.GetConcentratedPoolDepthChart)
+	e.GET(formatPoolsResource("/position-simulation/:id"), handler.SimulateConcentratedPosition)
+	e.GET(formatPoolsResource("/join-simulation/:id"), handler.CalcJoinPool)
+	e.GET(formatPoolsResource("/exit-simulation/:id"), handler.CalcExitPool)
 	e.GET(formatPoolsResource("/canonical-orderbook"), handler.GetCanonicalOrderbook)
 	e.GET(formatPoolsResource("/canonical-orderbooks"), handler.GetCanonicalOrderbooks)
 	e.GET(formatPoolsResource(""), handler.GetPools)
@@ -78,6 +92,10 @@ func NewPoolsHandler(e *echo.Echo, us mvc.PoolsUsecase) {
 // @Param  IDs  query  string  false  "Comma-separated list of pool IDs to fetch, e.g., '1,2,3'"
 // @Param  min_liquidity_cap  query  int  false  "Minimum pool liquidity cap"
 // @Param  with_market_incentives  query  bool  false  "Include market incentives data in the pool response"
+// @Param  where  query  string  false  "Expression over pool attributes to filter pools by, e.g. 'totalFiatValueLocked > 1e6'"
+// @Param  filter[<field>][min]  query  number  false  "Inclusive lower bound of the numeric pool field, e.g. filter[market.volume24hUsd][min]=1000"
+// @Param  filter[<field>][max]  query  number  false  "Inclusive upper bound of the numeric pool field, e.g. filter[creationHeight][max]=1000000"
+// @Param  sort  query  string  false  "Comma-separated list of fields to sort by, prefixed with '-' for descending order, e.g. '-market.volume24hUsd'"
 // @Success 200  {array}  sqsdomain.PoolI  "List of pool(s) details"
 // @Router /pools [get]
 func (a *PoolsHandler) GetPools(c echo.Context) error {
@@ -96,6 +114,11 @@ func (a *PoolsHandler) GetPools(c echo.Context) error {
 		domain.WithSort(req.Sort),
 	}
 
+	// Read pools from the state snapshot pinned for the request, if any.
+	if snapshot, ok := domain.GetStateSnapshotFromContext(c.Request().Context()); ok {
+		filters = append(filters, domain.WithStateSnapshot(snapshot))
+	}
+
 	// Get pools
 	pools, total, err := a.PUsecase.GetPools(
 		filters...,
@@ -106,6 +129,7 @@ func (a *PoolsHandler) GetPools(c echo.Context) error {
 
 	// Convert pools to the appropriate format
 	resultPools := convertPoolsToResponse(&req, pools, total)
+	resultPools.Height = domain.GetHeightFromContext(c.Request().Context())
 
 	return c.JSON(http.StatusOK, resultPools)
 }
@@ -130,12 +154,198 @@ func (a *PoolsHandler) GetConcentratedPoolTicks(c echo.Context) error {
 	return c.JSON(http.StatusOK, tickModel)
 }
 
+// @Summary Get the depth chart of a concentrated pool
+// @Description Converts the tick model of the concentrated pool into price buckets with token amounts
+// @Description scaled by the token precisions. Each bucket shows the amounts of token0 and token1 available
+// @Description on each side of the current tick. Additionally, returns the cumulative depth within
+// @Description the given percentages of the current price.
+// @ID get-concentrated-pool-depth-chart
+// @Produce  json
+// @Param  id  path  int  true  "Concentrated pool ID"
+// @Param  depthPercents  query  string  false  "Comma-separated list of percentages from the current price to compute the cumulative depth for, e.g., '1,2,5'. Defaults to '1,2,5,10'"
+// @Success 200  {object}  domain.ConcentratedDepthChart  "Depth chart of the concentrated pool"
+// @Router /pools/depth-chart/{id} [get]
+func (a *PoolsHandler) GetConcentratedPoolDepthChart(c echo.Context) error {
+	idStr := c.Param("id")
+	poolID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	depthPercents, err := parseDepthPercents(c.QueryParam("depthPercents"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	depthChart, err := a.PUsecase.GetConcentratedPoolDepthChart(c.Request().Context(), poolID, depthPercents)
+	if err != nil {
+		if errors.As(err, &domain.PoolNotFoundError{}) {
+			return c.JSON(http.StatusNotFound, ResponseError{Message: err.Error()})
+		}
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, depthChart)
+}
+
+// @Summary Simulate a concentrated liquidity position
+// @Description Simulates creating a position in the given concentrated pool within the given price range
+// @Description by providing the given token. Returns the amount of the other token required, the liquidity units,
+// @Description whether the range is currently active and the estimated fee APR in percent.
+// @Description The fee APR is estimated from the fees of the last 7 days and the share of the active liquidity
+// @Description the position would hold. If it cannot be estimated, the fee_apr_error field is set.
+// @ID simulate-concentrated-position
+// @Produce  json
+// @Param  id  path  int  true  "Concentrated pool ID"
+// @Param  lowerPrice  query  string  true  "Lower chain price of token0 in terms of token1, rounded down to the tick spacing"
+// @Param  upperPrice  query  string  true  "Upper chain price of token0 in terms of token1, rounded up to the tick spacing"
+// @Param  tokenIn  query  string  true  "Amount of token0 or token1 to provide, e.g., '1000000uosmo'"
+// @Success 200  {object}  domain.ConcentratedPositionSimulation  "Simulated concentrated liquidity position"
+// @Router /pools/position-simulation/{id} [get]
+func (a *PoolsHandler) SimulateConcentratedPosition(c echo.Context) error {
+	idStr := c.Param("id")
+	poolID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	lowerPrice, err := osmomath.NewBigDecFromStr(c.QueryParam("lowerPrice"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: fmt.Sprintf("invalid lower price: %s", err)})
+	}
+
+	upperPrice, err := osmomath.NewBigDecFromStr(c.QueryParam("upperPrice"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: fmt.Sprintf("invalid upper price: %s", err)})
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(c.QueryParam("tokenIn"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: fmt.Sprintf("invalid token in: %s", err)})
+	}
+
+	simulation, err := a.PUsecase.SimulateConcentratedPosition(c.Request().Context(), poolID, lowerPrice, upperPrice, tokenIn)
+	if err != nil {
+		if errors.As(err, &domain.PoolNotFoundError{}) {
+			return c.JSON(http.StatusNotFound, ResponseError{Message: err.Error()})
+		}
+		if errors.As(err, &domain.ConcentratedTickModelNotSetError{}) {
+			return c.JSON(http.StatusInternalServerError, ResponseError{Message: err.Error()})
+		}
+		// The remaining errors stem from the position parameters being invalid for the pool.
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, simulation)
+}
+
+// @Summary Simulate joining a CFMM pool
+// @Description Simulates joining the given balancer or stableswap pool with the given tokens.
+// @Description The tokens must either be a single pool token or all of the pool tokens.
+// @Description Returns the shares out, the tokens joined, the swap fee paid on the imbalanced part of the join
+// @Description and the price impact relative to the spot value of the tokens joined.
+// @ID calc-join-pool
+// @Produce  json
+// @Param  id  path  int  true  "CFMM pool ID"
+// @Param  tokensIn  query  string  true  "Comma-separated list of tokens to join, e.g., '1000000uosmo,1000000uion'"
+// @Success 200  {object}  domain.CFMMJoinPoolResult  "Simulated pool join"
+// @Router /pools/join-simulation/{id} [get]
+func (a *PoolsHandler) CalcJoinPool(c echo.Context) error {
+	idStr := c.Param("id")
+	poolID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	tokensIn, err := sdk.ParseCoinsNormalized(c.QueryParam("tokensIn"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: fmt.Sprintf("invalid tokens in: %s", err)})
+	}
+
+	if tokensIn.Empty() {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "tokens in must be provided"})
+	}
+
+	result, err := a.PUsecase.CalcJoinPool(poolID, tokensIn)
+	if err != nil {
+		return c.JSON(getSimulationStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// @Summary Simulate exiting a CFMM pool
+// @Description Simulates exiting the given balancer or stableswap pool with the given amount of shares.
+// @Description Returns the tokens received for the shares after the exit fee.
+// @ID calc-exit-pool
+// @Produce  json
+// @Param  id  path  int  true  "CFMM pool ID"
+// @Param  sharesIn  query  string  true  "Amount of pool shares to exit"
+// @Success 200  {object}  ExitPoolResponse  "Simulated pool exit"
+// @Router /pools/exit-simulation/{id} [get]
+func (a *PoolsHandler) CalcExitPool(c echo.Context) error {
+	idStr := c.Param("id")
+	poolID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	sharesIn, ok := osmomath.NewIntFromString(c.QueryParam("sharesIn"))
+	if !ok || !sharesIn.IsPositive() {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "shares in must be a positive integer"})
+	}
+
+	tokensOut, err := a.PUsecase.CalcExitCFMMPool(poolID, sharesIn)
+	if err != nil {
+		return c.JSON(getSimulationStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, ExitPoolResponse{TokensOut: tokensOut})
+}
+
+// getSimulationStatusCode returns the status code for errors returned from simulating pool joins and exits.
+// Errors other than the pool not being found stem from the simulation parameters being invalid for the pool.
+func getSimulationStatusCode(err error) int {
+	if errors.As(err, &domain.PoolNotFoundError{}) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+// parseDepthPercents parses the comma-separated depth percentages.
+// Returns the default depth percentages if the given string is empty.
+func parseDepthPercents(depthPercentsStr string) ([]osmomath.Dec, error) {
+	if depthPercentsStr == "" {
+		return domain.DefaultDepthChartPercents, nil
+	}
+
+	percentStrs := strings.Split(depthPercentsStr, ",")
+	depthPercents := make([]osmomath.Dec, 0, len(percentStrs))
+	for _, percentStr := range percentStrs {
+		percent, err := osmomath.NewDecFromStr(strings.TrimSpace(percentStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid depth percent (%s): %w", percentStr, err)
+		}
+		depthPercents = append(depthPercents, percent)
+	}
+
+	if err := domain.ValidateDepthChartPercents(depthPercents); err != nil {
+		return nil, err
+	}
+
+	return depthPercents, nil
+}
+
 func getStatusCode(err error) int {
 	if err == nil {
 		return http.StatusOK
 	}
 
 	logrus.Error(err)
+	if errors.As(err, &domain.InvalidPoolsFilterExpressionError{}) || errors.As(err, &domain.UnsupportedPoolsRangeFilterFieldError{}) {
+		return http.StatusBadRequest
+	}
+
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
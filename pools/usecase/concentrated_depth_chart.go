@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	clmath "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/math"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+var oneHundredBigDec = osmomath.NewBigDec(100)
+
+// GetConcentratedPoolDepthChart implements mvc.PoolsUsecase.
+func (p *poolsUseCase) GetConcentratedPoolDepthChart(ctx context.Context, poolID uint64, depthPercents []osmomath.Dec) (domain.ConcentratedDepthChart, error) {
+	if err := domain.ValidateDepthChartPercents(depthPercents); err != nil {
+		return domain.ConcentratedDepthChart{}, err
+	}
+
+	poolWrapper, concentratedPool, err := p.getConcentratedPool(ctx, poolID)
+	if err != nil {
+		return domain.ConcentratedDepthChart{}, err
+	}
+
+	token0, token1 := concentratedPool.GetToken0(), concentratedPool.GetToken1()
+
+	priceScalingFactor, err := p.tokenMetadataHolder.GetSpotPriceScalingFactorByDenom(token0, token1)
+	if err != nil {
+		return domain.ConcentratedDepthChart{}, err
+	}
+
+	token0ScalingFactor, err := p.cosmWasmPoolsParams.ScalingFactorGetterCb(token0)
+	if err != nil {
+		return domain.ConcentratedDepthChart{}, err
+	}
+
+	token1ScalingFactor, err := p.cosmWasmPoolsParams.ScalingFactorGetterCb(token1)
+	if err != nil {
+		return domain.ConcentratedDepthChart{}, err
+	}
+
+	return computeConcentratedDepthChart(concentratedPool, poolWrapper.TickModel, depthPercents, priceScalingFactor, token0ScalingFactor, token1ScalingFactor)
+}
+
+// getConcentratedPool returns the pool with the given ID together with its underlying concentrated pool model.
+// Reads the pool from the state snapshot pinned for the request, if any.
+// Returns error if the pool is not found, is not concentrated or if its tick model is not set.
+func (p *poolsUseCase) getConcentratedPool(ctx context.Context, poolID uint64) (*sqsdomain.PoolWrapper, *concentratedmodel.Pool, error) {
+	var (
+		pool sqsdomain.PoolI
+		err  error
+	)
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		pool, err = snapshot.GetPool(poolID)
+	} else {
+		pool, err = p.GetPool(poolID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pool.GetType() != poolmanagertypes.Concentrated {
+		return nil, nil, fmt.Errorf("pool with ID %d is not concentrated", poolID)
+	}
+
+	poolWrapper, ok := pool.(*sqsdomain.PoolWrapper)
+	if !ok || poolWrapper.TickModel == nil {
+		return nil, nil, domain.ConcentratedTickModelNotSetError{
+			PoolId: poolID,
+		}
+	}
+
+	concentratedPool, ok := pool.GetUnderlyingPool().(*concentratedmodel.Pool)
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to cast pool with ID %d to concentrated pool", poolID)
+	}
+
+	return poolWrapper, concentratedPool, nil
+}
+
+// computeConcentratedDepthChart converts the given tick model of the concentrated pool into the depth chart.
+// priceScalingFactor converts the chain price of token0 in terms of token1 into the human price.
+// token0ScalingFactor and token1ScalingFactor convert the chain amounts of the respective tokens into human amounts.
+// Returns error if any of the scaling factors is zero or if any of the ticks is out of bounds.
+func computeConcentratedDepthChart(pool *concentratedmodel.Pool, tickModel *sqsdomain.TickModel, depthPercents []osmomath.Dec, priceScalingFactor, token0ScalingFactor, token1ScalingFactor osmomath.Dec) (domain.ConcentratedDepthChart, error) {
+	if token0ScalingFactor.IsZero() || token1ScalingFactor.IsZero() {
+		return domain.ConcentratedDepthChart{}, fmt.Errorf("scaling factor for pool (%d) tokens is zero", pool.GetId())
+	}
+
+	var (
+		sqrtPriceCurrent = pool.GetCurrentSqrtPrice()
+
+		priceScalingFactorBigDec  = osmomath.BigDecFromDec(priceScalingFactor)
+		token0ScalingFactorBigDec = osmomath.BigDecFromDec(token0ScalingFactor)
+		token1ScalingFactorBigDec = osmomath.BigDecFromDec(token1ScalingFactor)
+	)
+
+	// toHumanPrice converts the given sqrt price into the human price of token0 in terms of token1.
+	toHumanPrice := func(sqrtPrice osmomath.BigDec) osmomath.Dec {
+		return sqrtPrice.Mul(sqrtPrice).MulMut(priceScalingFactorBigDec).Dec()
+	}
+
+	chart := domain.ConcentratedDepthChart{
+		PoolID:           pool.GetId(),
+		Token0:           pool.GetToken0(),
+		Token1:           pool.GetToken1(),
+		CurrentTick:      pool.GetCurrentTick(),
+		CurrentPrice:     toHumanPrice(sqrtPriceCurrent),
+		Buckets:          []domain.ConcentratedDepthBucket{},
+		CumulativeDepths: make([]domain.ConcentratedCumulativeDepth, 0, len(depthPercents)),
+	}
+
+	type sqrtPriceRange struct {
+		liquidity      osmomath.Dec
+		sqrtPriceLower osmomath.BigDec
+		sqrtPriceUpper osmomath.BigDec
+	}
+
+	var ranges []sqrtPriceRange
+	if !tickModel.HasNoLiquidity {
+		ranges = make([]sqrtPriceRange, 0, len(tickModel.Ticks))
+		for _, tick := range tickModel.Ticks {
+			if !tick.LiquidityAmount.IsPositive() {
+				continue
+			}
+
+			sqrtPriceLower, sqrtPriceUpper, err := clmath.TicksToSqrtPrice(tick.LowerTick, tick.UpperTick)
+			if err != nil {
+				return domain.ConcentratedDepthChart{}, err
+			}
+
+			amount0, amount1 := calcConcentratedAmountsInRange(tick.LiquidityAmount, sqrtPriceLower, sqrtPriceUpper, sqrtPriceCurrent)
+
+			chart.Buckets = append(chart.Buckets, domain.ConcentratedDepthBucket{
+				LowerTick:  tick.LowerTick,
+				UpperTick:  tick.UpperTick,
+				LowerPrice: toHumanPrice(sqrtPriceLower),
+				UpperPrice: toHumanPrice(sqrtPriceUpper),
+				Amount0:    amount0.QuoMut(token0ScalingFactorBigDec).Dec(),
+				Amount1:    amount1.QuoMut(token1ScalingFactorBigDec).Dec(),
+			})
+
+			ranges = append(ranges, sqrtPriceRange{
+				liquidity:      tick.LiquidityAmount,
+				sqrtPriceLower: sqrtPriceLower,
+				sqrtPriceUpper: sqrtPriceUpper,
+			})
+		}
+	}
+
+	for _, percent := range depthPercents {
+		percentBigDec := osmomath.BigDecFromDec(percent).QuoMut(oneHundredBigDec)
+
+		// Price moves by the percentage when the sqrt price moves by the square root of it.
+		sqrtMultiplierLower, err := osmomath.MonotonicSqrtBigDec(osmomath.OneBigDec().Sub(percentBigDec))
+		if err != nil {
+			return domain.ConcentratedDepthChart{}, err
+		}
+
+		sqrtMultiplierUpper, err := osmomath.MonotonicSqrtBigDec(osmomath.OneBigDec().Add(percentBigDec))
+		if err != nil {
+			return domain.ConcentratedDepthChart{}, err
+		}
+
+		var (
+			depthSqrtPriceLower = sqrtPriceCurrent.Mul(sqrtMultiplierLower)
+			depthSqrtPriceUpper = sqrtPriceCurrent.Mul(sqrtMultiplierUpper)
+
+			cumulativeAmount0 = osmomath.ZeroBigDec()
+			cumulativeAmount1 = osmomath.ZeroBigDec()
+		)
+
+		for _, r := range ranges {
+			// Clip the range to the depth bounds.
+			sqrtPriceLower := osmomath.MaxBigDec(r.sqrtPriceLower, depthSqrtPriceLower)
+			sqrtPriceUpper := osmomath.MinBigDec(r.sqrtPriceUpper, depthSqrtPriceUpper)
+			if sqrtPriceLower.GTE(sqrtPriceUpper) {
+				continue
+			}
+
+			amount0, amount1 := calcConcentratedAmountsInRange(r.liquidity, sqrtPriceLower, sqrtPriceUpper, sqrtPriceCurrent)
+
+			cumulativeAmount0.AddMut(amount0)
+			cumulativeAmount1.AddMut(amount1)
+		}
+
+		chart.CumulativeDepths = append(chart.CumulativeDepths, domain.ConcentratedCumulativeDepth{
+			Percent:    percent,
+			LowerPrice: toHumanPrice(depthSqrtPriceLower),
+			UpperPrice: toHumanPrice(depthSqrtPriceUpper),
+			Amount0:    cumulativeAmount0.QuoMut(token0ScalingFactorBigDec).Dec(),
+			Amount1:    cumulativeAmount1.QuoMut(token1ScalingFactorBigDec).Dec(),
+		})
+	}
+
+	return chart, nil
+}
+
+// calcConcentratedAmountsInRange returns the amounts of token0 and token1 held by the given liquidity
+// within the range between the lower and upper sqrt prices at the current sqrt price.
+// Token0 is held in the part of the range above the current sqrt price while token1 is held in the part below.
+// The amounts are rounded down.
+func calcConcentratedAmountsInRange(liquidity osmomath.Dec, sqrtPriceLower, sqrtPriceUpper, sqrtPriceCurrent osmomath.BigDec) (amount0 osmomath.BigDec, amount1 osmomath.BigDec) {
+	amount0, amount1 = osmomath.ZeroBigDec(), osmomath.ZeroBigDec()
+
+	if sqrtPriceCurrent.LT(sqrtPriceUpper) {
+		amount0 = clmath.CalcAmount0Delta(liquidity, osmomath.MaxBigDec(sqrtPriceLower, sqrtPriceCurrent), sqrtPriceUpper, false)
+	}
+
+	if sqrtPriceCurrent.GT(sqrtPriceLower) {
+		amount1 = clmath.CalcAmount1Delta(liquidity, sqrtPriceLower, osmomath.MinBigDec(sqrtPriceUpper, sqrtPriceCurrent), false)
+	}
+
+	return amount0, amount1
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/pools/usecase"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// TestComputeConcentratedDepthChart validates the conversion of the tick model into the depth chart.
+//
+// The pool is at tick 0 (sqrt price 1) with the following ranges:
+// - [-10_000_000, -7_500_000] (sqrt prices [0.3, 0.5]) with liquidity 2 * 10^8 holding only token1.
+// - [-7_500_000, 960_000] (sqrt prices [0.5, 1.4]) with liquidity 1.4 * 10^9 holding both tokens.
+// - [960_000, 8_000_000] (sqrt prices [1.4, 3]) with liquidity 4.2 * 10^9 holding only token0.
+//
+// Token0 has precision 6 and token1 has precision 3, making the price scaling factor 10^3.
+// ±96% of the current price corresponds to the sqrt prices [0.2, 1.4].
+func (s *PoolsUsecaseTestSuite) TestComputeConcentratedDepthChart() {
+	var (
+		token0ScalingFactor = osmomath.NewDec(1_000_000)
+		token1ScalingFactor = osmomath.NewDec(1_000)
+		priceScalingFactor  = token0ScalingFactor.Quo(token1ScalingFactor)
+
+		ninetySixPercent = osmomath.NewDec(96)
+
+		pool = &concentratedmodel.Pool{
+			Id:               defaultPoolID,
+			Token0:           denomOne,
+			Token1:           denomTwo,
+			CurrentTick:      0,
+			CurrentSqrtPrice: osmomath.OneBigDec(),
+		}
+
+		defaultTickModel = &sqsdomain.TickModel{
+			Ticks: []sqsdomain.LiquidityDepthsWithRange{
+				{LowerTick: -10_000_000, UpperTick: -7_500_000, LiquidityAmount: osmomath.NewDec(200_000_000)},
+				{LowerTick: -7_500_000, UpperTick: 960_000, LiquidityAmount: osmomath.NewDec(1_400_000_000)},
+				{LowerTick: 960_000, UpperTick: 8_000_000, LiquidityAmount: osmomath.NewDec(4_200_000_000)},
+				// Ranges without liquidity are skipped.
+				{LowerTick: 8_000_000, UpperTick: 9_000_000, LiquidityAmount: osmomath.ZeroDec()},
+			},
+		}
+	)
+
+	tests := []struct {
+		name string
+
+		tickModel           *sqsdomain.TickModel
+		token0ScalingFactor osmomath.Dec
+
+		expectedChart domain.ConcentratedDepthChart
+		expectedError bool
+	}{
+		{
+			name: "happy path",
+
+			tickModel:           defaultTickModel,
+			token0ScalingFactor: token0ScalingFactor,
+
+			expectedChart: domain.ConcentratedDepthChart{
+				PoolID:       defaultPoolID,
+				Token0:       denomOne,
+				Token1:       denomTwo,
+				CurrentTick:  0,
+				CurrentPrice: osmomath.NewDec(1_000),
+				Buckets: []domain.ConcentratedDepthBucket{
+					{
+						LowerTick:  -10_000_000,
+						UpperTick:  -7_500_000,
+						LowerPrice: osmomath.NewDec(90),
+						UpperPrice: osmomath.NewDec(250),
+						Amount0:    osmomath.ZeroDec(),
+						Amount1:    osmomath.NewDec(40_000),
+					},
+					{
+						LowerTick:  -7_500_000,
+						UpperTick:  960_000,
+						LowerPrice: osmomath.NewDec(250),
+						UpperPrice: osmomath.NewDec(1_960),
+						Amount0:    osmomath.NewDec(400),
+						Amount1:    osmomath.NewDec(700_000),
+					},
+					{
+						LowerTick:  960_000,
+						UpperTick:  8_000_000,
+						LowerPrice: osmomath.NewDec(1_960),
+						UpperPrice: osmomath.NewDec(9_000),
+						Amount0:    osmomath.NewDec(1_600),
+						Amount1:    osmomath.ZeroDec(),
+					},
+				},
+				CumulativeDepths: []domain.ConcentratedCumulativeDepth{
+					{
+						Percent:    ninetySixPercent,
+						LowerPrice: osmomath.NewDec(40),
+						UpperPrice: osmomath.NewDec(1_960),
+						Amount0:    osmomath.NewDec(400),
+						Amount1:    osmomath.NewDec(740_000),
+					},
+				},
+			},
+		},
+		{
+			name: "no liquidity",
+
+			tickModel: &sqsdomain.TickModel{
+				Ticks:          defaultTickModel.Ticks,
+				HasNoLiquidity: true,
+			},
+			token0ScalingFactor: token0ScalingFactor,
+
+			expectedChart: domain.ConcentratedDepthChart{
+				PoolID:       defaultPoolID,
+				Token0:       denomOne,
+				Token1:       denomTwo,
+				CurrentTick:  0,
+				CurrentPrice: osmomath.NewDec(1_000),
+				Buckets:      []domain.ConcentratedDepthBucket{},
+				CumulativeDepths: []domain.ConcentratedCumulativeDepth{
+					{
+						Percent:    ninetySixPercent,
+						LowerPrice: osmomath.NewDec(40),
+						UpperPrice: osmomath.NewDec(1_960),
+						Amount0:    osmomath.ZeroDec(),
+						Amount1:    osmomath.ZeroDec(),
+					},
+				},
+			},
+		},
+		{
+			name: "zero scaling factor",
+
+			tickModel:           defaultTickModel,
+			token0ScalingFactor: osmomath.ZeroDec(),
+
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			chart, err := usecase.ComputeConcentratedDepthChart(pool, tc.tickModel, []osmomath.Dec{ninetySixPercent}, priceScalingFactor, tc.token0ScalingFactor, token1ScalingFactor)
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedChart, chart)
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	clmath "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/math"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+var (
+	// daysPerYear is used to annualize the average daily fees.
+	daysPerYear = osmomath.NewBigDec(365)
+	// feesPeriodDays is the number of days the fees used for the APR estimate are collected over.
+	feesPeriodDays = osmomath.NewBigDec(7)
+)
+
+// SimulateConcentratedPosition implements mvc.PoolsUsecase.
+func (p *poolsUseCase) SimulateConcentratedPosition(ctx context.Context, poolID uint64, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error) {
+	poolWrapper, concentratedPool, err := p.getConcentratedPool(ctx, poolID)
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	simulation, err := simulateConcentratedPosition(concentratedPool, poolWrapper.TickModel, lowerPrice, upperPrice, tokenIn)
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	// Note that we do not fail the simulation if the fee APR cannot be estimated.
+	// Instead, we report the error alongside the simulated position.
+	feesSpent7d, err := p.getPoolFeesSpent7d(poolID)
+	if err != nil {
+		simulation.FeeAPRError = err.Error()
+		return simulation, nil
+	}
+
+	sqsModel := poolWrapper.GetSQSPoolModel()
+	feeAPR, err := estimateConcentratedPositionFeeAPR(simulation, concentratedPool, sqsModel.Balances, sqsModel.PoolLiquidityCap, feesSpent7d)
+	if err != nil {
+		simulation.FeeAPRError = err.Error()
+		return simulation, nil
+	}
+
+	simulation.FeeAPR = feeAPR
+
+	return simulation, nil
+}
+
+// GetConcentratedPositionAssets implements mvc.PoolsUsecase.
+func (p *poolsUseCase) GetConcentratedPositionAssets(ctx context.Context, positionID uint64) (sdk.Coins, error) {
+	if p.concentratedPositionFetcher == nil {
+		return nil, errors.New("concentrated position fetcher is not configured")
+	}
+
+	position, err := p.concentratedPositionFetcher.PositionByID(ctx, positionID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, concentratedPool, err := p.getConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeConcentratedPositionAssets(concentratedPool, position.LowerTick, position.UpperTick, position.Liquidity)
+}
+
+// getPoolFeesSpent7d returns the fees spent in the pool over the last 7 days in USD from the passthrough fees data.
+// Returns error if the fees data is not available or is stale.
+func (p *poolsUseCase) getPoolFeesSpent7d(poolID uint64) (float64, error) {
+	if p.poolFeesPrefetcher == nil {
+		return 0, errors.New("pool fees data is not configured")
+	}
+
+	poolFeeData, _, isStale, err := p.poolFeesPrefetcher.GetByKey(poolID)
+	if err != nil {
+		return 0, err
+	}
+
+	if isStale {
+		return 0, fmt.Errorf("pool fees data for pool (%d) is stale", poolID)
+	}
+
+	return poolFeeData.FeesSpent7d, nil
+}
+
+// simulateConcentratedPosition simulates creating a position in the given concentrated pool within the
+// given price range by providing the given token. Computes the amount of the other token required, the liquidity
+// of the position and the share of the active liquidity it would hold. The fee APR is not estimated.
+// The lower price is rounded down and the upper price is rounded up to the tick spacing of the pool.
+// The amount of the other token is rounded up as this is the amount required to create the position.
+// Returns error if the token is not in the pool or if the position cannot hold the token at the current price.
+func simulateConcentratedPosition(pool *concentratedmodel.Pool, tickModel *sqsdomain.TickModel, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error) {
+	token0, token1 := pool.GetToken0(), pool.GetToken1()
+	if tokenIn.Denom != token0 && tokenIn.Denom != token1 {
+		return domain.ConcentratedPositionSimulation{}, fmt.Errorf("denom (%s) is not in pool (%d)", tokenIn.Denom, pool.GetId())
+	}
+
+	if !tokenIn.Amount.IsPositive() {
+		return domain.ConcentratedPositionSimulation{}, fmt.Errorf("amount (%s) must be positive", tokenIn.Amount)
+	}
+
+	lowerTick, upperTick, err := priceRangeToTicks(lowerPrice, upperPrice, int64(pool.GetTickSpacing()))
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	sqrtPriceLower, sqrtPriceUpper, err := clmath.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	var (
+		sqrtPriceCurrent = pool.GetCurrentSqrtPrice()
+		liquidity        osmomath.Dec
+		amount0          osmomath.Int
+		amount1          osmomath.Int
+	)
+
+	if tokenIn.Denom == token0 {
+		// Token0 is held in the part of the range above the current price.
+		if sqrtPriceCurrent.GTE(sqrtPriceUpper) {
+			return domain.ConcentratedPositionSimulation{}, fmt.Errorf("position below the current price cannot hold token0 (%s)", token0)
+		}
+
+		amount0 = tokenIn.Amount
+		liquidity = clmath.Liquidity0(amount0, osmomath.MaxBigDec(sqrtPriceLower, sqrtPriceCurrent), sqrtPriceUpper)
+
+		amount1 = osmomath.ZeroInt()
+		if sqrtPriceCurrent.GT(sqrtPriceLower) {
+			amount1 = clmath.CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPriceCurrent, true).Ceil().Dec().TruncateInt()
+		}
+	} else {
+		// Token1 is held in the part of the range below the current price.
+		if sqrtPriceCurrent.LTE(sqrtPriceLower) {
+			return domain.ConcentratedPositionSimulation{}, fmt.Errorf("position above the current price cannot hold token1 (%s)", token1)
+		}
+
+		amount1 = tokenIn.Amount
+		liquidity = clmath.Liquidity1(amount1, sqrtPriceLower, osmomath.MinBigDec(sqrtPriceUpper, sqrtPriceCurrent))
+
+		amount0 = osmomath.ZeroInt()
+		if sqrtPriceCurrent.LT(sqrtPriceUpper) {
+			amount0 = clmath.CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceUpper, true).Ceil().Dec().TruncateInt()
+		}
+	}
+
+	lowerPriceRounded, err := clmath.TickToPrice(lowerTick)
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	upperPriceRounded, err := clmath.TickToPrice(upperTick)
+	if err != nil {
+		return domain.ConcentratedPositionSimulation{}, err
+	}
+
+	// The range is active if the current tick is within [lower tick, upper tick).
+	currentTick := pool.GetCurrentTick()
+	isActive := currentTick >= lowerTick && currentTick < upperTick
+
+	activeLiquidityShare := osmomath.ZeroDec()
+	if isActive {
+		totalActiveLiquidity := getCurrentBucketLiquidity(tickModel).Add(liquidity)
+		if totalActiveLiquidity.IsPositive() {
+			activeLiquidityShare = liquidity.Quo(totalActiveLiquidity)
+		}
+	}
+
+	return domain.ConcentratedPositionSimulation{
+		PoolID:               pool.GetId(),
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		LowerPrice:           lowerPriceRounded,
+		UpperPrice:           upperPriceRounded,
+		Amount0:              amount0,
+		Amount1:              amount1,
+		Liquidity:            liquidity,
+		IsActive:             isActive,
+		ActiveLiquidityShare: activeLiquidityShare,
+		FeeAPR:               osmomath.ZeroDec(),
+	}, nil
+}
+
+// computeConcentratedPositionAssets returns the assets held by the position with the given ticks and liquidity
+// at the current price of the given pool. The amounts are rounded down as these are the amounts withdrawable.
+func computeConcentratedPositionAssets(pool *concentratedmodel.Pool, lowerTick, upperTick int64, liquidity osmomath.Dec) (sdk.Coins, error) {
+	sqrtPriceLower, sqrtPriceUpper, err := clmath.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sqrtPriceCurrent = pool.GetCurrentSqrtPrice()
+		amount0          = osmomath.ZeroInt()
+		amount1          = osmomath.ZeroInt()
+	)
+
+	// Token0 is held in the part of the range above the current price
+	// and token1 in the part of the range below it.
+	if sqrtPriceCurrent.LT(sqrtPriceUpper) {
+		amount0 = clmath.CalcAmount0Delta(liquidity, osmomath.MaxBigDec(sqrtPriceLower, sqrtPriceCurrent), sqrtPriceUpper, false).Dec().TruncateInt()
+	}
+	if sqrtPriceCurrent.GT(sqrtPriceLower) {
+		amount1 = clmath.CalcAmount1Delta(liquidity, sqrtPriceLower, osmomath.MinBigDec(sqrtPriceUpper, sqrtPriceCurrent), false).Dec().TruncateInt()
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1)), nil
+}
+
+// estimateConcentratedPositionFeeAPR estimates the fee APR of the simulated position in percent.
+// The annual fees are extrapolated from the fees spent over the last 7 days and distributed
+// by the share of the active liquidity the position holds.
+// The position is valued in USD relative to the pool liquidity capitalization at the current price.
+// Returns zero if the position is not active.
+func estimateConcentratedPositionFeeAPR(simulation domain.ConcentratedPositionSimulation, pool *concentratedmodel.Pool, balances sdk.Coins, liquidityCap osmomath.Int, feesSpent7d float64) (osmomath.Dec, error) {
+	if !simulation.IsActive {
+		return osmomath.ZeroDec(), nil
+	}
+
+	if liquidityCap.IsNil() || !liquidityCap.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("liquidity capitalization for pool (%d) is not available", pool.GetId())
+	}
+
+	feesSpent7dDec, err := osmomath.NewDecFromStr(strconv.FormatFloat(feesSpent7d, 'f', 6, 64))
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	// Value of the pool and of the position in terms of token1 at the current spot price.
+	sqrtPriceCurrent := pool.GetCurrentSqrtPrice()
+	spotPrice := sqrtPriceCurrent.Mul(sqrtPriceCurrent)
+
+	poolValue := osmomath.BigDecFromSDKInt(balances.AmountOf(pool.GetToken0())).MulMut(spotPrice).AddMut(osmomath.BigDecFromSDKInt(balances.AmountOf(pool.GetToken1())))
+	if !poolValue.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("balances of pool (%d) are empty", pool.GetId())
+	}
+
+	positionValue := osmomath.BigDecFromSDKInt(simulation.Amount0).MulMut(spotPrice).AddMut(osmomath.BigDecFromSDKInt(simulation.Amount1))
+	positionValueUSD := positionValue.MulMut(osmomath.BigDecFromSDKInt(liquidityCap)).QuoMut(poolValue)
+	if !positionValueUSD.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("value of the position in pool (%d) is zero", pool.GetId())
+	}
+
+	annualFeesUSD := osmomath.BigDecFromDec(feesSpent7dDec).MulMut(daysPerYear).QuoMut(feesPeriodDays)
+
+	return annualFeesUSD.MulMut(osmomath.BigDecFromDec(simulation.ActiveLiquidityShare)).QuoMut(positionValueUSD).MulMut(oneHundredBigDec).Dec(), nil
+}
+
+// priceRangeToTicks converts the given price range into ticks rounded to the given tick spacing.
+// The lower tick is rounded down and the upper tick is rounded up.
+// Returns error if the prices are out of bounds or if the range is empty.
+func priceRangeToTicks(lowerPrice, upperPrice osmomath.BigDec, tickSpacing int64) (int64, int64, error) {
+	if tickSpacing <= 0 {
+		return 0, 0, fmt.Errorf("tick spacing (%d) must be positive", tickSpacing)
+	}
+
+	if lowerPrice.GTE(upperPrice) {
+		return 0, 0, fmt.Errorf("lower price (%s) must be less than upper price (%s)", lowerPrice, upperPrice)
+	}
+
+	lowerTick, err := clmath.CalculatePriceToTick(lowerPrice)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lowerTick, err = clmath.RoundDownTickToSpacing(lowerTick, tickSpacing)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	upperTickExact, err := clmath.CalculatePriceToTick(upperPrice)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	upperTick, err := clmath.RoundDownTickToSpacing(upperTickExact, tickSpacing)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if upperTick < upperTickExact {
+		upperTick += tickSpacing
+	}
+
+	if upperTick > cltypes.MaxTick {
+		return 0, 0, cltypes.TickIndexNotWithinBoundariesError{ActualTick: upperTick, MinTick: cltypes.MinInitializedTickV2, MaxTick: cltypes.MaxTick}
+	}
+
+	if lowerTick >= upperTick {
+		return 0, 0, fmt.Errorf("lower tick (%d) must be less than upper tick (%d)", lowerTick, upperTick)
+	}
+
+	return lowerTick, upperTick, nil
+}
+
+// getCurrentBucketLiquidity returns the liquidity of the bucket containing the current tick.
+// Returns zero if the pool has no liquidity or if the current bucket is out of range.
+func getCurrentBucketLiquidity(tickModel *sqsdomain.TickModel) osmomath.Dec {
+	currentBucketIndex := tickModel.CurrentTickIndex
+	if tickModel.HasNoLiquidity || currentBucketIndex < 0 || currentBucketIndex >= int64(len(tickModel.Ticks)) {
+		return osmomath.ZeroDec()
+	}
+
+	return tickModel.Ticks[currentBucketIndex].LiquidityAmount
+}
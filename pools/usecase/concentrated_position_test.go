@@ -0,0 +1,288 @@
+package usecase_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/pools/usecase"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// defaultPositionPool is a concentrated pool at tick 0 (sqrt price 1) with the tick spacing of 100.
+var defaultPositionPool = &concentratedmodel.Pool{
+	Id:               defaultPoolID,
+	Token0:           denomOne,
+	Token1:           denomTwo,
+	CurrentTick:      0,
+	CurrentSqrtPrice: osmomath.OneBigDec(),
+	TickSpacing:      100,
+}
+
+// TestSimulateConcentratedPosition validates the simulation of concentrated liquidity positions.
+// The active bucket of the pool has the liquidity of 2000.
+// Prices 0.25, 4 and 9 correspond to the ticks -7_500_000, 3_000_000 and 8_000_000
+// and to the sqrt prices 0.5, 2 and 3 respectively.
+func (s *PoolsUsecaseTestSuite) TestSimulateConcentratedPosition() {
+	tickModel := &sqsdomain.TickModel{
+		Ticks: []sqsdomain.LiquidityDepthsWithRange{
+			{LowerTick: -7_500_000, UpperTick: 3_000_000, LiquidityAmount: osmomath.NewDec(2000)},
+		},
+		CurrentTickIndex: 0,
+	}
+
+	tests := []struct {
+		name string
+
+		lowerPrice osmomath.BigDec
+		upperPrice osmomath.BigDec
+		tokenIn    sdk.Coin
+
+		expectedSimulation domain.ConcentratedPositionSimulation
+		expectedError      bool
+	}{
+		{
+			name:       "active range, token0 in",
+			lowerPrice: osmomath.MustNewBigDecFromStr("0.25"),
+			upperPrice: osmomath.NewBigDec(4),
+			tokenIn:    sdk.NewCoin(denomOne, osmomath.NewInt(1000)),
+
+			// L = 1000 * (1 * 2) / (2 - 1) = 2000
+			// amount1 = 2000 * (1 - 0.5) = 1000
+			expectedSimulation: domain.ConcentratedPositionSimulation{
+				PoolID:               defaultPoolID,
+				LowerTick:            -7_500_000,
+				UpperTick:            3_000_000,
+				LowerPrice:           osmomath.MustNewBigDecFromStr("0.25"),
+				UpperPrice:           osmomath.NewBigDec(4),
+				Amount0:              osmomath.NewInt(1000),
+				Amount1:              osmomath.NewInt(1000),
+				Liquidity:            osmomath.NewDec(2000),
+				IsActive:             true,
+				ActiveLiquidityShare: osmomath.MustNewDecFromStr("0.5"),
+				FeeAPR:               osmomath.ZeroDec(),
+			},
+		},
+		{
+			name:       "active range, token1 in",
+			lowerPrice: osmomath.MustNewBigDecFromStr("0.25"),
+			upperPrice: osmomath.NewBigDec(4),
+			tokenIn:    sdk.NewCoin(denomTwo, osmomath.NewInt(1000)),
+
+			// L = 1000 / (1 - 0.5) = 2000
+			// amount0 = 2000 * (2 - 1) / (1 * 2) = 1000
+			expectedSimulation: domain.ConcentratedPositionSimulation{
+				PoolID:               defaultPoolID,
+				LowerTick:            -7_500_000,
+				UpperTick:            3_000_000,
+				LowerPrice:           osmomath.MustNewBigDecFromStr("0.25"),
+				UpperPrice:           osmomath.NewBigDec(4),
+				Amount0:              osmomath.NewInt(1000),
+				Amount1:              osmomath.NewInt(1000),
+				Liquidity:            osmomath.NewDec(2000),
+				IsActive:             true,
+				ActiveLiquidityShare: osmomath.MustNewDecFromStr("0.5"),
+				FeeAPR:               osmomath.ZeroDec(),
+			},
+		},
+		{
+			name:       "range above the current price, token0 in",
+			lowerPrice: osmomath.NewBigDec(4),
+			upperPrice: osmomath.NewBigDec(9),
+			tokenIn:    sdk.NewCoin(denomOne, osmomath.NewInt(1200)),
+
+			// L = 1200 * (2 * 3) / (3 - 2) = 7200
+			expectedSimulation: domain.ConcentratedPositionSimulation{
+				PoolID:               defaultPoolID,
+				LowerTick:            3_000_000,
+				UpperTick:            8_000_000,
+				LowerPrice:           osmomath.NewBigDec(4),
+				UpperPrice:           osmomath.NewBigDec(9),
+				Amount0:              osmomath.NewInt(1200),
+				Amount1:              osmomath.ZeroInt(),
+				Liquidity:            osmomath.NewDec(7200),
+				IsActive:             false,
+				ActiveLiquidityShare: osmomath.ZeroDec(),
+				FeeAPR:               osmomath.ZeroDec(),
+			},
+		},
+		{
+			name:       "range above the current price, token1 in",
+			lowerPrice: osmomath.NewBigDec(4),
+			upperPrice: osmomath.NewBigDec(9),
+			tokenIn:    sdk.NewCoin(denomTwo, osmomath.NewInt(1000)),
+
+			expectedError: true,
+		},
+		{
+			name:       "denom not in pool",
+			lowerPrice: osmomath.MustNewBigDecFromStr("0.25"),
+			upperPrice: osmomath.NewBigDec(4),
+			tokenIn:    sdk.NewCoin(denomThree, osmomath.NewInt(1000)),
+
+			expectedError: true,
+		},
+		{
+			name:       "lower price above upper price",
+			lowerPrice: osmomath.NewBigDec(4),
+			upperPrice: osmomath.MustNewBigDecFromStr("0.25"),
+			tokenIn:    sdk.NewCoin(denomOne, osmomath.NewInt(1000)),
+
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			simulation, err := usecase.SimulateConcentratedPosition(defaultPositionPool, tickModel, tc.lowerPrice, tc.upperPrice, tc.tokenIn)
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedSimulation, simulation)
+		})
+	}
+}
+
+// TestComputeConcentratedPositionAssets validates the assets held by a position at the current price of the pool.
+// Ticks -7_500_000, 3_000_000 and 8_000_000 correspond to the sqrt prices 0.5, 2 and 3 respectively.
+func (s *PoolsUsecaseTestSuite) TestComputeConcentratedPositionAssets() {
+	liquidity := osmomath.NewDec(2000)
+
+	tests := []struct {
+		name string
+
+		lowerTick int64
+		upperTick int64
+
+		expectedAssets sdk.Coins
+	}{
+		{
+			name:      "active range",
+			lowerTick: -7_500_000,
+			upperTick: 3_000_000,
+
+			// amount0 = 2000 * (2 - 1) / (1 * 2) = 1000
+			// amount1 = 2000 * (1 - 0.5) = 1000
+			expectedAssets: sdk.NewCoins(sdk.NewCoin(denomOne, osmomath.NewInt(1000)), sdk.NewCoin(denomTwo, osmomath.NewInt(1000))),
+		},
+		{
+			name:      "range above the current price holds token0 only",
+			lowerTick: 3_000_000,
+			upperTick: 8_000_000,
+
+			// amount0 = 2000 * (3 - 2) / (2 * 3) = 333.33, rounded down
+			expectedAssets: sdk.NewCoins(sdk.NewCoin(denomOne, osmomath.NewInt(333))),
+		},
+		{
+			name:      "range below the current price holds token1 only",
+			lowerTick: -7_500_000,
+			upperTick: 0,
+
+			// amount1 = 2000 * (1 - 0.5) = 1000
+			expectedAssets: sdk.NewCoins(sdk.NewCoin(denomTwo, osmomath.NewInt(1000))),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			assets, err := usecase.ComputeConcentratedPositionAssets(defaultPositionPool, tc.lowerTick, tc.upperTick, liquidity)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedAssets.String(), assets.String())
+		})
+	}
+}
+
+// TestEstimateConcentratedPositionFeeAPR validates the fee APR estimate of a simulated position.
+func (s *PoolsUsecaseTestSuite) TestEstimateConcentratedPositionFeeAPR() {
+	var (
+		// At the spot price of 1, the pool holds 2_000_000 units of value worth 2000 USD.
+		balances     = sdk.NewCoins(sdk.NewCoin(denomOne, osmomath.NewInt(1_000_000)), sdk.NewCoin(denomTwo, osmomath.NewInt(1_000_000)))
+		liquidityCap = osmomath.NewInt(2000)
+
+		// The position holds 2000 units of value worth 2 USD.
+		activeSimulation = domain.ConcentratedPositionSimulation{
+			Amount0:              osmomath.NewInt(1000),
+			Amount1:              osmomath.NewInt(1000),
+			IsActive:             true,
+			ActiveLiquidityShare: osmomath.MustNewDecFromStr("0.5"),
+		}
+	)
+
+	tests := []struct {
+		name string
+
+		simulation   domain.ConcentratedPositionSimulation
+		liquidityCap osmomath.Int
+
+		expectedFeeAPR osmomath.Dec
+		expectedError  bool
+	}{
+		{
+			name:         "active position",
+			simulation:   activeSimulation,
+			liquidityCap: liquidityCap,
+
+			// 7 USD of weekly fees is 365 USD annually of which the position earns half.
+			// 182.5 / 2 * 100 = 9125%
+			expectedFeeAPR: osmomath.NewDec(9125),
+		},
+		{
+			name: "inactive position",
+			simulation: domain.ConcentratedPositionSimulation{
+				Amount0:              osmomath.NewInt(1000),
+				Amount1:              osmomath.ZeroInt(),
+				ActiveLiquidityShare: osmomath.ZeroDec(),
+			},
+			liquidityCap: liquidityCap,
+
+			expectedFeeAPR: osmomath.ZeroDec(),
+		},
+		{
+			name:         "zero liquidity cap",
+			simulation:   activeSimulation,
+			liquidityCap: osmomath.ZeroInt(),
+
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			feeAPR, err := usecase.EstimateConcentratedPositionFeeAPR(tc.simulation, defaultPositionPool, balances, tc.liquidityCap, 7)
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedFeeAPR, feeAPR)
+		})
+	}
+}
+
+// TestPriceRangeToTicks validates that the price range is rounded outwards to the tick spacing.
+func (s *PoolsUsecaseTestSuite) TestPriceRangeToTicks() {
+	// Price 1.00005 corresponds to tick 50 and price 1.00015 to tick 150.
+	lowerTick, upperTick, err := usecase.PriceRangeToTicks(osmomath.MustNewBigDecFromStr("1.00005"), osmomath.MustNewBigDecFromStr("1.00015"), 100)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), lowerTick)
+	s.Require().Equal(int64(200), upperTick)
+
+	// Ticks already on the tick spacing are not rounded.
+	lowerTick, upperTick, err = usecase.PriceRangeToTicks(osmomath.MustNewBigDecFromStr("0.25"), osmomath.NewBigDec(4), 100)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(-7_500_000), lowerTick)
+	s.Require().Equal(int64(3_000_000), upperTick)
+
+	// Empty range
+	_, _, err = usecase.PriceRangeToTicks(osmomath.OneBigDec(), osmomath.OneBigDec(), 100)
+	s.Require().Error(err)
+}
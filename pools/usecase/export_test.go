@@ -5,6 +5,7 @@ import (
 	"github.com/osmosis-labs/sqs/domain"
 	"github.com/osmosis-labs/sqs/sqsdomain"
 
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v27/x/gamm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,3 +47,39 @@ func (p *poolsUseCase) SetPoolAPRAndFeeDataIfConfigured(pool sqsdomain.PoolI, op
 func (p *poolsUseCase) CalcExitPool(ctx sdk.Context, pool types.CFMMPoolI, exitingSharesIn osmomath.Int, exitFee osmomath.Dec) (sdk.Coins, error) {
 	return calcExitPool(ctx, pool, exitingSharesIn, exitFee)
 }
+
+func ComputeConcentratedDepthChart(pool *concentratedmodel.Pool, tickModel *sqsdomain.TickModel, depthPercents []osmomath.Dec, priceScalingFactor, token0ScalingFactor, token1ScalingFactor osmomath.Dec) (domain.ConcentratedDepthChart, error) {
+	return computeConcentratedDepthChart(pool, tickModel, depthPercents, priceScalingFactor, token0ScalingFactor, token1ScalingFactor)
+}
+
+func SimulateConcentratedPosition(pool *concentratedmodel.Pool, tickModel *sqsdomain.TickModel, lowerPrice, upperPrice osmomath.BigDec, tokenIn sdk.Coin) (domain.ConcentratedPositionSimulation, error) {
+	return simulateConcentratedPosition(pool, tickModel, lowerPrice, upperPrice, tokenIn)
+}
+
+func EstimateConcentratedPositionFeeAPR(simulation domain.ConcentratedPositionSimulation, pool *concentratedmodel.Pool, balances sdk.Coins, liquidityCap osmomath.Int, feesSpent7d float64) (osmomath.Dec, error) {
+	return estimateConcentratedPositionFeeAPR(simulation, pool, balances, liquidityCap, feesSpent7d)
+}
+
+func PriceRangeToTicks(lowerPrice, upperPrice osmomath.BigDec, tickSpacing int64) (int64, int64, error) {
+	return priceRangeToTicks(lowerPrice, upperPrice, tickSpacing)
+}
+
+func CalcJoinPool(ctx sdk.Context, pool types.CFMMPoolI, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error) {
+	return calcJoinPool(ctx, pool, tokensIn)
+}
+
+func FilterWhere(where string) (func(pool sqsdomain.PoolI) bool, error) {
+	program, err := compileWhereFilter(where)
+	if err != nil {
+		return nil, err
+	}
+	return filterWhere(program), nil
+}
+
+func DownsamplePoolHistory(samples []domain.PoolHistorySample, maxPoints int) []domain.PoolHistorySample {
+	return downsamplePoolHistory(samples, maxPoints)
+}
+
+func ComputeConcentratedPositionAssets(pool *concentratedmodel.Pool, lowerTick, upperTick int64, liquidity osmomath.Dec) (sdk.Coins, error) {
+	return computeConcentratedPositionAssets(pool, lowerTick, upperTick, liquidity)
+}
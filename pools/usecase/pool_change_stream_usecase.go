@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/ringbuffer"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+// maxPendingBlocks is the number of blocks processed ahead of a missing height
+// after which the missing heights are considered skipped and a gap event is emitted for them.
+const maxPendingBlocks = 4
+
+type poolChangeStreamUseCase struct {
+	config               domain.PoolChangeStreamConfig
+	poolsUsecase         mvc.PoolsUsecase
+	stateSnapshotUsecase mvc.StateSnapshotUsecase
+
+	// mx guards the fields below.
+	mx sync.Mutex
+	// events are the pool changes of the most recent blocks ordered by height.
+	events *ringbuffer.RingBuffer[domain.PoolChangesEvent]
+	// pending are the events of the blocks processed ahead of the next height by height.
+	// The end of consecutive blocks may be processed concurrently and out of order.
+	pending          map[uint64]domain.PoolChangesEvent
+	latestHeight     uint64
+	subscribers      map[uint64]*poolChangesSubscriber
+	nextSubscriberID uint64
+
+	logger log.Logger
+}
+
+// poolChangesSubscriber is a subscriber to the pool changes.
+type poolChangesSubscriber struct {
+	filter domain.PoolChangesFilter
+	// fromHeight is the height below which the events are not pushed to the subscriber.
+	fromHeight uint64
+	ch         chan domain.PoolChangesEvent
+}
+
+var (
+	_ mvc.PoolChangeStreamUsecase  = &poolChangeStreamUseCase{}
+	_ domain.EndBlockProcessPlugin = &poolChangeStreamUseCase{}
+)
+
+// NewPoolChangeStreamUsecase returns a new pool change stream usecase.
+// The pool changes are read from the state snapshots of the processed blocks.
+func NewPoolChangeStreamUsecase(config domain.PoolChangeStreamConfig, poolsUsecase mvc.PoolsUsecase, stateSnapshotUsecase mvc.StateSnapshotUsecase, logger log.Logger) *poolChangeStreamUseCase {
+	return &poolChangeStreamUseCase{
+		config:               config,
+		poolsUsecase:         poolsUsecase,
+		stateSnapshotUsecase: stateSnapshotUsecase,
+
+		events:      ringbuffer.New[domain.PoolChangesEvent](config.RetainedBlocks),
+		pending:     make(map[uint64]domain.PoolChangesEvent),
+		subscribers: make(map[uint64]*poolChangesSubscriber),
+
+		logger: logger,
+	}
+}
+
+// ProcessEndBlock implements domain.EndBlockProcessPlugin.
+// It retains the pools updated within the block and pushes them to the subscribers in height order.
+// Blocks processed ahead of the next height are buffered until the next height is processed.
+// If more than maxPendingBlocks blocks are buffered, the missing heights are skipped with a gap event.
+// Subscribers that are not keeping up are disconnected.
+// Returns an error if the block is older than the latest pushed block or already processed.
+func (p *poolChangeStreamUseCase) ProcessEndBlock(ctx context.Context, blockHeight uint64, metadata domain.BlockPoolMetadata) error {
+	event := p.getPoolChangesEvent(ctx, blockHeight, metadata.PoolIDs)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if _, ok := p.pending[blockHeight]; ok || blockHeight <= p.latestHeight {
+		return fmt.Errorf("pool changes at height (%d) are processed after the changes at height (%d)", blockHeight, p.latestHeight)
+	}
+
+	p.pending[blockHeight] = event
+
+	p.emitPending()
+
+	return nil
+}
+
+// emitPending pushes the pending events in height order, starting from the height following the latest pushed one.
+// The first event ever processed is pushed right away.
+// CONTRACT: the caller holds the lock.
+func (p *poolChangeStreamUseCase) emitPending() {
+	for len(p.pending) > 0 {
+		nextHeight := p.latestHeight + 1
+
+		event, ok := p.pending[nextHeight]
+		if !ok {
+			if p.latestHeight != 0 && len(p.pending) <= maxPendingBlocks {
+				// Wait for the next height to be processed.
+				return
+			}
+
+			// Skip to the lowest pending height.
+			lowestPendingHeight := uint64(math.MaxUint64)
+			for height := range p.pending {
+				if height < lowestPendingHeight {
+					lowestPendingHeight = height
+				}
+			}
+
+			if p.latestHeight != 0 {
+				p.logger.Warn("skipping pool changes of missing heights", zap.Uint64("from_height", nextHeight), zap.Uint64("to_height", lowestPendingHeight-1))
+				p.emit(domain.PoolChangesEvent{
+					Height: lowestPendingHeight - 1,
+					Pools:  []domain.PoolChange{},
+					Gap:    &domain.PoolChangesGap{FromHeight: nextHeight},
+				})
+			}
+
+			nextHeight = lowestPendingHeight
+			event = p.pending[nextHeight]
+		}
+
+		delete(p.pending, nextHeight)
+		p.emit(event)
+	}
+}
+
+// emit retains the given event and pushes it to the subscribers.
+// CONTRACT: the caller holds the lock and the event height is greater than the latest height.
+func (p *poolChangeStreamUseCase) emit(event domain.PoolChangesEvent) {
+	p.events.Push(event)
+	p.latestHeight = event.Height
+
+	for id, subscriber := range p.subscribers {
+		p.push(id, subscriber, event)
+	}
+}
+
+// Subscribe implements mvc.PoolChangeStreamUsecase.
+func (p *poolChangeStreamUseCase) Subscribe(ctx context.Context, filter domain.PoolChangesFilter, fromHeight uint64) (<-chan domain.PoolChangesEvent, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if fromHeight > 0 && p.latestHeight == 0 {
+		// Nothing is retained yet so the changes preceding the first processed block would be missed.
+		return nil, domain.PoolChangesHeightNotRetainedError{Height: fromHeight}
+	}
+
+	var backlog []domain.PoolChangesEvent
+	if fromHeight > 0 && fromHeight <= p.latestHeight {
+		events := p.events.Items()
+		if oldestHeight := events[0].Height; fromHeight < oldestHeight {
+			return nil, domain.PoolChangesHeightNotRetainedError{Height: fromHeight, OldestRetainedHeight: oldestHeight}
+		}
+
+		// Find the first retained event at or above the height.
+		start := sort.Search(len(events), func(i int) bool { return events[i].Height >= fromHeight })
+		backlog = events[start:]
+	}
+
+	subscriber := &poolChangesSubscriber{
+		filter:     filter,
+		fromHeight: fromHeight,
+		// Buffer the backlog in addition to the configured size so that pushing it never blocks.
+		ch: make(chan domain.PoolChangesEvent, len(backlog)+p.config.SubscriberBufferSize),
+	}
+
+	id := p.nextSubscriberID
+	p.nextSubscriberID++
+	p.subscribers[id] = subscriber
+
+	for _, event := range backlog {
+		p.push(id, subscriber, event)
+	}
+
+	go func() {
+		<-ctx.Done()
+		p.unsubscribe(id)
+	}()
+
+	return subscriber.ch, nil
+}
+
+// push pushes the given event to the given subscriber if it matches the subscriber filter.
+// Disconnects the subscriber if its buffer is full.
+// CONTRACT: the caller holds the lock.
+func (p *poolChangeStreamUseCase) push(id uint64, subscriber *poolChangesSubscriber, event domain.PoolChangesEvent) {
+	if event.Height < subscriber.fromHeight {
+		return
+	}
+
+	event, ok := subscriber.filter.Filter(event)
+	if !ok {
+		return
+	}
+
+	select {
+	case subscriber.ch <- event:
+	default:
+		p.logger.Info("disconnecting pool changes subscriber falling behind", zap.Uint64("height", event.Height))
+		delete(p.subscribers, id)
+		close(subscriber.ch)
+	}
+}
+
+// unsubscribe removes the subscriber with the given ID and closes its channel.
+// No-op if the subscriber has already been disconnected.
+func (p *poolChangeStreamUseCase) unsubscribe(id uint64) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	subscriber, ok := p.subscribers[id]
+	if !ok {
+		return
+	}
+
+	delete(p.subscribers, id)
+	close(subscriber.ch)
+}
+
+// getPoolChangesEvent returns the event containing the state of the given pools at the block height sorted by pool ID.
+// Pools that fail to be read are skipped.
+// Returns a gap event for the height if the state snapshot of the height is no longer retained.
+func (p *poolChangeStreamUseCase) getPoolChangesEvent(ctx context.Context, blockHeight uint64, poolIDs map[uint64]struct{}) domain.PoolChangesEvent {
+	snapshot, ok := p.stateSnapshotUsecase.GetStateSnapshot(blockHeight)
+	if !ok {
+		p.logger.Warn("state snapshot of pool changes is not retained", zap.Uint64("height", blockHeight))
+		return domain.PoolChangesEvent{
+			Height: blockHeight,
+			Pools:  []domain.PoolChange{},
+			Gap:    &domain.PoolChangesGap{FromHeight: blockHeight},
+		}
+	}
+
+	// Read the spot prices from the same snapshot.
+	ctx = domain.ContextWithStateSnapshot(ctx, snapshot)
+
+	sortedPoolIDs := make([]uint64, 0, len(poolIDs))
+	for poolID := range poolIDs {
+		sortedPoolIDs = append(sortedPoolIDs, poolID)
+	}
+	sort.Slice(sortedPoolIDs, func(i, j int) bool { return sortedPoolIDs[i] < sortedPoolIDs[j] })
+
+	changes := make([]domain.PoolChange, 0, len(sortedPoolIDs))
+	for _, poolID := range sortedPoolIDs {
+		pool, err := snapshot.GetPool(poolID)
+		if err != nil {
+			p.logger.Debug("failed to get updated pool", zap.Uint64("pool_id", poolID), zap.Error(err))
+			continue
+		}
+
+		changes = append(changes, domain.PoolChange{
+			PoolID:       poolID,
+			Type:         pool.GetType(),
+			Denoms:       pool.GetPoolDenoms(),
+			Balances:     pool.GetSQSPoolModel().Balances,
+			LiquidityCap: pool.GetLiquidityCap(),
+			SpotPrice:    getPoolSpotPriceOrZero(ctx, p.poolsUsecase, pool, p.logger),
+		})
+	}
+
+	return domain.PoolChangesEvent{
+		Height: blockHeight,
+		Pools:  changes,
+	}
+}
@@ -0,0 +1,288 @@
+package usecase_test
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/pools/usecase"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// TestPoolChangeStream validates that the pool changes are pushed to the subscribers matching their filters,
+// that the subscribers can resume from the retained heights and that slow subscribers are disconnected.
+func (s *PoolsUsecaseTestSuite) TestPoolChangeStream() {
+	const otherPoolID = defaultPoolID + 1
+
+	var (
+		spotPrice = osmomath.MustNewBigDecFromStr("2.5")
+
+		poolsByID = map[uint64]sqsdomain.PoolI{
+			defaultPoolID: &mocks.MockRoutablePool{
+				ID:               defaultPoolID,
+				PoolType:         poolmanagertypes.Balancer,
+				Denoms:           []string{denomOne, denomTwo},
+				PoolLiquidityCap: osmomath.NewInt(1_000),
+			},
+			otherPoolID: &mocks.MockRoutablePool{
+				ID:               otherPoolID,
+				PoolType:         poolmanagertypes.Balancer,
+				Denoms:           []string{denomTwo, denomThree},
+				PoolLiquidityCap: osmomath.NewInt(2_000),
+			},
+		}
+	)
+
+	poolsUsecase := &mocks.PoolsUsecaseMock{
+		GetPoolSpotPriceFunc: func(ctx context.Context, poolID uint64, takerFee osmomath.Dec, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
+			return spotPrice, nil
+		},
+	}
+
+	// The pools are read from the state snapshot of the block height.
+	// No snapshot is retained for the heights from missingSnapshotHeight.
+	const missingSnapshotHeight = 100
+	stateSnapshotUsecase := &mocks.StateSnapshotUsecaseMock{
+		GetStateSnapshotFunc: func(height uint64) (*domain.StateSnapshot, bool) {
+			if height >= missingSnapshotHeight {
+				return nil, false
+			}
+
+			pools := make([]sqsdomain.PoolI, 0, len(poolsByID))
+			for _, pool := range poolsByID {
+				pools = append(pools, pool)
+			}
+			return domain.NewStateSnapshot(height, pools, nil, nil, nil), true
+		},
+	}
+
+	config := domain.PoolChangeStreamConfig{
+		Enabled:              true,
+		RetainedBlocks:       2,
+		SubscriberBufferSize: 1,
+	}
+
+	updated := func(poolIDs ...uint64) domain.BlockPoolMetadata {
+		metadata := domain.BlockPoolMetadata{PoolIDs: map[uint64]struct{}{}}
+		for _, poolID := range poolIDs {
+			metadata.PoolIDs[poolID] = struct{}{}
+		}
+		return metadata
+	}
+
+	// receive returns the heights and pool IDs of the events buffered in the given channel.
+	receive := func(events <-chan domain.PoolChangesEvent) map[uint64][]uint64 {
+		received := map[uint64][]uint64{}
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return received
+				}
+				for _, change := range event.Pools {
+					received[event.Height] = append(received[event.Height], change.PoolID)
+				}
+			default:
+				return received
+			}
+		}
+	}
+
+	// receiveEvents returns the events buffered in the given channel in order.
+	receiveEvents := func(events <-chan domain.PoolChangesEvent) []domain.PoolChangesEvent {
+		var received []domain.PoolChangesEvent
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return received
+				}
+				received = append(received, event)
+			default:
+				return received
+			}
+		}
+	}
+
+	// heightsAndGaps returns the heights of the given events with the from heights of the gap events.
+	heightsAndGaps := func(events []domain.PoolChangesEvent) [][2]uint64 {
+		result := make([][2]uint64, 0, len(events))
+		for _, event := range events {
+			var gapFromHeight uint64
+			if event.Gap != nil {
+				gapFromHeight = event.Gap.FromHeight
+			}
+			result = append(result, [2]uint64{event.Height, gapFromHeight})
+		}
+		return result
+	}
+
+	s.Run("resume from retained heights with filters", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		// Nothing is retained yet.
+		_, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 10)
+		s.Require().ErrorAs(err, &domain.PoolChangesHeightNotRetainedError{})
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 10, updated(defaultPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 11, updated(defaultPoolID, otherPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 12, updated(otherPoolID)))
+
+		// Out of order block.
+		s.Require().Error(poolChangeStreamUsecase.ProcessEndBlock(ctx, 12, updated(otherPoolID)))
+
+		// Height 10 is no longer retained.
+		_, err = poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 10)
+		s.Require().ErrorAs(err, &domain.PoolChangesHeightNotRetainedError{})
+
+		all, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 11)
+		s.Require().NoError(err)
+
+		byDenom, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{Denoms: []string{denomOne}}, 11)
+		s.Require().NoError(err)
+
+		byPoolID, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{PoolIDs: []uint64{otherPoolID}}, 0)
+		s.Require().NoError(err)
+
+		s.Require().Equal(map[uint64][]uint64{11: {defaultPoolID, otherPoolID}, 12: {otherPoolID}}, receive(all))
+		s.Require().Equal(map[uint64][]uint64{11: {defaultPoolID}}, receive(byDenom))
+		s.Require().Empty(receive(byPoolID))
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 13, updated(defaultPoolID, otherPoolID)))
+
+		s.Require().Equal(map[uint64][]uint64{13: {otherPoolID}}, receive(byPoolID))
+	})
+
+	s.Run("pool change contents", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		events, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 0)
+		s.Require().NoError(err)
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 10, updated(defaultPoolID)))
+
+		event := <-events
+		s.Require().Equal(domain.PoolChangesEvent{
+			Height: 10,
+			Pools: []domain.PoolChange{
+				{
+					PoolID:       defaultPoolID,
+					Type:         poolmanagertypes.Balancer,
+					Denoms:       []string{denomOne, denomTwo},
+					LiquidityCap: osmomath.NewInt(1_000),
+					SpotPrice:    spotPrice,
+				},
+			},
+		}, event)
+	})
+
+	s.Run("out of order blocks are pushed in height order", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(domain.PoolChangeStreamConfig{
+			Enabled:              true,
+			RetainedBlocks:       10,
+			SubscriberBufferSize: 10,
+		}, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		events, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 0)
+		s.Require().NoError(err)
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 10, updated(defaultPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 12, updated(defaultPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 13, updated(defaultPoolID)))
+
+		// Height 12 and 13 are pending until height 11 is processed.
+		s.Require().Equal([][2]uint64{{10, 0}}, heightsAndGaps(receiveEvents(events)))
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 11, updated(defaultPoolID)))
+
+		s.Require().Equal([][2]uint64{{11, 0}, {12, 0}, {13, 0}}, heightsAndGaps(receiveEvents(events)))
+
+		// Already pushed.
+		s.Require().Error(poolChangeStreamUsecase.ProcessEndBlock(ctx, 11, updated(defaultPoolID)))
+	})
+
+	s.Run("missing heights are skipped with a gap event", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(domain.PoolChangeStreamConfig{
+			Enabled:              true,
+			RetainedBlocks:       10,
+			SubscriberBufferSize: 10,
+		}, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		// The gap events match any filter.
+		events, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{PoolIDs: []uint64{otherPoolID}}, 0)
+		s.Require().NoError(err)
+
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 10, updated(otherPoolID)))
+
+		// Heights 11 and 12 are never processed.
+		for height := uint64(13); height <= 17; height++ {
+			s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, height, updated(otherPoolID)))
+		}
+
+		s.Require().Equal([][2]uint64{{10, 0}, {12, 11}, {13, 0}, {14, 0}, {15, 0}, {16, 0}, {17, 0}}, heightsAndGaps(receiveEvents(events)))
+
+		// Skipped.
+		s.Require().Error(poolChangeStreamUsecase.ProcessEndBlock(ctx, 11, updated(otherPoolID)))
+
+		// The snapshot of the height is not retained.
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 18, updated(otherPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, missingSnapshotHeight, updated(otherPoolID)))
+
+		for i := 0; i < 5; i++ {
+			s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, missingSnapshotHeight+uint64(i)+1, updated()))
+		}
+
+		s.Require().Equal([][2]uint64{{18, 0}, {99, 19}, {missingSnapshotHeight, missingSnapshotHeight}, {101, 101}, {102, 102}, {103, 103}, {104, 104}, {105, 105}}, heightsAndGaps(receiveEvents(events)))
+	})
+
+	s.Run("slow subscriber is disconnected", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		events, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 0)
+		s.Require().NoError(err)
+
+		// The second event exceeds the buffer of one event.
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 10, updated(defaultPoolID)))
+		s.Require().NoError(poolChangeStreamUsecase.ProcessEndBlock(ctx, 11, updated(defaultPoolID)))
+
+		event, ok := <-events
+		s.Require().True(ok)
+		s.Require().Equal(uint64(10), event.Height)
+
+		_, ok = <-events
+		s.Require().False(ok)
+	})
+
+	s.Run("subscriber is removed once the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		poolChangeStreamUsecase := usecase.NewPoolChangeStreamUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+
+		events, err := poolChangeStreamUsecase.Subscribe(ctx, domain.PoolChangesFilter{}, 0)
+		s.Require().NoError(err)
+
+		cancel()
+
+		_, ok := <-events
+		s.Require().False(ok)
+	})
+}
@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v27/x/cosmwasmpool/model"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/ringbuffer"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/router/usecase/routertesting/parsing"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+type poolHistoryUseCase struct {
+	config               domain.PoolHistoryConfig
+	poolsUsecase         mvc.PoolsUsecase
+	stateSnapshotUsecase mvc.StateSnapshotUsecase
+
+	// historyMx guards history.
+	historyMx sync.RWMutex
+	history   map[uint64]*ringbuffer.RingBuffer[domain.PoolHistorySample]
+
+	// processMx guards the fields below since the ingester may process
+	// the end of consecutive blocks concurrently.
+	processMx sync.Mutex
+	// updatedPoolIDs are the pools updated since the last sample.
+	updatedPoolIDs    map[uint64]struct{}
+	lastSampleHeight  uint64
+	lastPersistHeight uint64
+
+	// persistMx serializes the writes of the state file.
+	persistMx sync.Mutex
+
+	timeNowUnixSeconds func() int64
+
+	logger log.Logger
+}
+
+var (
+	_ mvc.PoolHistoryUsecase       = &poolHistoryUseCase{}
+	_ domain.EndBlockProcessPlugin = &poolHistoryUseCase{}
+)
+
+// NewPoolHistoryUsecase returns a new pool history usecase.
+// If the state file is configured and exists, the history is restored from it.
+func NewPoolHistoryUsecase(config domain.PoolHistoryConfig, poolsUsecase mvc.PoolsUsecase, stateSnapshotUsecase mvc.StateSnapshotUsecase, logger log.Logger) (*poolHistoryUseCase, error) {
+	p := &poolHistoryUseCase{
+		config:               config,
+		poolsUsecase:         poolsUsecase,
+		stateSnapshotUsecase: stateSnapshotUsecase,
+
+		history:        make(map[uint64]*ringbuffer.RingBuffer[domain.PoolHistorySample]),
+		updatedPoolIDs: make(map[uint64]struct{}),
+
+		timeNowUnixSeconds: func() int64 { return time.Now().Unix() },
+
+		logger: logger,
+	}
+
+	if config.StateFile == "" {
+		return p, nil
+	}
+
+	poolHistory, err := parsing.ReadPoolHistory(config.StateFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return p, nil
+		}
+		return nil, err
+	}
+
+	for _, pool := range poolHistory {
+		samples := p.getOrCreatePoolHistory(pool.PoolID)
+		for _, sample := range pool.Samples {
+			samples.Push(sample)
+		}
+	}
+
+	logger.Info("restored pool history", zap.String("state_file", config.StateFile), zap.Int("num_pools", len(poolHistory)))
+
+	return p, nil
+}
+
+// ProcessEndBlock implements domain.EndBlockProcessPlugin.
+// It samples the pools updated since the last sample once every configured number of blocks
+// and persists the history once every configured number of blocks.
+// The pool updates of blocks processed out of order are carried over to the next sample.
+func (p *poolHistoryUseCase) ProcessEndBlock(ctx context.Context, blockHeight uint64, metadata domain.BlockPoolMetadata) error {
+	p.processMx.Lock()
+	defer p.processMx.Unlock()
+
+	for poolID := range metadata.PoolIDs {
+		p.updatedPoolIDs[poolID] = struct{}{}
+	}
+
+	if p.lastSampleHeight == 0 || blockHeight >= p.lastSampleHeight+p.config.SampleIntervalBlocks {
+		p.samplePools(ctx, blockHeight)
+	}
+
+	if p.config.StateFile != "" && blockHeight >= p.lastPersistHeight+p.config.PersistIntervalBlocks {
+		p.lastPersistHeight = blockHeight
+
+		if err := p.StorePoolHistory(); err != nil {
+			p.logger.Error("failed to store pool history", zap.Uint64("height", blockHeight), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// GetPoolHistory implements mvc.PoolHistoryUsecase.
+func (p *poolHistoryUseCase) GetPoolHistory(poolID uint64, fromHeight, toHeight uint64, maxPoints int) ([]domain.PoolHistorySample, error) {
+	if _, err := p.poolsUsecase.GetPool(poolID); err != nil {
+		return nil, err
+	}
+
+	p.historyMx.RLock()
+	history, ok := p.history[poolID]
+	var samples []domain.PoolHistorySample
+	if ok {
+		samples = history.Items()
+	}
+	p.historyMx.RUnlock()
+
+	result := make([]domain.PoolHistorySample, 0, len(samples))
+	for _, sample := range samples {
+		if sample.Height < fromHeight || (toHeight > 0 && sample.Height > toHeight) {
+			continue
+		}
+		result = append(result, sample)
+	}
+
+	return downsamplePoolHistory(result, maxPoints), nil
+}
+
+// StorePoolHistory implements mvc.PoolHistoryUsecase.
+func (p *poolHistoryUseCase) StorePoolHistory() error {
+	if p.config.StateFile == "" {
+		return nil
+	}
+
+	p.historyMx.RLock()
+	poolHistory := make([]domain.PoolHistory, 0, len(p.history))
+	for poolID, history := range p.history {
+		poolHistory = append(poolHistory, domain.PoolHistory{
+			PoolID:  poolID,
+			Samples: history.Items(),
+		})
+	}
+	p.historyMx.RUnlock()
+
+	p.persistMx.Lock()
+	defer p.persistMx.Unlock()
+
+	return parsing.StorePoolHistory(poolHistory, p.config.StateFile)
+}
+
+// samplePools samples the pools updated since the last sample from the state snapshot of the given height.
+// Pools that fail to be sampled are skipped.
+// If the state snapshot of the height is no longer retained, the pool updates are carried over to the next block.
+func (p *poolHistoryUseCase) samplePools(ctx context.Context, blockHeight uint64) {
+	snapshot, ok := p.stateSnapshotUsecase.GetStateSnapshot(blockHeight)
+	if !ok {
+		p.logger.Warn("state snapshot of pool history sample is not retained", zap.Uint64("height", blockHeight))
+		return
+	}
+
+	// Read the spot prices from the same snapshot.
+	ctx = domain.ContextWithStateSnapshot(ctx, snapshot)
+
+	timestamp := p.timeNowUnixSeconds()
+
+	samples := make(map[uint64]domain.PoolHistorySample, len(p.updatedPoolIDs))
+	for poolID := range p.updatedPoolIDs {
+		sample, err := p.samplePool(ctx, snapshot, poolID, blockHeight, timestamp)
+		if err != nil {
+			p.logger.Debug("failed to sample pool", zap.Uint64("pool_id", poolID), zap.Error(err))
+			continue
+		}
+		samples[poolID] = sample
+	}
+
+	p.historyMx.Lock()
+	for poolID, sample := range samples {
+		p.getOrCreatePoolHistory(poolID).Push(sample)
+	}
+	p.historyMx.Unlock()
+
+	p.updatedPoolIDs = make(map[uint64]struct{})
+	p.lastSampleHeight = blockHeight
+}
+
+// samplePool returns the sample of the given pool from the state snapshot of the given height.
+func (p *poolHistoryUseCase) samplePool(ctx context.Context, snapshot *domain.StateSnapshot, poolID uint64, blockHeight uint64, timestamp int64) (domain.PoolHistorySample, error) {
+	pool, err := snapshot.GetPool(poolID)
+	if err != nil {
+		return domain.PoolHistorySample{}, err
+	}
+
+	sample := domain.PoolHistorySample{
+		Height:       blockHeight,
+		Timestamp:    timestamp,
+		LiquidityCap: pool.GetLiquidityCap(),
+		Balances:     pool.GetSQSPoolModel().Balances,
+	}
+
+	if concentratedPool, ok := pool.GetUnderlyingPool().(*concentratedmodel.Pool); ok {
+		currentTick := concentratedPool.CurrentTick
+		sample.CurrentTick = &currentTick
+	}
+
+	sample.SpotPrice = getPoolSpotPriceOrZero(ctx, p.poolsUsecase, pool, p.logger)
+
+	return sample, nil
+}
+
+// getPoolSpotPriceOrZero returns the spot price of the first pool denom in terms of the second pool denom
+// without the taker fee. Returns zero if it fails to be computed or if the pool is a general CosmWasm pool
+// since computing it would require querying the chain.
+func getPoolSpotPriceOrZero(ctx context.Context, poolsUsecase mvc.PoolsUsecase, pool sqsdomain.PoolI, logger log.Logger) osmomath.BigDec {
+	denoms := pool.GetPoolDenoms()
+	if len(denoms) < 2 || isGeneralCosmWasmPool(poolsUsecase, pool) {
+		return osmomath.ZeroBigDec()
+	}
+
+	spotPrice, err := poolsUsecase.GetPoolSpotPrice(ctx, pool.GetId(), osmomath.ZeroDec(), denoms[1], denoms[0])
+	if err != nil {
+		logger.Debug("failed to compute pool spot price", zap.Uint64("pool_id", pool.GetId()), zap.Error(err))
+		return osmomath.ZeroBigDec()
+	}
+
+	return spotPrice
+}
+
+// isGeneralCosmWasmPool returns true if the given pool is a general CosmWasm pool.
+func isGeneralCosmWasmPool(poolsUsecase mvc.PoolsUsecase, pool sqsdomain.PoolI) bool {
+	cosmWasmPool, ok := pool.GetUnderlyingPool().(*cwpoolmodel.CosmWasmPool)
+	if !ok {
+		return false
+	}
+
+	_, isGeneralCosmWasmPool := poolsUsecase.GetCosmWasmPoolConfig().GeneralCosmWasmCodeIDs[cosmWasmPool.CodeId]
+	return isGeneralCosmWasmPool
+}
+
+// getOrCreatePoolHistory returns the history of the given pool, creating it if it does not exist.
+// CONTRACT: the caller holds the history write lock or has exclusive access to the usecase.
+func (p *poolHistoryUseCase) getOrCreatePoolHistory(poolID uint64) *ringbuffer.RingBuffer[domain.PoolHistorySample] {
+	history, ok := p.history[poolID]
+	if !ok {
+		history = ringbuffer.New[domain.PoolHistorySample](p.config.Capacity)
+		p.history[poolID] = history
+	}
+	return history
+}
+
+// downsamplePoolHistory downsamples the given samples to at most maxPoints by splitting the height range
+// into equally-sized buckets and keeping the latest sample of each bucket.
+// Returns the samples as is if maxPoints is not positive or if they do not exceed it.
+// CONTRACT: samples are ordered by height ascending.
+func downsamplePoolHistory(samples []domain.PoolHistorySample, maxPoints int) []domain.PoolHistorySample {
+	if maxPoints <= 0 || len(samples) <= maxPoints {
+		return samples
+	}
+
+	firstHeight := samples[0].Height
+	heightRange := samples[len(samples)-1].Height - firstHeight + 1
+	bucketSize := (heightRange + uint64(maxPoints) - 1) / uint64(maxPoints)
+
+	result := make([]domain.PoolHistorySample, 0, maxPoints)
+	for i, sample := range samples {
+		isLastInBucket := i == len(samples)-1 ||
+			(samples[i+1].Height-firstHeight)/bucketSize != (sample.Height-firstHeight)/bucketSize
+		if isLastInBucket {
+			result = append(result, sample)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedmodel "github.com/osmosis-labs/osmosis/v27/x/concentrated-liquidity/model"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/pools/usecase"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// TestPoolHistory validates that the updated pools are sampled at the configured interval,
+// that the oldest samples are overwritten once the capacity is reached
+// and that the history is restored from the state file.
+func (s *PoolsUsecaseTestSuite) TestPoolHistory() {
+	const (
+		concentratedPoolID = defaultPoolID + 1
+		currentTick        = int64(100)
+	)
+
+	var (
+		spotPrice = osmomath.MustNewBigDecFromStr("2.5")
+
+		balancerPool = &mocks.MockRoutablePool{
+			ID:               defaultPoolID,
+			PoolType:         poolmanagertypes.Balancer,
+			Denoms:           []string{denomOne, denomTwo},
+			PoolLiquidityCap: osmomath.NewInt(1_000),
+		}
+		concentratedPool = &mocks.MockRoutablePool{
+			ID:               concentratedPoolID,
+			PoolType:         poolmanagertypes.Concentrated,
+			Denoms:           []string{denomOne, denomTwo},
+			PoolLiquidityCap: osmomath.NewInt(2_000),
+			ChainPoolModel:   &concentratedmodel.Pool{Id: concentratedPoolID, CurrentTick: currentTick},
+		}
+		poolsByID = map[uint64]sqsdomain.PoolI{
+			defaultPoolID:      balancerPool,
+			concentratedPoolID: concentratedPool,
+		}
+	)
+
+	poolsUsecase := &mocks.PoolsUsecaseMock{
+		GetPoolFunc: func(poolID uint64) (sqsdomain.PoolI, error) {
+			pool, ok := poolsByID[poolID]
+			if !ok {
+				return nil, domain.PoolNotFoundError{PoolID: poolID}
+			}
+			return pool, nil
+		},
+		GetPoolSpotPriceFunc: func(ctx context.Context, poolID uint64, takerFee osmomath.Dec, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
+			return spotPrice, nil
+		},
+	}
+
+	unretainedHeights := map[uint64]struct{}{}
+	stateSnapshotUsecase := &mocks.StateSnapshotUsecaseMock{
+		GetStateSnapshotFunc: func(height uint64) (*domain.StateSnapshot, bool) {
+			if _, ok := unretainedHeights[height]; ok {
+				return nil, false
+			}
+			return domain.NewStateSnapshot(height, []sqsdomain.PoolI{balancerPool, concentratedPool}, nil, nil, nil), true
+		},
+	}
+
+	config := domain.PoolHistoryConfig{
+		Enabled:               true,
+		Capacity:              3,
+		SampleIntervalBlocks:  2,
+		PersistIntervalBlocks: 1,
+		StateFile:             filepath.Join(s.T().TempDir(), "pool_history.json"),
+	}
+
+	poolHistoryUsecase, err := usecase.NewPoolHistoryUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+	s.Require().NoError(err)
+
+	updated := func(poolIDs ...uint64) domain.BlockPoolMetadata {
+		metadata := domain.BlockPoolMetadata{PoolIDs: map[uint64]struct{}{}}
+		for _, poolID := range poolIDs {
+			metadata.PoolIDs[poolID] = struct{}{}
+		}
+		return metadata
+	}
+
+	// Height 10 is sampled since it is the first block.
+	// Height 11 is not sampled but the concentrated pool update is carried over to height 12.
+	// Heights 12, 14 and 16 sample the balancer pool, overwriting its sample at height 10.
+	s.Require().NoError(poolHistoryUsecase.ProcessEndBlock(context.Background(), 10, updated(defaultPoolID)))
+	s.Require().NoError(poolHistoryUsecase.ProcessEndBlock(context.Background(), 11, updated(concentratedPoolID)))
+	for height := uint64(12); height <= 16; height++ {
+		s.Require().NoError(poolHistoryUsecase.ProcessEndBlock(context.Background(), height, updated(defaultPoolID)))
+	}
+
+	balancerHistory, err := poolHistoryUsecase.GetPoolHistory(defaultPoolID, 0, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{12, 14, 16}, sampleHeights(balancerHistory))
+	s.Require().Equal(balancerPool.PoolLiquidityCap, balancerHistory[0].LiquidityCap)
+	s.Require().Equal(spotPrice, balancerHistory[0].SpotPrice)
+	s.Require().Nil(balancerHistory[0].CurrentTick)
+
+	concentratedHistory, err := poolHistoryUsecase.GetPoolHistory(concentratedPoolID, 0, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{12}, sampleHeights(concentratedHistory))
+	s.Require().NotNil(concentratedHistory[0].CurrentTick)
+	s.Require().Equal(currentTick, *concentratedHistory[0].CurrentTick)
+
+	// Height range.
+	balancerHistory, err = poolHistoryUsecase.GetPoolHistory(defaultPoolID, 13, 15, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{14}, sampleHeights(balancerHistory))
+
+	// Pool not found.
+	_, err = poolHistoryUsecase.GetPoolHistory(defaultPoolID+2, 0, 0, 0)
+	s.Require().ErrorAs(err, &domain.PoolNotFoundError{})
+
+	// Restored from the state file.
+	restoredPoolHistoryUsecase, err := usecase.NewPoolHistoryUsecase(config, poolsUsecase, stateSnapshotUsecase, &log.NoOpLogger{})
+	s.Require().NoError(err)
+
+	restoredBalancerHistory, err := restoredPoolHistoryUsecase.GetPoolHistory(defaultPoolID, 0, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{12, 14, 16}, sampleHeights(restoredBalancerHistory))
+
+	// Height 18 is not sampled since its state snapshot is no longer retained
+	// but the concentrated pool update is carried over to height 19.
+	unretainedHeights[18] = struct{}{}
+	s.Require().NoError(poolHistoryUsecase.ProcessEndBlock(context.Background(), 18, updated(concentratedPoolID)))
+	s.Require().NoError(poolHistoryUsecase.ProcessEndBlock(context.Background(), 19, updated()))
+
+	concentratedHistory, err = poolHistoryUsecase.GetPoolHistory(concentratedPoolID, 0, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{12, 19}, sampleHeights(concentratedHistory))
+}
+
+// TestDownsamplePoolHistory validates that the samples are downsampled to the latest sample of each height bucket.
+func (s *PoolsUsecaseTestSuite) TestDownsamplePoolHistory() {
+	samplesAt := func(heights ...uint64) []domain.PoolHistorySample {
+		samples := make([]domain.PoolHistorySample, 0, len(heights))
+		for _, height := range heights {
+			samples = append(samples, domain.PoolHistorySample{Height: height})
+		}
+		return samples
+	}
+
+	tests := []struct {
+		name      string
+		heights   []uint64
+		maxPoints int
+
+		expectedHeights []uint64
+	}{
+		{
+			name:            "no max points",
+			heights:         []uint64{1, 2, 3},
+			maxPoints:       0,
+			expectedHeights: []uint64{1, 2, 3},
+		},
+		{
+			name:            "fewer samples than max points",
+			heights:         []uint64{1, 2, 3},
+			maxPoints:       5,
+			expectedHeights: []uint64{1, 2, 3},
+		},
+		{
+			name:            "evenly spaced samples",
+			heights:         []uint64{1, 2, 3, 4, 5, 6},
+			maxPoints:       3,
+			expectedHeights: []uint64{2, 4, 6},
+		},
+		{
+			name:            "unevenly spaced samples",
+			heights:         []uint64{1, 2, 3, 10, 20},
+			maxPoints:       2,
+			expectedHeights: []uint64{10, 20},
+		},
+		{
+			name:            "single point",
+			heights:         []uint64{1, 5, 9},
+			maxPoints:       1,
+			expectedHeights: []uint64{9},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual := usecase.DownsamplePoolHistory(samplesAt(tc.heights...), tc.maxPoints)
+
+			s.Require().Equal(tc.expectedHeights, sampleHeights(actual))
+		})
+	}
+}
+
+// sampleHeights returns the heights of the given samples.
+func sampleHeights(samples []domain.PoolHistorySample) []uint64 {
+	heights := make([]uint64, 0, len(samples))
+	for _, sample := range samples {
+		heights = append(heights, sample.Height)
+	}
+	return heights
+}
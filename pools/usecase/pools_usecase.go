@@ -29,6 +29,7 @@ import (
 	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/pools"
 
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/expression"
 	"github.com/osmosis-labs/sqs/domain/mvc"
 	routerrepo "github.com/osmosis-labs/sqs/router/repository"
 	"github.com/osmosis-labs/sqs/router/usecase/pools"
@@ -45,6 +46,7 @@ import (
 
 type TokenMetadataHolder interface {
 	GetMetadataByChainDenom(denom string) (domain.Token, error)
+	GetSpotPriceScalingFactorByDenom(baseDenom, quoteDenom string) (osmomath.Dec, error)
 }
 
 type orderBookEntry struct {
@@ -61,15 +63,26 @@ type poolsUseCase struct {
 	canonicalOrderBookForBaseQuoteDenom sync.Map
 	canonicalOrderbookPoolIDs           sync.Map
 
-	cosmWasmPoolsParams cosmwasmdomain.CosmWasmPoolsParams
+	// alloyedPoolIDs are the IDs of the alloyed transmuter pools by alloyed denom.
+	alloyedPoolIDs sync.Map
+
+	// cosmWasmPoolsParamsMx guards the code IDs of the CosmWasm pools config
+	// that may be updated at runtime.
+	cosmWasmPoolsParamsMx sync.RWMutex
+	cosmWasmPoolsParams   cosmwasmdomain.CosmWasmPoolsParams
 
 	aprPrefetcher      datafetchers.MapFetcher[uint64, sqspassthroughdomain.PoolAPR]
 	poolFeesPrefetcher datafetchers.MapFetcher[uint64, sqspassthroughdomain.PoolFee]
 
+	concentratedPositionFetcher domain.ConcentratedPositionFetcher
+
 	logger log.Logger
 }
 
-var _ mvc.PoolsUsecase = &poolsUseCase{}
+var (
+	_ mvc.PoolsUsecase                       = &poolsUseCase{}
+	_ domain.PoolRoutingConfigUpdateListener = &poolsUseCase{}
+)
 
 const (
 	// baseQuoteKeySeparator is the separator used to separate base and quote denom in the key.
@@ -85,26 +98,6 @@ func NewPoolsUsecase(
 	tokenMetadataHolder TokenMetadataHolder,
 	logger log.Logger,
 ) (*poolsUseCase, error) {
-	transmuterCodeIDsMap := make(map[uint64]struct{}, len(poolsConfig.TransmuterCodeIDs))
-	for _, codeID := range poolsConfig.TransmuterCodeIDs {
-		transmuterCodeIDsMap[codeID] = struct{}{}
-	}
-
-	alloyedTransmuterCodeIDsMap := make(map[uint64]struct{}, len(poolsConfig.AlloyedTransmuterCodeIDs))
-	for _, codeId := range poolsConfig.AlloyedTransmuterCodeIDs {
-		alloyedTransmuterCodeIDsMap[codeId] = struct{}{}
-	}
-
-	orderbookCodeIDsMap := make(map[uint64]struct{}, len(poolsConfig.OrderbookCodeIDs))
-	for _, codeId := range poolsConfig.OrderbookCodeIDs {
-		orderbookCodeIDsMap[codeId] = struct{}{}
-	}
-
-	generalizedCosmWasmCodeIDsMap := make(map[uint64]struct{}, len(poolsConfig.GeneralCosmWasmCodeIDs))
-	for _, codeID := range poolsConfig.GeneralCosmWasmCodeIDs {
-		generalizedCosmWasmCodeIDsMap[codeID] = struct{}{}
-	}
-
 	wasmClient, err := initializeWasmClient(chainGRPCGatewayEndpoint)
 	if err != nil {
 		return nil, err
@@ -116,13 +109,7 @@ func NewPoolsUsecase(
 		tokenMetadataHolder: tokenMetadataHolder,
 
 		cosmWasmPoolsParams: cosmwasmdomain.CosmWasmPoolsParams{
-			Config: domain.CosmWasmPoolRouterConfig{
-				TransmuterCodeIDs:        transmuterCodeIDsMap,
-				AlloyedTransmuterCodeIDs: alloyedTransmuterCodeIDsMap,
-				OrderbookCodeIDs:         orderbookCodeIDsMap,
-				GeneralCosmWasmCodeIDs:   generalizedCosmWasmCodeIDsMap,
-				ChainGRPCGatewayEndpoint: chainGRPCGatewayEndpoint,
-			},
+			Config: domain.NewCosmWasmPoolRouterConfig(*poolsConfig, chainGRPCGatewayEndpoint),
 
 			WasmClient: wasmClient,
 
@@ -150,7 +137,10 @@ func (p *poolsUseCase) GetAllPools() (pools []sqsdomain.PoolI, err error) {
 }
 
 // GetRoutesFromCandidates implements mvc.PoolsUsecase.
-func (p *poolsUseCase) GetRoutesFromCandidates(candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error) {
+func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRoutes sqsdomain.CandidateRoutes, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error) {
+	// Read pools and taker fees from the same snapshot if one is pinned in the context.
+	snapshot, hasSnapshot := domain.GetStateSnapshotFromContext(ctx)
+
 	// We track whether a route contains a generalized cosmwasm pool
 	// so that we can exclude it from split quote logic.
 	// The reason for this is that making network requests to chain is expensive.
@@ -169,18 +159,29 @@ func (p *poolsUseCase) GetRoutesFromCandidates(candidateRoutes sqsdomain.Candida
 		skipErrorRoute := false
 
 		for _, candidatePool := range candidateRoute.Pools {
-			pool, err := p.GetPool(candidatePool.ID)
+			var (
+				pool     sqsdomain.PoolI
+				takerFee osmomath.Dec
+				exists   bool
+				err      error
+			)
+			if hasSnapshot {
+				pool, err = snapshot.GetPool(candidatePool.ID)
+				takerFee, exists = snapshot.GetTakerFee(previousTokenOutDenom, candidatePool.TokenOutDenom)
+			} else {
+				pool, err = p.GetPool(candidatePool.ID)
+				takerFee, exists = p.routerRepository.GetTakerFee(previousTokenOutDenom, candidatePool.TokenOutDenom)
+			}
 			if err != nil {
 				return nil, err
 			}
 
-			// Get taker fee
-			takerFee, exists := p.routerRepository.GetTakerFee(previousTokenOutDenom, candidatePool.TokenOutDenom)
+			// Default taker fee if not found
 			if !exists {
 				takerFee = sqsdomain.DefaultTakerFee
 			}
 
-			routablePool, err := pools.NewRoutablePool(pool, candidatePool.TokenOutDenom, takerFee, p.cosmWasmPoolsParams)
+			routablePool, err := pools.NewRoutablePool(pool, candidatePool.TokenOutDenom, takerFee, p.getCosmWasmPoolsParams())
 			if err != nil {
 				skipErrorRoute = true
 				break
@@ -253,7 +254,15 @@ func (p *poolsUseCase) GetPool(poolID uint64) (sqsdomain.PoolI, error) {
 
 // GetPoolSpotPrice implements mvc.PoolsUsecase.
 func (p *poolsUseCase) GetPoolSpotPrice(ctx context.Context, poolID uint64, takerFee math.LegacyDec, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
-	pool, err := p.GetPool(poolID)
+	var (
+		pool sqsdomain.PoolI
+		err  error
+	)
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		pool, err = snapshot.GetPool(poolID)
+	} else {
+		pool, err = p.GetPool(poolID)
+	}
 	if err != nil {
 		return osmomath.BigDec{}, err
 	}
@@ -265,7 +274,7 @@ func (p *poolsUseCase) GetPoolSpotPrice(ctx context.Context, poolID uint64, take
 
 	// N.B.: Empty string for token out denom because it is irrelevant for calculating spot price.
 	// It is only relevant in the context of routing
-	routablePool, err := pools.NewRoutablePool(pool, "", takerFee, p.cosmWasmPoolsParams)
+	routablePool, err := pools.NewRoutablePool(pool, "", takerFee, p.getCosmWasmPoolsParams())
 	if err != nil {
 		return osmomath.BigDec{}, err
 	}
@@ -275,7 +284,7 @@ func (p *poolsUseCase) GetPoolSpotPrice(ctx context.Context, poolID uint64, take
 
 // IsGeneralCosmWasmCodeID implements mvc.PoolsUsecase.
 func (p *poolsUseCase) IsGeneralCosmWasmCodeID(codeId uint64) bool {
-	_, isGenneralCosmWasmCodeID := p.cosmWasmPoolsParams.Config.GeneralCosmWasmCodeIDs[codeId]
+	_, isGenneralCosmWasmCodeID := p.getCosmWasmPoolsParams().Config.GeneralCosmWasmCodeIDs[codeId]
 	return isGenneralCosmWasmCodeID
 }
 
@@ -321,49 +330,120 @@ func (p *poolsUseCase) getTicksAndSetTickModelIfConcentrated(pool sqsdomain.Pool
 }
 
 // getPoolsSortFuncs is a map of available sort functions for getPools function.
-var getPoolsSortFuncs = map[string]func(a, b sqsdomain.PoolI, desc bool) bool{
-	"id": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetId() > b.GetId()
+// Besides the fields below, pools can be sorted by any of the poolNumericFields.
+var getPoolsSortFuncs = func() map[string]func(a, b sqsdomain.PoolI, desc bool) bool {
+	sortFuncs := map[string]func(a, b sqsdomain.PoolI, desc bool) bool{
+		"id": func(a, b sqsdomain.PoolI, desc bool) bool {
+			if desc {
+				return a.GetId() > b.GetId()
+			}
+			return a.GetId() < b.GetId()
+		},
+		"totalFiatValueLocked": func(a, b sqsdomain.PoolI, desc bool) bool {
+			if desc {
+				return a.GetLiquidityCap().GT(b.GetLiquidityCap())
+			}
+			return a.GetLiquidityCap().LT(b.GetLiquidityCap())
+		},
+	}
+
+	for field, getValue := range poolNumericFields {
+		if _, ok := sortFuncs[field]; ok {
+			continue
 		}
-		return a.GetId() < b.GetId()
-	},
-	"totalFiatValueLocked": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetLiquidityCap().GT(b.GetLiquidityCap())
+
+		sortFuncs[field] = func(a, b sqsdomain.PoolI, desc bool) bool {
+			if desc {
+				return getValue(a) > getValue(b)
+			}
+			return getValue(a) < getValue(b)
 		}
-		return a.GetLiquidityCap().LT(b.GetLiquidityCap())
+	}
+
+	return sortFuncs
+}()
+
+const (
+	// marketDataFieldPrefix is the prefix of the numeric pool fields read from the fees data.
+	marketDataFieldPrefix = "market."
+	// incentivesDataFieldPrefix is the prefix of the numeric pool fields read from the APR data.
+	incentivesDataFieldPrefix = "incentives."
+)
+
+// poolNumericFields is a map of the numeric pool fields available for sorting, range filtering
+// and as where filter attributes to their getters.
+var poolNumericFields = map[string]func(pool sqsdomain.PoolI) float64{
+	"id": func(pool sqsdomain.PoolI) float64 {
+		return float64(pool.GetId())
 	},
-	"market.feesSpent7dUsd": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetFeesData().PoolFee.FeesSpent7d > b.GetFeesData().PoolFee.FeesSpent7d
-		}
-		return a.GetFeesData().PoolFee.FeesSpent7d < b.GetFeesData().PoolFee.FeesSpent7d
+	"totalFiatValueLocked": func(pool sqsdomain.PoolI) float64 {
+		return intToFloat(pool.GetLiquidityCap())
 	},
-	"market.feesSpent24hUsd": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetFeesData().PoolFee.FeesSpent24h > b.GetFeesData().PoolFee.FeesSpent24h
-		}
-		return a.GetFeesData().PoolFee.FeesSpent24h < b.GetFeesData().PoolFee.FeesSpent24h
+	"creationHeight": func(pool sqsdomain.PoolI) float64 {
+		return float64(pool.GetSQSPoolModel().CreationHeight)
 	},
-	"market.volume7dUsd": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetFeesData().PoolFee.Volume7d > b.GetFeesData().PoolFee.Volume7d
-		}
-		return a.GetFeesData().PoolFee.Volume7d < b.GetFeesData().PoolFee.Volume7d
+	"market.feesSpent7dUsd": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetFeesData().PoolFee.FeesSpent7d
 	},
-	"market.volume24hUsd": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetFeesData().PoolFee.Volume24h > b.GetFeesData().PoolFee.Volume24h
-		}
-		return a.GetFeesData().PoolFee.Volume24h < b.GetFeesData().PoolFee.Volume24h
+	"market.feesSpent24hUsd": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetFeesData().PoolFee.FeesSpent24h
 	},
-	"incentives.aprBreakdown.total.upper": func(a, b sqsdomain.PoolI, desc bool) bool {
-		if desc {
-			return a.GetAPRData().TotalAPR.Upper > b.GetAPRData().TotalAPR.Upper
-		}
-		return a.GetAPRData().TotalAPR.Upper < b.GetAPRData().TotalAPR.Upper
+	"market.volume7dUsd": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetFeesData().PoolFee.Volume7d
+	},
+	"market.volume24hUsd": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetFeesData().PoolFee.Volume24h
+	},
+	"incentives.aprBreakdown.total.lower": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().TotalAPR.Lower
+	},
+	"incentives.aprBreakdown.total.upper": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().TotalAPR.Upper
+	},
+	"incentives.aprBreakdown.swapFee.lower": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().SwapFees.Lower
+	},
+	"incentives.aprBreakdown.swapFee.upper": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().SwapFees.Upper
+	},
+	"incentives.aprBreakdown.superfluid.lower": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().SuperfluidAPR.Lower
+	},
+	"incentives.aprBreakdown.superfluid.upper": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().SuperfluidAPR.Upper
+	},
+	"incentives.aprBreakdown.osmosis.lower": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().OsmosisAPR.Lower
+	},
+	"incentives.aprBreakdown.osmosis.upper": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().OsmosisAPR.Upper
+	},
+	"incentives.aprBreakdown.boost.lower": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().BoostAPR.Lower
 	},
+	"incentives.aprBreakdown.boost.upper": func(pool sqsdomain.PoolI) float64 {
+		return pool.GetAPRData().BoostAPR.Upper
+	},
+}
+
+// poolFieldRequiresMarketData returns true if the given numeric pool field is read from the APR or fees data.
+func poolFieldRequiresMarketData(field string) bool {
+	return strings.HasPrefix(field, marketDataFieldPrefix) || strings.HasPrefix(field, incentivesDataFieldPrefix)
+}
+
+// filterRange returns a filter for the given range of a numeric pool field.
+// Bounds that are not set are ignored.
+// Returns error if the field is not supported.
+func filterRange(r *api.RangeFilter) (func(pool sqsdomain.PoolI) bool, error) {
+	getValue, ok := poolNumericFields[r.Field]
+	if !ok {
+		return nil, domain.UnsupportedPoolsRangeFilterFieldError{Field: r.Field}
+	}
+
+	return func(pool sqsdomain.PoolI) bool {
+		value := getValue(pool)
+		return (!r.HasMin || value >= r.Min) && (!r.HasMax || value <= r.Max)
+	}, nil
 }
 
 // poolFilters is a map of available filters for getPools function.
@@ -468,16 +548,49 @@ func (p *poolsUseCase) GetPools(opts ...domain.PoolsOption) ([]sqsdomain.PoolI,
 		return nil, 0, nil
 	}
 
-	transformer := pipeline.NewSyncMapTransformer[uint64, sqsdomain.PoolI](&p.pools)
+	// Compile the where filter expression and the range filters once per request.
+	var (
+		where        *expression.Program
+		rangeFilters []func(pool sqsdomain.PoolI) bool
+	)
+	if f := options.Filter; f != nil {
+		var err error
+		where, err = compileWhereFilter(f.Where)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, r := range f.Ranges {
+			rangeFilter, err := filterRange(r)
+			if err != nil {
+				return nil, 0, err
+			}
+			rangeFilters = append(rangeFilters, rangeFilter)
+		}
+	}
+
+	// Read from the pinned snapshot if provided so that all pools are from the same height.
+	poolsMap := &p.pools
+	if options.StateSnapshot != nil {
+		poolsMap = options.StateSnapshot.GetPoolsMap()
+	}
+
+	transformer := pipeline.NewSyncMapTransformer[uint64, sqsdomain.PoolI](poolsMap)
 
 	// Apply filters
 	for _, applyFilter := range poolFilters {
 		applyFilter(options.Filter, transformer)
 	}
 
-	// Set fetch APR and fees data if configured used by some sort opts below
+	// Set fetch APR and fees data if configured used by some sort opts below.
+	// The data is always set if the where filter, the range filters or the sort options reference it.
+	withMarketData := requiresMarketData(where, options)
 	transformer.Range(func(key uint64, value sqsdomain.PoolI) bool {
-		p.setPoolAPRAndFeeDataIfConfigured(value, options)
+		if withMarketData {
+			p.setPoolAPRAndFeeData(value)
+		} else {
+			p.setPoolAPRAndFeeDataIfConfigured(value, options)
+		}
 		return true
 	})
 
@@ -490,6 +603,16 @@ func (p *poolsUseCase) GetPools(opts ...domain.PoolsOption) ([]sqsdomain.PoolI,
 		})
 	}
 
+	// Filter by the where expression and the ranges.
+	// These filters are intentionally placed after setting APR and fee data
+	// as they may reference it.
+	if where != nil {
+		transformer.Filter(filterWhere(where))
+	}
+	for _, rangeFilter := range rangeFilters {
+		transformer.Filter(rangeFilter)
+	}
+
 	// TODO: pool denoms seems needs to be reversed?
 	// which one is base and which one is quote?
 	// we need to sort in format: quote/base
@@ -516,13 +639,18 @@ func (p *poolsUseCase) GetPools(opts ...domain.PoolsOption) ([]sqsdomain.PoolI,
 			}
 		}
 	}
+
+	// Break the ties by pool ID so that the order and, as a result, the cursor pagination is stable across requests.
+	sortopts = append(sortopts, func(a, b sqsdomain.PoolI) bool {
+		return getPoolsSortFuncs["id"](a, b, false)
+	})
 	transformer.Sort(sortopts...) // apply sort options
 
 	var pools []sqsdomain.PoolI
 	if pagination := options.Pagination; pagination == nil {
 		pools = transformer.Data()
 	} else {
-		iterator := pipeline.NewSyncMapIterator[uint64, sqsdomain.PoolI](&p.pools, transformer.Keys())
+		iterator := pipeline.NewSyncMapIterator[uint64, sqsdomain.PoolI](poolsMap, transformer.Keys())
 		paginator := pipeline.NewPaginator[uint64](iterator, pagination)
 		pools = paginator.GetPage()
 	}
@@ -537,9 +665,14 @@ func (p *poolsUseCase) StorePools(pools []sqsdomain.PoolI) error {
 		poolID := pool.GetId()
 		p.pools.Store(poolID, pool)
 
-		// If orderbook, update top liquidity pool for base and quote denom if it has higher liquidity capitalization.
+		// If alloyed transmuter, index the pool by its alloyed denom.
 		sqsModel := pool.GetSQSPoolModel()
 		cosmWasmPoolModel := sqsModel.CosmWasmPoolModel
+		if cosmWasmPoolModel != nil && cosmWasmPoolModel.Data.AlloyTransmuter != nil && cosmWasmPoolModel.IsAlloyTransmuter() {
+			p.alloyedPoolIDs.Store(cosmWasmPoolModel.Data.AlloyTransmuter.AlloyedDenom, poolID)
+		}
+
+		// If orderbook, update top liquidity pool for base and quote denom if it has higher liquidity capitalization.
 		if cosmWasmPoolModel != nil && cosmWasmPoolModel.Data.Orderbook != nil && cosmWasmPoolModel.IsOrderbook() {
 			baseDenom := cosmWasmPoolModel.Data.Orderbook.BaseDenom
 			quoteDenom := cosmWasmPoolModel.Data.Orderbook.QuoteDenom
@@ -694,6 +827,21 @@ func (p *poolsUseCase) RegisterPoolFeesFetcher(poolFeesFetcher datafetchers.MapF
 	p.poolFeesPrefetcher = poolFeesFetcher
 }
 
+// RegisterConcentratedPositionFetcher registers the fetcher of the concentrated liquidity positions.
+func (p *poolsUseCase) RegisterConcentratedPositionFetcher(concentratedPositionFetcher domain.ConcentratedPositionFetcher) {
+	p.concentratedPositionFetcher = concentratedPositionFetcher
+}
+
+// GetAlloyedPoolID implements mvc.PoolsUsecase.
+func (p *poolsUseCase) GetAlloyedPoolID(alloyedDenom string) (uint64, error) {
+	poolID, ok := p.alloyedPoolIDs.Load(alloyedDenom)
+	if !ok {
+		return 0, fmt.Errorf("no alloyed transmuter pool found for denom (%s)", alloyedDenom)
+	}
+
+	return poolID.(uint64), nil
+}
+
 // IsCanonicalOrderbookPool implements mvc.PoolsUsecase.
 func (p *poolsUseCase) IsCanonicalOrderbookPool(poolID uint64) bool {
 	_, exists := p.canonicalOrderbookPoolIDs.Load(poolID)
@@ -702,11 +850,55 @@ func (p *poolsUseCase) IsCanonicalOrderbookPool(poolID uint64) bool {
 
 // GetCosmWasmPoolConfig implements mvc.PoolsUsecase.
 func (p *poolsUseCase) GetCosmWasmPoolConfig() domain.CosmWasmPoolRouterConfig {
-	return p.cosmWasmPoolsParams.Config
+	return p.getCosmWasmPoolsParams().Config
+}
+
+// OnPoolRoutingConfigUpdate implements domain.PoolRoutingConfigUpdateListener.
+// It replaces the code IDs of the CosmWasm pools config.
+func (p *poolsUseCase) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	p.cosmWasmPoolsParamsMx.Lock()
+	defer p.cosmWasmPoolsParamsMx.Unlock()
+
+	p.cosmWasmPoolsParams.Config = domain.NewCosmWasmPoolRouterConfig(config.GetPoolsConfig(), p.cosmWasmPoolsParams.Config.ChainGRPCGatewayEndpoint)
+
+	return nil
+}
+
+// getCosmWasmPoolsParams returns the CosmWasm pools params with the current code IDs.
+func (p *poolsUseCase) getCosmWasmPoolsParams() cosmwasmdomain.CosmWasmPoolsParams {
+	p.cosmWasmPoolsParamsMx.RLock()
+	defer p.cosmWasmPoolsParamsMx.RUnlock()
+
+	return p.cosmWasmPoolsParams
 }
 
 // CalcExitCFMMPool implements mvc.PoolsUsecase.
 func (p *poolsUseCase) CalcExitCFMMPool(poolID uint64, exitingSharesIn osmomath.Int) (sdk.Coins, error) {
+	pool, err := p.getCFMMPool(poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	// fine to pass empty context as no data is mutated
+	exitFee := pool.GetExitFee(sdk.Context{})
+
+	return calcExitPool(sdk.Context{}, pool, exitingSharesIn, exitFee)
+}
+
+// CalcJoinPool implements mvc.PoolsUsecase.
+func (p *poolsUseCase) CalcJoinPool(poolID uint64, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error) {
+	pool, err := p.getCFMMPool(poolID)
+	if err != nil {
+		return domain.CFMMJoinPoolResult{}, err
+	}
+
+	// fine to pass empty context as no data is mutated
+	return calcJoinPool(sdk.Context{}, pool, tokensIn)
+}
+
+// getCFMMPool returns the underlying CFMM pool for the given pool ID.
+// Returns error if the pool is not found or is not a CFMM pool.
+func (p *poolsUseCase) getCFMMPool(poolID uint64) (types.CFMMPoolI, error) {
 	sqsPool, err := p.GetPool(poolID)
 	if err != nil {
 		return nil, err
@@ -721,10 +913,7 @@ func (p *poolsUseCase) CalcExitCFMMPool(poolID uint64, exitingSharesIn osmomath.
 		return nil, fmt.Errorf("failed to cast underlying pool to CFMMPoolI for ID: %d", poolID)
 	}
 
-	// fine to pass empty context as no data is mutated
-	exitFee := pool.GetExitFee(sdk.Context{})
-
-	return calcExitPool(sdk.Context{}, pool, exitingSharesIn, exitFee)
+	return pool, nil
 }
 
 // errMsgFormatSharesLargerThanMax is the error message format for when the exiting shares are larger than the max allowed.
@@ -801,6 +990,136 @@ func calcExitPool(ctx sdk.Context, pool types.CFMMPoolI, exitingSharesIn osmomat
 	return exitedCoins, nil
 }
 
+// calcJoinPool estimates joining the given CFMM pool with the given tokens.
+// Relies on the pool to compute the shares out. Both single-sided and all-asset joins are supported.
+// For all-asset joins, the part of the tokens in that matches the pool ratio is joined without incurring
+// the spread factor. The remaining imbalanced part is joined single-sided, paying the spread factor.
+// The swap fee is computed from the shares lost to the spread factor and is denominated in the imbalanced tokens.
+// The price impact compares the shares out to the shares corresponding to the spot value of the tokens joined.
+func calcJoinPool(ctx sdk.Context, pool types.CFMMPoolI, tokensIn sdk.Coins) (domain.CFMMJoinPoolResult, error) {
+	if !tokensIn.IsAllPositive() {
+		return domain.CFMMJoinPoolResult{}, fmt.Errorf("tokens in (%s) must be positive", tokensIn)
+	}
+
+	spreadFactor := pool.GetSpreadFactor(ctx)
+
+	sharesOut, tokensJoined, err := pool.CalcJoinPoolShares(ctx, tokensIn, spreadFactor)
+	if err != nil {
+		return domain.CFMMJoinPoolResult{}, err
+	}
+
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+
+	// Determine the part of the join that is proportional to the pool liquidity.
+	proportionalShares, proportionalTokens := osmomath.ZeroInt(), sdk.NewCoins()
+	if tokensIn.Len() == poolLiquidity.Len() {
+		proportionalShares, proportionalTokens, err = pool.CalcJoinPoolNoSwapShares(ctx, tokensIn, spreadFactor)
+		if err != nil {
+			return domain.CFMMJoinPoolResult{}, err
+		}
+	}
+
+	imbalancedTokens, hasNeg := tokensJoined.SafeSub(proportionalTokens...)
+	if hasNeg {
+		return domain.CFMMJoinPoolResult{}, fmt.Errorf("proportionally joined tokens (%s) exceed tokens joined (%s)", proportionalTokens, tokensJoined)
+	}
+
+	swapFee := sdk.NewCoins()
+	if !imbalancedTokens.IsZero() && spreadFactor.IsPositive() {
+		sharesOutNoFee, _, err := pool.CalcJoinPoolShares(ctx, tokensIn, osmomath.ZeroDec())
+		if err != nil {
+			return domain.CFMMJoinPoolResult{}, err
+		}
+
+		// swapFee = imbalancedTokens * feeShares / imbalancedSharesNoFee
+		imbalancedSharesNoFee := sharesOutNoFee.Sub(proportionalShares)
+		feeShares := sharesOutNoFee.Sub(sharesOut)
+		if imbalancedSharesNoFee.IsPositive() && feeShares.IsPositive() {
+			for _, token := range imbalancedTokens {
+				swapFee = swapFee.Add(sdk.NewCoin(token.Denom, token.Amount.Mul(feeShares).Quo(imbalancedSharesNoFee)))
+			}
+		}
+	}
+
+	priceImpact, err := calcJoinPoolPriceImpact(ctx, pool, poolLiquidity, tokensJoined, sharesOut)
+	if err != nil {
+		return domain.CFMMJoinPoolResult{}, err
+	}
+
+	return domain.CFMMJoinPoolResult{
+		SharesOut:    sharesOut,
+		TokensJoined: tokensJoined,
+		SwapFee:      swapFee,
+		PriceImpact:  priceImpact,
+	}, nil
+}
+
+// calcJoinPoolPriceImpact returns the price impact of receiving the given shares out for joining the given tokens.
+// The tokens and the pool liquidity are valued in terms of the first pool denom at the spot price.
+// priceImpact = sharesOut / (totalShares * tokensJoinedValue / poolLiquidityValue) - 1
+func calcJoinPoolPriceImpact(ctx sdk.Context, pool types.CFMMPoolI, poolLiquidity sdk.Coins, tokensJoined sdk.Coins, sharesOut osmomath.Int) (osmomath.Dec, error) {
+	if poolLiquidity.Empty() {
+		return osmomath.Dec{}, fmt.Errorf("pool (%d) has no liquidity", pool.GetId())
+	}
+
+	quoteDenom := poolLiquidity[0].Denom
+	spotPrices := make(map[string]osmomath.BigDec, poolLiquidity.Len())
+	spotPrices[quoteDenom] = osmomath.OneBigDec()
+	for _, coin := range poolLiquidity[1:] {
+		spotPrice, err := pool.SpotPrice(ctx, quoteDenom, coin.Denom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		spotPrices[coin.Denom] = spotPrice
+	}
+
+	value := func(coins sdk.Coins) osmomath.BigDec {
+		result := osmomath.ZeroBigDec()
+		for _, coin := range coins {
+			result.AddMut(osmomath.BigDecFromSDKInt(coin.Amount).MulMut(spotPrices[coin.Denom]))
+		}
+		return result
+	}
+
+	poolLiquidityValue := value(poolLiquidity)
+	if !poolLiquidityValue.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("pool (%d) liquidity value is zero", pool.GetId())
+	}
+
+	expectedSharesOut := osmomath.BigDecFromSDKInt(pool.GetTotalShares()).MulMut(value(tokensJoined)).QuoMut(poolLiquidityValue)
+	if !expectedSharesOut.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("expected shares out for joining pool (%d) are zero", pool.GetId())
+	}
+
+	return osmomath.BigDecFromSDKInt(sharesOut).QuoMut(expectedSharesOut).SubMut(osmomath.OneBigDec()).Dec(), nil
+}
+
+// requiresMarketData returns true if the APR and fees data must be set for the pools
+// because the given where filter, the range filters or the sort options reference it.
+func requiresMarketData(where *expression.Program, options domain.PoolsOptions) bool {
+	if whereFilterRequiresMarketData(where) {
+		return true
+	}
+
+	if options.Filter != nil {
+		for _, r := range options.Filter.Ranges {
+			if poolFieldRequiresMarketData(r.Field) {
+				return true
+			}
+		}
+	}
+
+	if options.Sort != nil {
+		for _, field := range options.Sort.Fields {
+			if poolFieldRequiresMarketData(field.Field) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // setPoolAPRAndFeeDataIfConfigured sets the APR and fee data for the pool if the options are configured.
 // No-op otherwise.
 // Logs an error if fails to get APR or pool fee data.
@@ -808,41 +1127,46 @@ func calcExitPool(ctx sdk.Context, pool types.CFMMPoolI, exitingSharesIn osmomat
 // The input options parameter is used to determine whether to set APR and fee data.
 func (p *poolsUseCase) setPoolAPRAndFeeDataIfConfigured(pool sqsdomain.PoolI, options domain.PoolsOptions) {
 	if options.Filter != nil && options.Filter.WithMarketIncentives {
-		poolID := pool.GetId()
+		p.setPoolAPRAndFeeData(pool)
+	}
+}
 
-		if p.aprPrefetcher == nil {
-			p.logger.Error("failed to get APR data: aprPrefetcher not set", zap.Uint64("poolID", poolID))
-			return
-		}
+// setPoolAPRAndFeeData sets the APR and fee data for the pool from the prefetchers.
+func (p *poolsUseCase) setPoolAPRAndFeeData(pool sqsdomain.PoolI) {
+	poolID := pool.GetId()
 
-		// Get APR data
-		poolAPRData, _, isStale, err := p.aprPrefetcher.GetByKey(poolID)
-		if err != nil {
-			// Log error if fails to get APR data
-			p.logger.Error("failed to get APR data", zap.Uint64("poolID", poolID), zap.Error(err))
-		}
+	if p.aprPrefetcher == nil {
+		p.logger.Error("failed to get APR data: aprPrefetcher not set", zap.Uint64("poolID", poolID))
+		return
+	}
 
-		// Set APR data
-		pool.SetAPRData(sqspassthroughdomain.PoolAPRDataStatusWrap{
-			PoolAPR: poolAPRData,
-			IsStale: isStale,
-			IsError: err != nil,
-		})
+	// Get APR data
+	poolAPRData, _, isStale, err := p.aprPrefetcher.GetByKey(poolID)
+	if err != nil {
+		// Log error if fails to get APR data
+		p.logger.Error("failed to get APR data", zap.Uint64("poolID", poolID), zap.Error(err))
+	}
 
-		// Get pool fee data
-		poolFeeData, _, isStale, err := p.poolFeesPrefetcher.GetByKey(poolID)
-		if err != nil {
-			// Log error if fails to get pool fee data
-			p.logger.Error("failed to get pool fee data", zap.Uint64("poolID", poolID), zap.Error(err))
-		}
+	// Set APR data
+	pool.SetAPRData(sqspassthroughdomain.PoolAPRDataStatusWrap{
+		PoolAPR: poolAPRData,
+		IsStale: isStale,
+		IsError: err != nil,
+	})
 
-		// Set pool fee data
-		pool.SetFeesData(sqspassthroughdomain.PoolFeesDataStatusWrap{
-			PoolFee: poolFeeData,
-			IsStale: isStale,
-			IsError: err != nil,
-		})
+	// Get pool fee data
+	poolFeeData, _, isStale, err := p.poolFeesPrefetcher.GetByKey(poolID)
+	if err != nil {
+		// Log error if fails to get pool fee data
+		p.logger.Error("failed to get pool fee data", zap.Uint64("poolID", poolID), zap.Error(err))
 	}
+
+	// Set pool fee data
+	pool.SetFeesData(sqspassthroughdomain.PoolFeesDataStatusWrap{
+		PoolFee: poolFeeData,
+		IsStale: isStale,
+		IsError: err != nil,
+	})
 }
 
 // formatBaseQuoteDenom formats the base and quote denom into a single string with a separator.
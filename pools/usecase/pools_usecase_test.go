@@ -24,6 +24,7 @@ import (
 	"github.com/osmosis-labs/sqs/domain"
 	"github.com/osmosis-labs/sqs/domain/mocks"
 	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/pools"
 	"github.com/osmosis-labs/sqs/pools/usecase"
 	routerrepo "github.com/osmosis-labs/sqs/router/repository"
 	"github.com/osmosis-labs/sqs/router/usecase/pools"
@@ -237,7 +238,7 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 			poolsUsecase.StorePools(tc.pools)
 
 			// System under test
-			actualRoutes, err := poolsUsecase.GetRoutesFromCandidates(tc.candidateRoutes, tc.tokenInDenom, tc.tokenOutDenom)
+			actualRoutes, err := poolsUsecase.GetRoutesFromCandidates(context.TODO(), tc.candidateRoutes, tc.tokenInDenom, tc.tokenOutDenom)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
@@ -710,6 +711,123 @@ func (s *PoolsUsecaseTestSuite) TestCalcExitPool() {
 	}
 }
 
+// TestCalcJoinPool validates the simulation of proportional and single-sided joins
+// into balancer and stableswap pools.
+func (s *PoolsUsecaseTestSuite) TestCalcJoinPool() {
+	emptyContext := sdk.Context{}
+
+	spreadFactor := osmomath.MustNewDecFromStr("0.01")
+
+	balancerPool, err := balancer.NewBalancerPool(
+		1,
+		balancer.PoolParams{SwapFee: spreadFactor, ExitFee: osmomath.ZeroDec()},
+		[]balancer.PoolAsset{
+			{Token: sdk.NewInt64Coin("foo", 1_000_000_000), Weight: osmomath.NewInt(5)},
+			{Token: sdk.NewInt64Coin("bar", 2_000_000_000), Weight: osmomath.NewInt(5)},
+		},
+		"",
+		time.Now(),
+	)
+	s.Require().NoError(err)
+
+	stableswapPool, err := stableswap.NewStableswapPool(
+		2,
+		stableswap.PoolParams{SwapFee: spreadFactor, ExitFee: osmomath.ZeroDec()},
+		sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000_000), sdk.NewInt64Coin("bar", 1_000_000_000)),
+		[]uint64{1, 1},
+		"",
+		"",
+	)
+	s.Require().NoError(err)
+
+	// Tolerate rounding of the shares out.
+	priceImpactTolerance := osmomath.MustNewDecFromStr("0.000001")
+
+	tests := []struct {
+		name string
+
+		pool     gammtypes.CFMMPoolI
+		tokensIn sdk.Coins
+
+		expectedSharesOut osmomath.Int
+		// Expected denoms of the swap fee. Empty for proportional joins.
+		expectedSwapFeeDenoms []string
+		isProportional        bool
+		expectedError         bool
+	}{
+		{
+			name:     "balancer, proportional join",
+			pool:     &balancerPool,
+			tokensIn: sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 2_000_000)),
+
+			// 0.1% of the total shares
+			expectedSharesOut: balancerPool.GetTotalShares().QuoRaw(1000),
+			isProportional:    true,
+		},
+		{
+			name:     "balancer, imbalanced join",
+			pool:     &balancerPool,
+			tokensIn: sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 3_000_000)),
+
+			expectedSwapFeeDenoms: []string{"bar"},
+		},
+		{
+			name:     "balancer, single-sided join",
+			pool:     &balancerPool,
+			tokensIn: sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000)),
+
+			expectedSwapFeeDenoms: []string{"foo"},
+		},
+		{
+			name:     "stableswap, single-sided join",
+			pool:     &stableswapPool,
+			tokensIn: sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)),
+
+			expectedSwapFeeDenoms: []string{"bar"},
+		},
+		{
+			name:     "denom not in pool",
+			pool:     &balancerPool,
+			tokensIn: sdk.NewCoins(sdk.NewInt64Coin("baz", 1_000_000)),
+
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			result, err := usecase.CalcJoinPool(emptyContext, tc.pool, tc.tokensIn)
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.tokensIn, result.TokensJoined)
+
+			if tc.isProportional {
+				s.Require().Equal(tc.expectedSharesOut, result.SharesOut)
+				s.Require().True(result.SwapFee.Empty())
+				s.Require().True(result.PriceImpact.Abs().LTE(priceImpactTolerance), "price impact: %s", result.PriceImpact)
+				return
+			}
+
+			s.Require().True(result.SharesOut.IsPositive())
+
+			// The swap fee is paid in the imbalanced tokens and does not exceed the spread factor share of them.
+			s.Require().Equal(tc.expectedSwapFeeDenoms, result.SwapFee.Denoms())
+			for _, fee := range result.SwapFee {
+				s.Require().True(fee.Amount.IsPositive())
+				s.Require().True(fee.Amount.LTE(spreadFactor.MulInt(tc.tokensIn.AmountOf(fee.Denom)).TruncateInt()), "fee: %s", fee)
+			}
+
+			// Imbalanced joins receive fewer shares than the spot value of the tokens joined.
+			s.Require().True(result.PriceImpact.IsNegative(), "price impact: %s", result.PriceImpact)
+		})
+	}
+}
+
 // a helper function used to multiply coins
 func mulCoins(coins sdk.Coins, multiplier osmomath.Dec) sdk.Coins {
 	outCoins := sdk.Coins{}
@@ -831,6 +949,25 @@ func (s *PoolsUsecaseTestSuite) TestGetPools() {
 				}
 			},
 		},
+		{
+			name: "Where filter: pool ID and min liquidity cap",
+			options: []domain.PoolsOption{
+				domain.WithWhere("id in [1, 32, 1066] && totalFiatValueLocked >= 1"),
+			},
+			expectedLen: 2,
+			expectError: false,
+			validateFunc: func(s *PoolsUsecaseTestSuite, pools []sqsdomain.PoolI) {
+				s.Require().Contains([]uint64{1, 1066}, pools[0].GetId())
+				s.Require().Contains([]uint64{1, 1066}, pools[1].GetId())
+			},
+		},
+		{
+			name: "Where filter: invalid expression",
+			options: []domain.PoolsOption{
+				domain.WithWhere("totalFiatValueLocked > 'uosmo'"),
+			},
+			expectError: true,
+		},
 		{
 			name: "Sort by pool ID descending",
 			options: []domain.PoolsOption{
@@ -879,6 +1016,150 @@ func (s *PoolsUsecaseTestSuite) TestGetPools() {
 	}
 }
 
+// TestGetPools_SortAndRangeFilters validates sorting and range filtering pools by numeric fields,
+// including the market data that is set for the pools even if market incentives are not requested.
+func (s *PoolsUsecaseTestSuite) TestGetPools_SortAndRangeFilters() {
+	var (
+		volumes24h = map[uint64]float64{1: 100, 2: 300, 3: 300, 4: 50}
+
+		volumeSort = &v1beta1.SortRequest{
+			Fields: []*v1beta1.SortField{
+				{Field: "market.volume24hUsd", Direction: v1beta1.SortDirection_DESCENDING},
+			},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		options []domain.PoolsOption
+
+		expectedPoolIDs []uint64
+		expectedTotal   uint64
+		expectedError   error
+	}{
+		{
+			name:    "sort by 24h volume, ties broken by pool ID",
+			options: []domain.PoolsOption{domain.WithSort(volumeSort)},
+
+			expectedPoolIDs: []uint64{2, 3, 1, 4},
+			expectedTotal:   4,
+		},
+		{
+			name: "sort by creation height",
+			options: []domain.PoolsOption{domain.WithSort(&v1beta1.SortRequest{
+				Fields: []*v1beta1.SortField{
+					{Field: "creationHeight", Direction: v1beta1.SortDirection_DESCENDING},
+				},
+			})},
+
+			expectedPoolIDs: []uint64{4, 3, 2, 1},
+			expectedTotal:   4,
+		},
+		{
+			name: "creation height range",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "creationHeight", Min: 200, HasMin: true, Max: 300, HasMax: true}},
+			})},
+
+			expectedPoolIDs: []uint64{2, 3},
+			expectedTotal:   2,
+		},
+		{
+			name: "min 24h volume",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "market.volume24hUsd", Min: 100, HasMin: true}},
+			})},
+
+			expectedPoolIDs: []uint64{1, 2, 3},
+			expectedTotal:   3,
+		},
+		{
+			name: "min and max 24h volume",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "market.volume24hUsd", Min: 50, HasMin: true, Max: 100, HasMax: true}},
+			})},
+
+			expectedPoolIDs: []uint64{1, 4},
+			expectedTotal:   2,
+		},
+		{
+			name: "max 24h volume of zero",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "market.volume24hUsd", Max: 0, HasMax: true}},
+			})},
+
+			expectedPoolIDs: []uint64{},
+			expectedTotal:   0,
+		},
+		{
+			name: "min 7d volume of zero",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "market.volume7dUsd", Min: 0, HasMin: true}},
+			})},
+
+			expectedPoolIDs: []uint64{1, 2, 3, 4},
+			expectedTotal:   4,
+		},
+		{
+			name: "cursor pagination over sorted pools",
+			options: []domain.PoolsOption{
+				domain.WithSort(volumeSort),
+				domain.WithPagination(&v1beta1.PaginationRequest{
+					Strategy: v1beta1.PaginationStrategy_CURSOR,
+					Cursor:   2,
+					Limit:    2,
+				}),
+			},
+
+			expectedPoolIDs: []uint64{1, 4},
+			expectedTotal:   4,
+		},
+		{
+			name: "unsupported range filter field",
+			options: []domain.PoolsOption{domain.WithFilter(&api.GetPoolsRequestFilter{
+				Ranges: []*api.RangeFilter{{Field: "unsupported", Min: 1, HasMin: true}},
+			})},
+
+			expectedError: domain.UnsupportedPoolsRangeFilterFieldError{Field: "unsupported"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			poolsUseCase := s.newDefaultPoolsUseCase()
+
+			poolsUseCase.RegisterAPRFetcher(getMockAPRFetcher(false, false))
+			poolsUseCase.RegisterPoolFeesFetcher(&mocks.MapFetcherMock[uint64, sqspassthroughdomain.PoolFee]{
+				GetByKeyFn: func(key uint64) (sqspassthroughdomain.PoolFee, time.Time, bool, error) {
+					return sqspassthroughdomain.PoolFee{Volume24h: volumes24h[key]}, defaultTime, false, nil
+				},
+			})
+
+			var pools []sqsdomain.PoolI
+			for id := uint64(1); id <= 4; id++ {
+				pools = append(pools, &mocks.MockRoutablePool{ID: id, CreationHeight: 100 * id})
+			}
+			s.Require().NoError(poolsUseCase.StorePools(pools))
+
+			// System under test
+			result, total, err := poolsUseCase.GetPools(tc.options...)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			poolIDs := make([]uint64, 0, len(result))
+			for _, pool := range result {
+				poolIDs = append(poolIDs, pool.GetId())
+			}
+			s.Require().Equal(tc.expectedPoolIDs, poolIDs)
+			s.Require().Equal(tc.expectedTotal, total)
+		})
+	}
+}
+
 func (s *PoolsUsecaseTestSuite) TestSetPoolAPRAndFeeDataIfConfigured() {
 	var (
 		// Helper functions to modify the APR and fee data
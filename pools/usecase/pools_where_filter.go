@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/expression"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+const (
+	// incentiveAttribute is the pool attribute derived from the APR data.
+	incentiveAttribute = "incentive"
+
+	// aprAttributeAliasPrefix is the prefix of the snake_case aliases of the attributes read from the APR data.
+	aprAttributeAliasPrefix = "apr."
+	// feesAttributeAliasPrefix is the prefix of the snake_case aliases of the attributes read from the fees data.
+	feesAttributeAliasPrefix = "fees."
+)
+
+// poolAttributes maps the non-numeric pool attributes available in the where filter expressions to their getters.
+// The numeric pool fields available for sorting and range filtering are available as attributes under the same names.
+var poolAttributes = map[string]struct {
+	typ expression.Type
+	get func(pool sqsdomain.PoolI) expression.Value
+}{
+	"type": {expression.TypeNumber, func(pool sqsdomain.PoolI) expression.Value {
+		return expression.NumberValue(float64(pool.GetType()))
+	}},
+	"spreadFactor": {expression.TypeNumber, func(pool sqsdomain.PoolI) expression.Value {
+		return expression.NumberValue(decToFloat(pool.GetSQSPoolModel().SpreadFactor))
+	}},
+	"denoms": {expression.TypeStringList, func(pool sqsdomain.PoolI) expression.Value {
+		return expression.StringListValue(pool.GetPoolDenoms())
+	}},
+	incentiveAttribute: {expression.TypeString, func(pool sqsdomain.PoolI) expression.Value {
+		return expression.StringValue(pool.Incentive().String())
+	}},
+}
+
+// poolAttributeAliases maps the snake_case aliases of the pool attributes to the attribute names,
+// e.g. liquidity_cap to totalFiatValueLocked, apr.total.upper to incentives.aprBreakdown.total.upper
+// and fees.volume_24h to market.volume24hUsd.
+var poolAttributeAliases = func() map[string]string {
+	aliases := map[string]string{
+		"liquidity_cap":   "totalFiatValueLocked",
+		"spread_factor":   "spreadFactor",
+		"creation_height": "creationHeight",
+
+		feesAttributeAliasPrefix + "volume_24h":     marketDataFieldPrefix + "volume24hUsd",
+		feesAttributeAliasPrefix + "volume_7d":      marketDataFieldPrefix + "volume7dUsd",
+		feesAttributeAliasPrefix + "fees_spent_24h": marketDataFieldPrefix + "feesSpent24hUsd",
+		feesAttributeAliasPrefix + "fees_spent_7d":  marketDataFieldPrefix + "feesSpent7dUsd",
+	}
+
+	aprKinds := map[string]string{
+		"total":      "total",
+		"swap_fees":  "swapFee",
+		"superfluid": "superfluid",
+		"osmosis":    "osmosis",
+		"boost":      "boost",
+	}
+	for alias, kind := range aprKinds {
+		for _, bound := range []string{"lower", "upper"} {
+			aliases[aprAttributeAliasPrefix+alias+"."+bound] = incentivesDataFieldPrefix + "aprBreakdown." + kind + "." + bound
+		}
+	}
+
+	return aliases
+}()
+
+// poolAttributesSchema is the schema of the pool attributes available in the where filter expressions.
+var poolAttributesSchema = func() expression.Schema {
+	schema := expression.Schema{}
+	for name, attribute := range poolAttributes {
+		schema[name] = attribute.typ
+	}
+	for name := range poolNumericFields {
+		schema[name] = expression.TypeNumber
+	}
+	for alias, name := range poolAttributeAliases {
+		schema[alias] = schema[name]
+	}
+	return schema
+}()
+
+// compileWhereFilter compiles the given where filter expression against the pool attributes.
+// Returns nil program if the expression is empty.
+func compileWhereFilter(where string) (*expression.Program, error) {
+	if where == "" {
+		return nil, nil
+	}
+
+	program, err := expression.Compile(where, poolAttributesSchema)
+	if err != nil {
+		return nil, domain.InvalidPoolsFilterExpressionError{Expression: where, Err: err}
+	}
+
+	return program, nil
+}
+
+// whereFilterRequiresMarketData returns true if the given program references
+// attributes read from the APR or fees data.
+func whereFilterRequiresMarketData(program *expression.Program) bool {
+	return program != nil &&
+		(program.ReferencesPrefix(marketDataFieldPrefix) ||
+			program.ReferencesPrefix(incentivesDataFieldPrefix) ||
+			program.ReferencesPrefix(aprAttributeAliasPrefix) ||
+			program.ReferencesPrefix(feesAttributeAliasPrefix) ||
+			program.ReferencesPrefix(incentiveAttribute))
+}
+
+// filterWhere returns a filter evaluating the given program against the pool attributes.
+func filterWhere(program *expression.Program) func(pool sqsdomain.PoolI) bool {
+	return func(pool sqsdomain.PoolI) bool {
+		return program.Eval(poolAttributesEnv(pool))
+	}
+}
+
+// poolAttributesEnv returns the environment resolving the pool attributes of the given pool.
+func poolAttributesEnv(pool sqsdomain.PoolI) expression.Env {
+	return func(identifier string) expression.Value {
+		if name, ok := poolAttributeAliases[identifier]; ok {
+			identifier = name
+		}
+
+		if attribute, ok := poolAttributes[identifier]; ok {
+			return attribute.get(pool)
+		}
+
+		// Guaranteed by the schema to be a numeric pool field.
+		return expression.NumberValue(poolNumericFields[identifier](pool))
+	}
+}
+
+// intToFloat converts the given int to float, returning zero if it is nil.
+func intToFloat(i osmomath.Int) float64 {
+	if i.IsNil() {
+		return 0
+	}
+	f, _ := i.ToLegacyDec().Float64()
+	return f
+}
+
+// decToFloat converts the given dec to float, returning zero if it is nil.
+func decToFloat(d osmomath.Dec) float64 {
+	if d.IsNil() {
+		return 0
+	}
+	f, _ := d.Float64()
+	return f
+}
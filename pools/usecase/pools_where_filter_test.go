@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/pools"
+	"github.com/osmosis-labs/sqs/pools/usecase"
+	"github.com/osmosis-labs/sqs/router/usecase/routertesting"
+	sqspassthroughdomain "github.com/osmosis-labs/sqs/sqsdomain/passthroughdomain"
+)
+
+// TestFilterWhere validates that the where filter expressions are evaluated against the pool attributes.
+func (s *PoolsUsecaseTestSuite) TestFilterWhere() {
+	pool := &mocks.MockRoutablePool{
+		ID:               defaultPoolID,
+		PoolType:         poolmanagertypes.Stableswap,
+		Denoms:           []string{denomOne, denomTwo},
+		SpreadFactor:     osmomath.MustNewDecFromStr("0.003"),
+		PoolLiquidityCap: osmomath.NewInt(2_000_000),
+		IncentiveType:    api.IncentiveType_SUPERFLUID,
+		APRData: sqspassthroughdomain.PoolAPRDataStatusWrap{
+			PoolAPR: sqspassthroughdomain.PoolAPR{
+				TotalAPR: sqspassthroughdomain.PoolDataRange{Lower: 0.05, Upper: 0.15},
+			},
+		},
+		FeesData: sqspassthroughdomain.PoolFeesDataStatusWrap{
+			PoolFee: sqspassthroughdomain.PoolFee{Volume24h: 1000},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		where string
+
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:     "example from the request",
+			where:    `totalFiatValueLocked > 1e6 && "` + denomOne + `" in denoms && incentives.aprBreakdown.total.upper > 0.1 && spreadFactor <= 0.003`,
+			expected: true,
+		},
+		{
+			name:     "pool ID and type",
+			where:    "id == 1 && type == 1",
+			expected: true,
+		},
+		{
+			name:     "denom not in pool",
+			where:    `"` + denomThree + `" in denoms`,
+			expected: false,
+		},
+		{
+			name:     "incentive",
+			where:    "incentive in ['SUPERFLUID', 'OSMOSIS']",
+			expected: true,
+		},
+		{
+			name:     "APR lower bound",
+			where:    "incentives.aprBreakdown.total.lower >= 0.1",
+			expected: false,
+		},
+		{
+			name:     "fees data",
+			where:    "market.volume24hUsd > 100 && market.volume7dUsd == 0",
+			expected: true,
+		},
+		{
+			name:     "snake_case aliases",
+			where:    "liquidity_cap == 2e6 && spread_factor == 0.003 && apr.total.lower < 0.1 && fees.volume_24h > 100 && fees.volume_7d == 0",
+			expected: true,
+		},
+		{
+			name:          "unknown attribute",
+			where:         "incentives.aprBreakdown.total > 0.1",
+			expectedError: true,
+		},
+		{
+			name:          "unknown alias",
+			where:         "apr.total > 0.1",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			filter, err := usecase.FilterWhere(tc.where)
+
+			if tc.expectedError {
+				s.Require().ErrorAs(err, &domain.InvalidPoolsFilterExpressionError{})
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expected, filter(pool))
+		})
+	}
+}
+
+// TestFilterWhere_RequestExample validates that the example expression of the where filter request,
+// written with the snake_case attribute aliases, compiles and is evaluated against the pool attributes.
+func (s *PoolsUsecaseTestSuite) TestFilterWhere_RequestExample() {
+	const where = `liquidity_cap > 1e6 && "uosmo" in denoms && apr.total.upper > 0.1 && spread_factor <= 0.003`
+
+	filter, err := usecase.FilterWhere(where)
+	s.Require().NoError(err)
+
+	pool := &mocks.MockRoutablePool{
+		ID:               defaultPoolID,
+		Denoms:           []string{routertesting.UOSMO, denomTwo},
+		SpreadFactor:     osmomath.MustNewDecFromStr("0.003"),
+		PoolLiquidityCap: osmomath.NewInt(2_000_000),
+		APRData: sqspassthroughdomain.PoolAPRDataStatusWrap{
+			PoolAPR: sqspassthroughdomain.PoolAPR{
+				TotalAPR: sqspassthroughdomain.PoolDataRange{Lower: 0.05, Upper: 0.15},
+			},
+		},
+	}
+	s.Require().True(filter(pool))
+
+	pool.SpreadFactor = osmomath.MustNewDecFromStr("0.01")
+	s.Require().False(filter(pool))
+}
@@ -0,0 +1,231 @@
+package grpc
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/sqs/delivery/grpc/server"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/router"
+	"github.com/osmosis-labs/sqs/router/types"
+)
+
+// RouterGRPCHandler represents the GRPC handler for the router queries.
+type RouterGRPCHandler struct {
+	RUsecase mvc.RouterUsecase
+	TUsecase mvc.TokensUsecase
+	logger   log.Logger
+
+	api.UnimplementedQueryServer
+}
+
+var (
+	_ api.QueryServer = &RouterGRPCHandler{}
+
+	oneDec = osmomath.OneDec()
+)
+
+// NewRouterGRPCHandler will register the router query service on the given GRPC server.
+func NewRouterGRPCHandler(s *grpc.Server, us mvc.RouterUsecase, tu mvc.TokensUsecase, logger log.Logger) {
+	api.RegisterQueryServer(s, &RouterGRPCHandler{
+		RUsecase: us,
+		TUsecase: tu,
+		logger:   logger,
+	})
+}
+
+// GetOptimalQuote implements api.QueryServer.
+// It mirrors the GET /router/quote endpoint without the simulation and base fee options.
+func (a *RouterGRPCHandler) GetOptimalQuote(ctx context.Context, protoReq *api.GetOptimalQuoteRequest) (*api.GetQuoteResponse, error) {
+	req := types.GetQuoteRequest{
+		TokenIn:        protoReq.TokenIn,
+		TokenOutDenom:  protoReq.TokenOutDenom,
+		TokenOut:       protoReq.TokenOut,
+		TokenInDenom:   protoReq.TokenInDenom,
+		SingleRoute:    protoReq.SingleRoute,
+		HumanDenoms:    protoReq.HumanDenoms,
+		ApplyExponents: protoReq.ApplyExponents,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+	)
+
+	if req.SwapMethod() == domain.TokenSwapMethodExactIn {
+		tokenIn, tokenOutDenom = *req.TokenIn, req.TokenOutDenom
+	} else {
+		tokenIn, tokenOutDenom = *req.TokenOut, req.TokenInDenom
+	}
+
+	chainDenoms, err := mvc.ValidateChainDenoms(a.TUsecase, []string{tokenIn.Denom, tokenOutDenom}, req.HumanDenoms)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Update coins token in denom it case it was translated from human to chain.
+	tokenIn.Denom = chainDenoms[0]
+	tokenOutDenom = chainDenoms[1]
+
+	var routerOpts []domain.RouterOption
+	if req.SingleRoute {
+		routerOpts = append(routerOpts, domain.WithMaxSplitRoutes(domain.DisableSplitRoutes))
+	}
+
+	var quote domain.Quote
+	if req.SwapMethod() == domain.TokenSwapMethodExactIn {
+		quote, err = a.RUsecase.GetOptimalQuote(ctx, tokenIn, tokenOutDenom, routerOpts...)
+	} else {
+		quote, err = a.RUsecase.GetOptimalQuoteInGivenOut(ctx, tokenIn, tokenOutDenom, routerOpts...)
+	}
+	if err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	scalingFactor := oneDec
+	if req.ApplyExponents {
+		scalingFactor = a.getSpotPriceScalingFactor(tokenIn.Denom, tokenOutDenom)
+	}
+
+	return a.prepareQuoteResponse(ctx, quote, req.SwapMethod(), tokenOutDenom, scalingFactor)
+}
+
+// GetCustomDirectQuote implements api.QueryServer.
+// It mirrors the GET /router/custom-direct-quote endpoint.
+func (a *RouterGRPCHandler) GetCustomDirectQuote(ctx context.Context, protoReq *api.GetCustomDirectQuoteRequest) (*api.GetQuoteResponse, error) {
+	req := types.GetDirectCustomQuoteRequest{
+		TokenIn:        protoReq.TokenIn,
+		TokenOutDenom:  protoReq.TokenOutDenom,
+		TokenOut:       protoReq.TokenOut,
+		TokenInDenom:   protoReq.TokenInDenom,
+		PoolID:         protoReq.PoolId,
+		ApplyExponents: protoReq.ApplyExponents,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		tokenIn       sdk.Coin
+		tokenOutDenom []string
+	)
+
+	// Determine the tokenIn and tokenOutDenom based on the swap method.
+	if req.SwapMethod() == domain.TokenSwapMethodExactIn {
+		tokenIn, tokenOutDenom = *req.TokenIn, req.TokenOutDenom
+	} else {
+		tokenIn, tokenOutDenom = *req.TokenOut, req.TokenInDenom
+	}
+
+	// Apply human denoms conversion if required.
+	chainDenoms, err := mvc.ValidateChainDenoms(a.TUsecase, append([]string{tokenIn.Denom}, tokenOutDenom...), protoReq.HumanDenoms)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Update coins token in denom it case it was translated from human to chain.
+	tokenIn.Denom = chainDenoms[0]
+	tokenOutDenom = chainDenoms[1:]
+
+	// Get the quote based on the swap method.
+	var quote domain.Quote
+	if req.SwapMethod() == domain.TokenSwapMethodExactIn {
+		quote, err = a.RUsecase.GetCustomDirectQuoteMultiPool(ctx, tokenIn, tokenOutDenom, req.PoolID)
+	} else {
+		quote, err = a.RUsecase.GetCustomDirectQuoteMultiPoolInGivenOut(ctx, tokenIn, tokenOutDenom, req.PoolID)
+	}
+	if err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	finalDenom := tokenOutDenom[len(tokenOutDenom)-1]
+
+	scalingFactor := oneDec
+	if req.ApplyExponents {
+		scalingFactor = a.getSpotPriceScalingFactor(tokenIn.Denom, finalDenom)
+	}
+
+	return a.prepareQuoteResponse(ctx, quote, req.SwapMethod(), finalDenom, scalingFactor)
+}
+
+// prepareQuoteResponse prepares the quote for output and converts it to the GRPC response format.
+// tokenOutDenom is the denom of the token the quote amount out is computed for. For the exact amount out
+// swap method, it is the denom of the token in as the swap is computed in reverse.
+func (a *RouterGRPCHandler) prepareQuoteResponse(ctx context.Context, quote domain.Quote, swapMethod domain.TokenSwapMethod, tokenOutDenom string, scalingFactor osmomath.Dec) (*api.GetQuoteResponse, error) {
+	if _, _, err := quote.PrepareResult(ctx, scalingFactor, a.logger); err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	// Report the height of the state the quote was computed at.
+	return convertQuoteToResponse(quote, swapMethod, tokenOutDenom, domain.GetHeightFromContext(ctx)), nil
+}
+
+// convertQuoteToResponse converts the prepared quote to the GRPC response format.
+// For the exact amount out swap method, the quote getters return the amounts of the reversed swap.
+// Therefore, the amounts in and out are swapped back.
+// CONTRACT: quote.PrepareResult is called beforehand.
+func convertQuoteToResponse(quote domain.Quote, swapMethod domain.TokenSwapMethod, tokenOutDenom string, height uint64) *api.GetQuoteResponse {
+	amountIn := quote.GetAmountIn()
+	amountOut := sdk.Coin{Denom: tokenOutDenom, Amount: quote.GetAmountOut()}
+	if swapMethod == domain.TokenSwapMethodExactOut {
+		amountIn, amountOut = amountOut, amountIn
+	}
+
+	splitRoutes := quote.GetRoute()
+	routes := make([]api.Route, 0, len(splitRoutes))
+	for _, splitRoute := range splitRoutes {
+		routablePools := splitRoute.GetPools()
+		pools := make([]api.RoutePool, 0, len(routablePools))
+		for _, pool := range routablePools {
+			pools = append(pools, api.RoutePool{
+				Id:            pool.GetId(),
+				Type:          uint64(pool.GetType()),
+				SpreadFactor:  pool.GetSpreadFactor(),
+				TokenInDenom:  pool.GetTokenInDenom(),
+				TokenOutDenom: pool.GetTokenOutDenom(),
+				TakerFee:      pool.GetTakerFee(),
+				CodeId:        pool.GetCodeID(),
+			})
+		}
+
+		routes = append(routes, api.Route{
+			Pools:     pools,
+			InAmount:  splitRoute.GetAmountIn(),
+			OutAmount: splitRoute.GetAmountOut(),
+		})
+	}
+
+	return &api.GetQuoteResponse{
+		AmountIn:                amountIn,
+		AmountOut:               amountOut,
+		Route:                   routes,
+		EffectiveFee:            quote.GetEffectiveFee(),
+		PriceImpact:             quote.GetPriceImpact(),
+		InBaseOutQuoteSpotPrice: quote.GetInBaseOutQuoteSpotPrice(),
+		Height:                  height,
+	}
+}
+
+// getSpotPriceScalingFactor returns the spot price scaling factor for a given tokenIn and tokenOutDenom.
+func (a *RouterGRPCHandler) getSpotPriceScalingFactor(tokenInDenom, tokenOutDenom string) osmomath.Dec {
+	scalingFactor, err := a.TUsecase.GetSpotPriceScalingFactorByDenom(tokenOutDenom, tokenInDenom)
+	if err != nil {
+		// Note that we do not fail the quote if scaling factor fetching fails.
+		// Instead, we simply set it to zero to validate future calculations downstream.
+		scalingFactor = osmomath.ZeroDec()
+	}
+
+	return scalingFactor
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
+
+// PoolHealthHandler represent the httphandler for the pool health tracked by the router
+type PoolHealthHandler struct {
+	PHTracker mvc.PoolHealthTracker
+}
+
+// PoolHealthOverrideResponse is the response to setting the pool health override.
+type PoolHealthOverrideResponse struct {
+	PoolID      uint64                    `json:"pool_id"`
+	Override    domain.PoolHealthOverride `json:"override,omitempty"`
+	Quarantined bool                      `json:"quarantined"`
+}
+
+// NewPoolHealthHandler will initialize the router/pool-health resource endpoint
+func NewPoolHealthHandler(e *echo.Echo, tracker mvc.PoolHealthTracker) {
+	handler := &PoolHealthHandler{
+		PHTracker: tracker,
+	}
+
+	e.GET(formatRouterResource("/pool-health"), handler.GetPoolHealth)
+}
+
+// NewPoolHealthAdminHandler will initialize the admin/pool-health resource endpoint overriding the pool health
+// CONTRACT: the group authenticates the requests.
+func NewPoolHealthAdminHandler(g *echo.Group, tracker mvc.PoolHealthTracker) {
+	handler := &PoolHealthHandler{
+		PHTracker: tracker,
+	}
+
+	g.POST("/pool-health/:id", handler.SetPoolHealthOverride)
+}
+
+// @Summary Get the health of the pools
+// @Description Returns the pools that misbehaved within the current window, are quarantined or have a manual override.
+// @Description Quarantined pools are excluded from the candidate routes until they behave for the configured number of blocks.
+// @ID get-pool-health
+// @Produce  json
+// @Success 200  {array}  domain.PoolHealthStatus  "Health of the pools"
+// @Router /router/pool-health [get]
+func (a *PoolHealthHandler) GetPoolHealth(c echo.Context) error {
+	return c.JSON(http.StatusOK, a.PHTracker.GetPoolHealthStatuses())
+}
+
+// @Summary Override the quarantine state of a pool
+// @Description Quarantines the pool regardless of its behavior, never quarantines it, or clears the override.
+// @ID set-pool-health-override
+// @Produce  json
+// @Param  id  path  int  true  "Pool ID"
+// @Param  override  query  string  true  "One of quarantined, healthy or none"
+// @Success 200  {object}  PoolHealthOverrideResponse  "Override of the pool"
+// @Router /admin/pool-health/{id} [post]
+func (a *PoolHealthHandler) SetPoolHealthOverride(c echo.Context) error {
+	poolID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	override, err := domain.ParsePoolHealthOverride(c.QueryParam("override"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	a.PHTracker.SetOverride(poolID, override)
+
+	return c.JSON(http.StatusOK, PoolHealthOverrideResponse{
+		PoolID:      poolID,
+		Override:    override,
+		Quarantined: a.PHTracker.IsQuarantined(poolID),
+	})
+}
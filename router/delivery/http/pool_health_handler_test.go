@@ -0,0 +1,84 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/middleware"
+	routerdelivery "github.com/osmosis-labs/sqs/router/delivery/http"
+	"github.com/osmosis-labs/sqs/router/usecase/poolhealth"
+)
+
+// TestSetPoolHealthOverride validates that the pool health can only be overridden through the authenticated admin group.
+func TestSetPoolHealthOverride(t *testing.T) {
+	const (
+		apiKey = "secret"
+		poolID = uint64(1)
+	)
+
+	tracker := poolhealth.New(domain.PoolHealthConfig{
+		Enabled:          true,
+		ErrorThreshold:   1,
+		OutlierThreshold: 1,
+		OutlierTolerance: 0.5,
+		WindowBlocks:     10,
+		QuarantineBlocks: 10,
+	}, &log.NoOpLogger{})
+
+	m := middleware.InitMiddleware(&domain.CORSConfig{}, &domain.FlightRecordConfig{}, &log.NoOpLogger{})
+
+	e := echo.New()
+	routerdelivery.NewPoolHealthHandler(e, tracker)
+	routerdelivery.NewPoolHealthAdminHandler(e.Group("/admin", m.AdminAuthMiddleware(apiKey)), tracker)
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+
+		expectedStatusCode  int
+		expectedQuarantined bool
+	}{
+		{
+			name: "not exposed on the public router resource",
+			path: "/router/pool-health/1?override=quarantined",
+
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "unauthenticated",
+			path: "/admin/pool-health/1?override=quarantined",
+
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "authenticated",
+			path:          "/admin/pool-health/1?override=quarantined",
+			authorization: "Bearer " + apiKey,
+
+			expectedStatusCode:  http.StatusOK,
+			expectedQuarantined: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedStatusCode, rec.Code)
+			require.Equal(t, tc.expectedQuarantined, tracker.IsQuarantined(poolID))
+		})
+	}
+}
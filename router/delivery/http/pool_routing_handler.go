@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/number"
+)
+
+// PoolRoutingHandler represent the httphandler for the pool routing config updated at runtime
+type PoolRoutingHandler struct {
+	PRUsecase mvc.PoolRoutingUsecase
+}
+
+// PoolRoutingResponse is the effective pool routing config together with the runtime changes it includes.
+type PoolRoutingResponse struct {
+	Config  domain.PoolRoutingConfig  `json:"config"`
+	Overlay domain.PoolRoutingOverlay `json:"overlay"`
+}
+
+// NewPoolRoutingHandler will initialize the admin/pool-routing resource endpoints
+// CONTRACT: the group authenticates the requests.
+func NewPoolRoutingHandler(g *echo.Group, us mvc.PoolRoutingUsecase) {
+	handler := &PoolRoutingHandler{
+		PRUsecase: us,
+	}
+
+	g.GET("/pool-routing", handler.GetPoolRouting)
+	g.POST("/pool-routing/:list/:ids", handler.AddPoolRoutingIDs)
+	g.DELETE("/pool-routing/:list/:ids", handler.RemovePoolRoutingIDs)
+}
+
+// @Summary Get the pool routing config
+// @Description Returns the effective denied and preferred pool IDs and the supported CosmWasm code IDs
+// @Description together with the runtime changes applied on top of the static config.
+// @ID get-pool-routing
+// @Produce  json
+// @Param  Authorization  header  string  true  "Bearer API key"
+// @Success 200  {object}  PoolRoutingResponse  "Pool routing config"
+// @Router /admin/pool-routing [get]
+func (a *PoolRoutingHandler) GetPoolRouting(c echo.Context) error {
+	return c.JSON(http.StatusOK, PoolRoutingResponse{
+		Config:  a.PRUsecase.GetPoolRoutingConfig(),
+		Overlay: a.PRUsecase.GetPoolRoutingOverlay(),
+	})
+}
+
+// @Summary Add IDs to a pool routing list
+// @Description Adds the IDs to the list, re-sorts the pools, recomputes the candidate route search data
+// @Description and flushes the route caches. The change is persisted and survives restarts.
+// @ID add-pool-routing-ids
+// @Produce  json
+// @Param  Authorization  header  string  true  "Bearer API key"
+// @Param  list  path  string  true  "One of denied-pool-ids, preferred-pool-ids, transmuter-code-ids, alloyed-transmuter-code-ids, orderbook-code-ids, general-cosmwasm-code-ids"
+// @Param  ids  path  string  true  "Comma-separated list of IDs"
+// @Success 200  {object}  PoolRoutingResponse  "Updated pool routing config"
+// @Router /admin/pool-routing/{list}/{ids} [post]
+func (a *PoolRoutingHandler) AddPoolRoutingIDs(c echo.Context) error {
+	return a.updatePoolRouting(c, a.PRUsecase.AddIDs)
+}
+
+// @Summary Remove IDs from a pool routing list
+// @Description Removes the IDs from the list, including the ones set in the static config, re-sorts the pools,
+// @Description recomputes the candidate route search data and flushes the route caches.
+// @Description The change is persisted and survives restarts.
+// @ID remove-pool-routing-ids
+// @Produce  json
+// @Param  Authorization  header  string  true  "Bearer API key"
+// @Param  list  path  string  true  "One of denied-pool-ids, preferred-pool-ids, transmuter-code-ids, alloyed-transmuter-code-ids, orderbook-code-ids, general-cosmwasm-code-ids"
+// @Param  ids  path  string  true  "Comma-separated list of IDs"
+// @Success 200  {object}  PoolRoutingResponse  "Updated pool routing config"
+// @Router /admin/pool-routing/{list}/{ids} [delete]
+func (a *PoolRoutingHandler) RemovePoolRoutingIDs(c echo.Context) error {
+	return a.updatePoolRouting(c, a.PRUsecase.RemoveIDs)
+}
+
+// updatePoolRouting parses the list and the IDs from the path and applies the given update.
+func (a *PoolRoutingHandler) updatePoolRouting(c echo.Context, updateFn func(ctx context.Context, list domain.PoolRoutingList, ids []uint64) (domain.PoolRoutingConfig, error)) error {
+	list, err := domain.ParsePoolRoutingList(c.Param("list"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	ids, err := number.ParseNumbers(c.Param("ids"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+	if len(ids) == 0 {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: "no IDs provided"})
+	}
+
+	// The update is completed even if the client disconnects.
+	config, err := updateFn(context.WithoutCancel(c.Request().Context()), list, ids)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, PoolRoutingResponse{
+		Config:  config,
+		Overlay: a.PRUsecase.GetPoolRoutingOverlay(),
+	})
+}
@@ -24,7 +24,9 @@ type RouterHandler struct {
 	RUsecase       mvc.RouterUsecase
 	TUsecase       mvc.TokensUsecase
 	QuoteSimulator domain.QuoteSimulator
-	logger         log.Logger
+	// SlippageRecommender is nil if the recommended slippage is disabled.
+	SlippageRecommender domain.SlippageRecommender
+	logger              log.Logger
 }
 
 const routerResource = "/router"
@@ -38,12 +40,13 @@ func formatRouterResource(resource string) string {
 }
 
 // NewRouterHandler will initialize the pools/ resources endpoint
-func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, tu mvc.TokensUsecase, qs domain.QuoteSimulator, logger log.Logger) {
+func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, tu mvc.TokensUsecase, qs domain.QuoteSimulator, sr domain.SlippageRecommender, logger log.Logger) {
 	handler := &RouterHandler{
-		RUsecase:       us,
-		TUsecase:       tu,
-		QuoteSimulator: qs,
-		logger:         logger,
+		RUsecase:            us,
+		TUsecase:            tu,
+		QuoteSimulator:      qs,
+		SlippageRecommender: sr,
+		logger:              logger,
 	}
 	e.GET(formatRouterResource("/quote"), handler.GetOptimalQuote)
 	e.GET(formatRouterResource("/routes"), handler.GetCandidateRoutes)
@@ -63,6 +66,10 @@ func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, tu mvc.TokensUsecase,
 // @Description Mixing swap method parameters in other way than specified will result in an error.
 // @Description
 // @Description When `singleRoute` parameter is set to true, it gives the best single quote while excluding splits.
+// @Description
+// @Description If enabled, the quote includes the `recommended_slippage` tolerance computed from the recent per-block volatility
+// @Description of the price of the token in in terms of the token out and the price impact of the amount. It is omitted until enough prices are recorded.
+// @Description The volatility is approximated from the USDC prices of both tokens rather than computed per hop of the route.
 // @ID get-route-quote
 // @Produce  json
 // @Param  tokenIn         query  string  false  "String representation of the sdk.Coin denoting the input token for the exact amount in swap method."     example(1000000uosmo)
@@ -162,12 +169,17 @@ func (a *RouterHandler) GetOptimalQuote(c echo.Context) (err error) {
 		quote.SetQuotePriceInfo(&priceInfo)
 	}
 
+	a.setRecommendedSlippage(quote, tokenIn.Denom, tokenOutDenom)
+
 	if req.AppendBaseFee {
 		quote.SetQuotePriceInfo(&domain.TxFeeInfo{
 			BaseFee: a.RUsecase.GetBaseFee().CurrentFee,
 		})
 	}
 
+	// Report the height of the state the quote was computed at.
+	quote.SetHeight(domain.GetHeightFromContext(ctx))
+
 	return c.JSON(http.StatusOK, quote)
 }
 
@@ -250,6 +262,11 @@ func (a *RouterHandler) GetDirectCustomQuote(c echo.Context) (err error) {
 		return c.JSON(domain.GetStatusCode(err), domain.ResponseError{Message: err.Error()})
 	}
 
+	a.setRecommendedSlippage(quote, tokenIn.Denom, tokenOutDenom[len(tokenOutDenom)-1])
+
+	// Report the height of the state the quote was computed at.
+	quote.SetHeight(domain.GetHeightFromContext(ctx))
+
 	return c.JSON(http.StatusOK, quote)
 }
 
@@ -368,6 +385,18 @@ func (a *RouterHandler) GetSpotPriceForPool(c echo.Context) error {
 	return c.JSON(http.StatusOK, spotPrice)
 }
 
+// setRecommendedSlippage sets the slippage tolerance recommended for the prepared quote if available.
+// The denoms may be given in either order since the volatility of the price is the same in both directions.
+func (a *RouterHandler) setRecommendedSlippage(quote domain.Quote, tokenInDenom, tokenOutDenom string) {
+	if a.SlippageRecommender == nil {
+		return
+	}
+
+	if slippage, ok := a.SlippageRecommender.GetRecommendedSlippage(tokenInDenom, tokenOutDenom, quote.GetPriceImpact()); ok {
+		quote.SetRecommendedSlippage(slippage)
+	}
+}
+
 // getSpotPriceScalingFactor returns the spot price scaling factor for a given tokenIn and tokenOutDenom.
 func (a *RouterHandler) getSpotPriceScalingFactor(tokenInDenom, tokenOutDenom string) osmomath.Dec {
 	scalingFactor, err := a.TUsecase.GetSpotPriceScalingFactorByDenom(tokenOutDenom, tokenInDenom)
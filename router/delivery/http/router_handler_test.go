@@ -156,6 +156,90 @@ func (s *RouterHandlerSuite) TestGetOptimalQuote() {
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   s.MustReadFile("../../usecase/routertesting/parsing/quote_amount_out_response.json"),
 		},
+		{
+			name: "valid exact in request with recommended slippage",
+			queryParams: map[string]string{
+				"tokenIn":        "1000ibc/EA1D43981D5C9A1C4AAEA9C23BB1D4FA126BA9BC7020A25E0AE4AA841EA25DC5",
+				"tokenOutDenom":  "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+				"singleRoute":    "true",
+				"applyExponents": "true",
+			},
+			handler: &routerdelivery.RouterHandler{
+				TUsecase: &mocks.TokensUsecaseMock{
+					IsValidChainDenomFunc: func(chainDenom string) bool {
+						return true
+					},
+				},
+				RUsecase: &mocks.RouterUsecaseMock{
+					GetOptimalQuoteFunc: func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
+						return s.NewExactAmountInQuote(poolOne, poolTwo, poolThree), nil
+					},
+				},
+				SlippageRecommender: &mocks.SlippageRecommenderMock{
+					GetRecommendedSlippageFn: func(tokenInDenom, tokenOutDenom string, priceImpact math.LegacyDec) (math.LegacyDec, bool) {
+						return priceImpact.Abs(), true
+					},
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   s.MustReadFile("../../usecase/routertesting/parsing/quote_amount_in_response_recommended_slippage.json"),
+		},
+		{
+			name: "valid exact out request with recommended slippage",
+			queryParams: map[string]string{
+				"tokenOut":       "1000ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+				"tokenInDenom":   "ibc/EA1D43981D5C9A1C4AAEA9C23BB1D4FA126BA9BC7020A25E0AE4AA841EA25DC5",
+				"singleRoute":    "true",
+				"applyExponents": "true",
+			},
+			handler: &routerdelivery.RouterHandler{
+				TUsecase: &mocks.TokensUsecaseMock{
+					IsValidChainDenomFunc: func(chainDenom string) bool {
+						return true
+					},
+				},
+				RUsecase: &mocks.RouterUsecaseMock{
+					GetOptimalQuoteInGivenOutFunc: func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
+						return s.NewExactAmountOutQuote(poolOne, poolTwo, poolThree), nil
+					},
+				},
+				SlippageRecommender: &mocks.SlippageRecommenderMock{
+					GetRecommendedSlippageFn: func(tokenInDenom, tokenOutDenom string, priceImpact math.LegacyDec) (math.LegacyDec, bool) {
+						return priceImpact.Abs(), true
+					},
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   s.MustReadFile("../../usecase/routertesting/parsing/quote_amount_out_response_recommended_slippage.json"),
+		},
+		{
+			name: "valid exact in request without enough recorded prices for recommended slippage",
+			queryParams: map[string]string{
+				"tokenIn":        "1000ibc/EA1D43981D5C9A1C4AAEA9C23BB1D4FA126BA9BC7020A25E0AE4AA841EA25DC5",
+				"tokenOutDenom":  "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+				"singleRoute":    "true",
+				"applyExponents": "true",
+			},
+			handler: &routerdelivery.RouterHandler{
+				TUsecase: &mocks.TokensUsecaseMock{
+					IsValidChainDenomFunc: func(chainDenom string) bool {
+						return true
+					},
+				},
+				RUsecase: &mocks.RouterUsecaseMock{
+					GetOptimalQuoteFunc: func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
+						return s.NewExactAmountInQuote(poolOne, poolTwo, poolThree), nil
+					},
+				},
+				SlippageRecommender: &mocks.SlippageRecommenderMock{
+					GetRecommendedSlippageFn: func(tokenInDenom, tokenOutDenom string, priceImpact math.LegacyDec) (math.LegacyDec, bool) {
+						return math.LegacyDec{}, false
+					},
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   s.MustReadFile("../../usecase/routertesting/parsing/quote_amount_in_response.json"),
+		},
 		{
 			name: "invalid swap method request",
 			queryParams: map[string]string{
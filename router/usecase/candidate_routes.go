@@ -50,7 +50,13 @@ func (c candidateRouteFinder) FindCandidateRoutes(tokenIn sdk.Coin, tokenOutDeno
 	queue := make([][]candidatePoolWrapper, 0, 100)
 	queue = append(queue, make([]candidatePoolWrapper, 0, options.MaxPoolsPerRoute))
 
-	denomData, err := c.candidateRouteDataHolder.GetDenomData(tokenIn.Denom)
+	// Read the search data from the pinned snapshot if provided.
+	var searchData domain.CandidateRouteDenomDataGetter = c.candidateRouteDataHolder
+	if options.SearchData != nil {
+		searchData = options.SearchData
+	}
+
+	denomData, err := searchData.GetDenomData(tokenIn.Denom)
 	if err != nil {
 		return sqsdomain.CandidateRoutes{}, err
 	}
@@ -102,7 +108,7 @@ func (c candidateRouteFinder) FindCandidateRoutes(tokenIn sdk.Coin, tokenOutDeno
 			currenTokenInDenom = lastPool.TokenOutDenom
 		}
 
-		denomData, err := c.candidateRouteDataHolder.GetDenomData(currenTokenInDenom)
+		denomData, err := searchData.GetDenomData(currenTokenInDenom)
 		if err != nil {
 			return sqsdomain.CandidateRoutes{}, err
 		}
@@ -189,7 +195,7 @@ func (c candidateRouteFinder) FindCandidateRoutes(tokenIn sdk.Coin, tokenOutDeno
 					continue
 				}
 
-				denomData, err := c.candidateRouteDataHolder.GetDenomData(currenTokenInDenom)
+				denomData, err := searchData.GetDenomData(currenTokenInDenom)
 				if err != nil {
 					return sqsdomain.CandidateRoutes{}, err
 				}
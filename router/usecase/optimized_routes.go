@@ -34,6 +34,8 @@ func (r *routerUseCaseImpl) estimateAndRankSingleRouteQuote(ctx context.Context,
 		if err != nil {
 			logger.Debug("skipping single route due to error in estimate", zap.Error(err))
 			errors = append(errors, err)
+
+			r.recordPoolError(err)
 			continue
 		}
 
@@ -73,6 +75,8 @@ func (r *routerUseCaseImpl) estimateAndRankSingleRouteQuote(ctx context.Context,
 
 	bestRoute := routesWithAmountOut[0]
 
+	r.recordPoolOutliers(ctx, bestRoute.RouteImpl, tokenIn)
+
 	finalQuote := &quoteExactAmountIn{
 		AmountIn:  tokenIn,
 		AmountOut: bestRoute.OutAmount,
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/router/usecase/route"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// SetPoolHealthTracker implements mvc.RouterUsecase.
+func (r *routerUseCaseImpl) SetPoolHealthTracker(tracker mvc.PoolHealthTracker) {
+	r.poolHealthTracker = tracker
+}
+
+// withQuarantinedPoolFilter returns the given candidate route pool filters with the filter skipping
+// the quarantined pools appended. Returns the filters as is if the pool health is not tracked.
+// The given slice is never mutated since it may be shared across requests.
+func (r *routerUseCaseImpl) withQuarantinedPoolFilter(filters []domain.CandidateRoutePoolFiltrerCb) []domain.CandidateRoutePoolFiltrerCb {
+	if r.poolHealthTracker == nil {
+		return filters
+	}
+
+	result := make([]domain.CandidateRoutePoolFiltrerCb, 0, len(filters)+1)
+	result = append(result, filters...)
+	return append(result, r.shouldSkipQuarantinedPool)
+}
+
+// shouldSkipQuarantinedPool returns true if the given pool is quarantined.
+// CONTRACT: the pool health tracker is set.
+func (r *routerUseCaseImpl) shouldSkipQuarantinedPool(pool *sqsdomain.PoolWrapper) bool {
+	return r.poolHealthTracker.IsQuarantined(pool.GetId())
+}
+
+// containsQuarantinedPool returns true if any of the given routes goes through a quarantined pool.
+// Returns false if the pool health is not tracked.
+func (r *routerUseCaseImpl) containsQuarantinedPool(candidateRoutes sqsdomain.CandidateRoutes) bool {
+	if r.poolHealthTracker == nil {
+		return false
+	}
+
+	for _, candidateRoute := range candidateRoutes.Routes {
+		for _, pool := range candidateRoute.Pools {
+			if r.poolHealthTracker.IsQuarantined(pool.ID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// recordPoolError records the error of the pool that failed to estimate the route, if any.
+// No-op if the pool health is not tracked, if the error is not returned by a pool or if it is caused
+// by the requested amount since any caller could otherwise quarantine a pool by quoting an oversized amount.
+func (r *routerUseCaseImpl) recordPoolError(err error) {
+	if r.poolHealthTracker == nil {
+		return
+	}
+
+	var poolErr domain.PoolCalculateTokenOutError
+	if errors.As(err, &poolErr) && !poolErr.IsAmountDriven() {
+		r.poolHealthTracker.RecordError(poolErr.PoolID)
+	}
+}
+
+// recordPoolOutliers estimates the amount out of each pool in the given route and records the pools
+// whose amount out exceeds the one implied by their spot price beyond the configured tolerance.
+// Routes through generalized CosmWasm pools are not checked since estimating them requires querying the chain.
+// No-op if the pool health is not tracked.
+func (r *routerUseCaseImpl) recordPoolOutliers(ctx context.Context, route route.RouteImpl, tokenIn sdk.Coin) {
+	if r.poolHealthTracker == nil || route.ContainsGeneralizedCosmWasmPool() {
+		return
+	}
+
+	for _, pool := range route.GetPools() {
+		tokenIn = pool.ChargeTakerFeeExactIn(tokenIn)
+		if tokenIn.Amount.IsNil() || tokenIn.Amount.IsZero() {
+			return
+		}
+
+		tokenOut, err := pool.CalculateTokenOutByTokenIn(ctx, tokenIn)
+		if err != nil {
+			return
+		}
+
+		spotPriceInBaseOutQuote, err := pool.CalcSpotPrice(ctx, tokenIn.Denom, pool.GetTokenOutDenom())
+		if err == nil {
+			spotPriceImpliedTokenOutAmount := tokenIn.Amount.ToLegacyDec().MulMut(spotPriceInBaseOutQuote.Dec())
+
+			r.poolHealthTracker.RecordTokenOut(pool.GetId(), tokenOut.Amount, spotPriceImpliedTokenOutAmount)
+		}
+
+		tokenIn = tokenOut
+	}
+}
@@ -0,0 +1,237 @@
+package poolhealth
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+type poolHealthTracker struct {
+	config domain.PoolHealthConfig
+	// outlierToleranceMultiplier is one plus the outlier tolerance.
+	outlierToleranceMultiplier osmomath.Dec
+
+	mx sync.RWMutex
+	// height is the latest processed block height.
+	height uint64
+	pools  map[uint64]*poolHealth
+
+	logger log.Logger
+}
+
+// poolHealth is the health of a single pool.
+type poolHealth struct {
+	errors                int
+	outliers              int
+	windowStartHeight     uint64
+	lastMisbehaviorHeight uint64
+	quarantined           bool
+	override              domain.PoolHealthOverride
+}
+
+var (
+	_ mvc.PoolHealthTracker        = &poolHealthTracker{}
+	_ domain.EndBlockProcessPlugin = &poolHealthTracker{}
+)
+
+// New returns a new pool health tracker.
+func New(config domain.PoolHealthConfig, logger log.Logger) *poolHealthTracker {
+	return &poolHealthTracker{
+		config:                     config,
+		outlierToleranceMultiplier: osmomath.OneDec().Add(osmomath.MustNewDecFromStr(strconv.FormatFloat(config.OutlierTolerance, 'f', -1, 64))),
+
+		pools: make(map[uint64]*poolHealth),
+
+		logger: logger,
+	}
+}
+
+// ProcessEndBlock implements domain.EndBlockProcessPlugin.
+// It releases the quarantined pools that have not misbehaved for the configured number of blocks
+// and forgets the healthy pools whose window has elapsed.
+func (t *poolHealthTracker) ProcessEndBlock(ctx context.Context, blockHeight uint64, metadata domain.BlockPoolMetadata) error {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	// The ingester may process the end of consecutive blocks concurrently.
+	if blockHeight <= t.height {
+		return nil
+	}
+	t.height = blockHeight
+
+	for poolID, health := range t.pools {
+		if health.quarantined {
+			if blockHeight < health.lastMisbehaviorHeight+t.config.QuarantineBlocks {
+				continue
+			}
+
+			health.quarantined = false
+			health.errors = 0
+			health.outliers = 0
+			health.windowStartHeight = blockHeight
+
+			t.setQuarantinedGauge(poolID, health)
+			t.logger.Info("pool released from quarantine", zap.Uint64("pool_id", poolID), zap.Uint64("height", blockHeight))
+			continue
+		}
+
+		if health.override == domain.PoolHealthOverrideNone && blockHeight >= health.windowStartHeight+t.config.WindowBlocks {
+			delete(t.pools, poolID)
+		}
+	}
+
+	return nil
+}
+
+// RecordError implements mvc.PoolHealthTracker.
+func (t *poolHealthTracker) RecordError(poolID uint64) {
+	domain.SQSRouterPoolHealthErrorCounter.WithLabelValues(strconv.FormatUint(poolID, 10)).Inc()
+
+	t.recordMisbehavior(poolID, true)
+}
+
+// RecordTokenOut implements mvc.PoolHealthTracker.
+func (t *poolHealthTracker) RecordTokenOut(poolID uint64, tokenOutAmount osmomath.Int, spotPriceImpliedTokenOutAmount osmomath.Dec) bool {
+	if tokenOutAmount.IsNil() || spotPriceImpliedTokenOutAmount.IsNil() || !spotPriceImpliedTokenOutAmount.IsPositive() {
+		return false
+	}
+
+	if tokenOutAmount.ToLegacyDec().LTE(spotPriceImpliedTokenOutAmount.Mul(t.outlierToleranceMultiplier)) {
+		return false
+	}
+
+	domain.SQSRouterPoolHealthOutlierCounter.WithLabelValues(strconv.FormatUint(poolID, 10)).Inc()
+
+	t.recordMisbehavior(poolID, false)
+
+	return true
+}
+
+// IsQuarantined implements mvc.PoolHealthTracker.
+func (t *poolHealthTracker) IsQuarantined(poolID uint64) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	health, ok := t.pools[poolID]
+	if !ok {
+		return false
+	}
+
+	return health.isQuarantined()
+}
+
+// GetPoolHealthStatuses implements mvc.PoolHealthTracker.
+func (t *poolHealthTracker) GetPoolHealthStatuses() []domain.PoolHealthStatus {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	statuses := make([]domain.PoolHealthStatus, 0, len(t.pools))
+	for poolID, health := range t.pools {
+		status := domain.PoolHealthStatus{
+			PoolID:                poolID,
+			Errors:                health.errors,
+			Outliers:              health.outliers,
+			LastMisbehaviorHeight: health.lastMisbehaviorHeight,
+			Quarantined:           health.isQuarantined(),
+			Override:              health.override,
+		}
+
+		if health.quarantined {
+			status.ReleaseHeight = health.lastMisbehaviorHeight + t.config.QuarantineBlocks
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].PoolID < statuses[j].PoolID })
+
+	return statuses
+}
+
+// SetOverride implements mvc.PoolHealthTracker.
+func (t *poolHealthTracker) SetOverride(poolID uint64, override domain.PoolHealthOverride) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	health := t.getOrCreatePoolHealth(poolID)
+	health.override = override
+
+	t.setQuarantinedGauge(poolID, health)
+
+	t.logger.Info("pool health override set", zap.Uint64("pool_id", poolID), zap.String("override", string(override)))
+}
+
+// recordMisbehavior records an error or an outlier result of the given pool
+// and quarantines it if either crosses the configured threshold within the window.
+func (t *poolHealthTracker) recordMisbehavior(poolID uint64, isError bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	health := t.getOrCreatePoolHealth(poolID)
+
+	// Restart the window if it has elapsed.
+	if t.height >= health.windowStartHeight+t.config.WindowBlocks {
+		health.errors = 0
+		health.outliers = 0
+		health.windowStartHeight = t.height
+	}
+
+	if isError {
+		health.errors++
+	} else {
+		health.outliers++
+	}
+	health.lastMisbehaviorHeight = t.height
+
+	if health.quarantined || (health.errors < t.config.ErrorThreshold && health.outliers < t.config.OutlierThreshold) {
+		return
+	}
+
+	health.quarantined = true
+
+	t.setQuarantinedGauge(poolID, health)
+
+	t.logger.Warn("pool quarantined", zap.Uint64("pool_id", poolID), zap.Int("errors", health.errors), zap.Int("outliers", health.outliers), zap.Uint64("height", t.height))
+}
+
+// getOrCreatePoolHealth returns the health of the given pool, creating it if it does not exist.
+// CONTRACT: the caller holds the write lock.
+func (t *poolHealthTracker) getOrCreatePoolHealth(poolID uint64) *poolHealth {
+	health, ok := t.pools[poolID]
+	if !ok {
+		health = &poolHealth{windowStartHeight: t.height}
+		t.pools[poolID] = health
+	}
+	return health
+}
+
+// setQuarantinedGauge sets the quarantined gauge of the given pool.
+func (t *poolHealthTracker) setQuarantinedGauge(poolID uint64, health *poolHealth) {
+	value := 0.0
+	if health.isQuarantined() {
+		value = 1
+	}
+
+	domain.SQSRouterPoolQuarantinedGauge.WithLabelValues(strconv.FormatUint(poolID, 10)).Set(value)
+}
+
+// isQuarantined returns true if the pool is excluded from the candidate routes, accounting for the override.
+func (h *poolHealth) isQuarantined() bool {
+	switch h.override {
+	case domain.PoolHealthOverrideQuarantined:
+		return true
+	case domain.PoolHealthOverrideHealthy:
+		return false
+	default:
+		return h.quarantined
+	}
+}
@@ -0,0 +1,171 @@
+package poolhealth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/router/usecase/poolhealth"
+)
+
+const poolID = uint64(1)
+
+var (
+	defaultConfig = domain.PoolHealthConfig{
+		Enabled:          true,
+		ErrorThreshold:   3,
+		OutlierThreshold: 2,
+		OutlierTolerance: 0.5,
+		WindowBlocks:     10,
+		QuarantineBlocks: 20,
+	}
+)
+
+// processEndBlock processes the end of the block at the given height.
+func processEndBlock(t *testing.T, tracker domain.EndBlockProcessPlugin, height uint64) {
+	require.NoError(t, tracker.ProcessEndBlock(context.TODO(), height, domain.BlockPoolMetadata{}))
+}
+
+func TestPoolHealthTracker_Errors(t *testing.T) {
+	tracker := poolhealth.New(defaultConfig, &log.NoOpLogger{})
+	processEndBlock(t, tracker, 100)
+
+	// Below the threshold.
+	tracker.RecordError(poolID)
+	tracker.RecordError(poolID)
+	require.False(t, tracker.IsQuarantined(poolID))
+
+	// Crosses the threshold.
+	tracker.RecordError(poolID)
+	require.True(t, tracker.IsQuarantined(poolID))
+	require.Equal(t, []domain.PoolHealthStatus{
+		{
+			PoolID:                poolID,
+			Errors:                3,
+			LastMisbehaviorHeight: 100,
+			Quarantined:           true,
+			ReleaseHeight:         120,
+		},
+	}, tracker.GetPoolHealthStatuses())
+
+	// Misbehaving again delays the release.
+	processEndBlock(t, tracker, 110)
+	tracker.RecordError(poolID)
+
+	processEndBlock(t, tracker, 120)
+	require.True(t, tracker.IsQuarantined(poolID))
+
+	// Released after behaving for the configured number of blocks.
+	processEndBlock(t, tracker, 130)
+	require.False(t, tracker.IsQuarantined(poolID))
+	require.Equal(t, []domain.PoolHealthStatus{
+		{
+			PoolID:                poolID,
+			LastMisbehaviorHeight: 110,
+		},
+	}, tracker.GetPoolHealthStatuses())
+
+	// Forgotten once the window elapses.
+	processEndBlock(t, tracker, 140)
+	require.Empty(t, tracker.GetPoolHealthStatuses())
+}
+
+func TestPoolHealthTracker_ErrorWindow(t *testing.T) {
+	tracker := poolhealth.New(defaultConfig, &log.NoOpLogger{})
+	processEndBlock(t, tracker, 100)
+
+	tracker.RecordError(poolID)
+	tracker.RecordError(poolID)
+
+	// The errors of the elapsed window are not counted.
+	processEndBlock(t, tracker, 105)
+	processEndBlock(t, tracker, 110)
+	tracker.RecordError(poolID)
+
+	require.False(t, tracker.IsQuarantined(poolID))
+	require.Equal(t, 1, tracker.GetPoolHealthStatuses()[0].Errors)
+}
+
+func TestPoolHealthTracker_RecordTokenOut(t *testing.T) {
+	tests := []struct {
+		name                           string
+		tokenOutAmount                 osmomath.Int
+		spotPriceImpliedTokenOutAmount osmomath.Dec
+
+		expectedIsOutlier bool
+	}{
+		{
+			name:                           "below spot price implied amount",
+			tokenOutAmount:                 osmomath.NewInt(90),
+			spotPriceImpliedTokenOutAmount: osmomath.NewDec(100),
+		},
+		{
+			name:                           "at tolerance",
+			tokenOutAmount:                 osmomath.NewInt(150),
+			spotPriceImpliedTokenOutAmount: osmomath.NewDec(100),
+		},
+		{
+			name:                           "beyond tolerance",
+			tokenOutAmount:                 osmomath.NewInt(151),
+			spotPriceImpliedTokenOutAmount: osmomath.NewDec(100),
+
+			expectedIsOutlier: true,
+		},
+		{
+			name:                           "zero spot price implied amount",
+			tokenOutAmount:                 osmomath.NewInt(151),
+			spotPriceImpliedTokenOutAmount: osmomath.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := poolhealth.New(defaultConfig, &log.NoOpLogger{})
+
+			isOutlier := tracker.RecordTokenOut(poolID, tc.tokenOutAmount, tc.spotPriceImpliedTokenOutAmount)
+			require.Equal(t, tc.expectedIsOutlier, isOutlier)
+
+			expectedStatuses := []domain.PoolHealthStatus{}
+			if tc.expectedIsOutlier {
+				expectedStatuses = []domain.PoolHealthStatus{{PoolID: poolID, Outliers: 1}}
+			}
+			require.Equal(t, expectedStatuses, tracker.GetPoolHealthStatuses())
+		})
+	}
+
+	// Crosses the outlier threshold.
+	tracker := poolhealth.New(defaultConfig, &log.NoOpLogger{})
+	tracker.RecordTokenOut(poolID, osmomath.NewInt(200), osmomath.NewDec(100))
+	require.False(t, tracker.IsQuarantined(poolID))
+	tracker.RecordTokenOut(poolID, osmomath.NewInt(200), osmomath.NewDec(100))
+	require.True(t, tracker.IsQuarantined(poolID))
+}
+
+func TestPoolHealthTracker_Override(t *testing.T) {
+	tracker := poolhealth.New(defaultConfig, &log.NoOpLogger{})
+	processEndBlock(t, tracker, 100)
+
+	// Quarantined regardless of the behavior.
+	tracker.SetOverride(poolID, domain.PoolHealthOverrideQuarantined)
+	require.True(t, tracker.IsQuarantined(poolID))
+
+	// The override is retained across the blocks.
+	processEndBlock(t, tracker, 200)
+	require.True(t, tracker.IsQuarantined(poolID))
+
+	// Never quarantined regardless of the behavior.
+	tracker.SetOverride(poolID, domain.PoolHealthOverrideHealthy)
+	for i := 0; i < defaultConfig.ErrorThreshold; i++ {
+		tracker.RecordError(poolID)
+	}
+	require.False(t, tracker.IsQuarantined(poolID))
+
+	// Clearing the override falls back to the behavior.
+	tracker.SetOverride(poolID, domain.PoolHealthOverrideNone)
+	require.True(t, tracker.IsQuarantined(poolID))
+}
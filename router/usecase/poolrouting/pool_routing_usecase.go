@@ -0,0 +1,136 @@
+package poolrouting
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/router/usecase/routertesting/parsing"
+)
+
+type poolRoutingUseCase struct {
+	// baseConfig is the static pool routing config.
+	baseConfig domain.PoolRoutingConfig
+	// overlayFile is the file the overlay is persisted to. Empty if the overlay is not persisted.
+	overlayFile string
+
+	// mx serializes the updates, including the notification of the listeners,
+	// so that the listeners observe the updates in order.
+	mx        sync.RWMutex
+	overlay   domain.PoolRoutingOverlay
+	config    domain.PoolRoutingConfig
+	listeners []domain.PoolRoutingConfigUpdateListener
+
+	logger log.Logger
+}
+
+var _ mvc.PoolRoutingUsecase = &poolRoutingUseCase{}
+
+// New returns a new pool routing usecase with the overlay restored from the given file
+// applied on top of the given static config.
+// Returns error if the overlay file exists but cannot be read.
+func New(baseConfig domain.PoolRoutingConfig, overlayFile string, logger log.Logger) (*poolRoutingUseCase, error) {
+	p := &poolRoutingUseCase{
+		baseConfig:  baseConfig,
+		overlayFile: overlayFile,
+
+		listeners: []domain.PoolRoutingConfigUpdateListener{},
+
+		logger: logger,
+	}
+
+	if overlayFile != "" {
+		overlay, err := parsing.ReadPoolRoutingOverlay(overlayFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if err == nil {
+			p.overlay = overlay
+			logger.Info("restored pool routing overlay", zap.String("overlay_file", overlayFile), zap.Any("overlay", overlay))
+		}
+	}
+
+	p.config = p.overlay.Apply(baseConfig)
+
+	return p, nil
+}
+
+// GetPoolRoutingConfig implements mvc.PoolRoutingUsecase.
+func (p *poolRoutingUseCase) GetPoolRoutingConfig() domain.PoolRoutingConfig {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.config
+}
+
+// GetPoolRoutingOverlay implements mvc.PoolRoutingUsecase.
+func (p *poolRoutingUseCase) GetPoolRoutingOverlay() domain.PoolRoutingOverlay {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.overlay
+}
+
+// AddIDs implements mvc.PoolRoutingUsecase.
+func (p *poolRoutingUseCase) AddIDs(ctx context.Context, list domain.PoolRoutingList, ids []uint64) (domain.PoolRoutingConfig, error) {
+	return p.update(ctx, func(overlay *domain.PoolRoutingOverlay) error {
+		return overlay.Add(list, ids)
+	})
+}
+
+// RemoveIDs implements mvc.PoolRoutingUsecase.
+func (p *poolRoutingUseCase) RemoveIDs(ctx context.Context, list domain.PoolRoutingList, ids []uint64) (domain.PoolRoutingConfig, error) {
+	return p.update(ctx, func(overlay *domain.PoolRoutingOverlay) error {
+		return overlay.Remove(list, ids)
+	})
+}
+
+// RegisterListener implements mvc.PoolRoutingUsecase.
+func (p *poolRoutingUseCase) RegisterListener(listener domain.PoolRoutingConfigUpdateListener) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.listeners = append(p.listeners, listener)
+}
+
+// update applies the given update to a copy of the overlay, persists it and then
+// notifies the listeners of the updated effective config.
+// The overlay is left as is if the update or its persistence fails.
+// All listeners are notified even if some of them fail, in which case the errors are joined.
+func (p *poolRoutingUseCase) update(ctx context.Context, updateFn func(overlay *domain.PoolRoutingOverlay) error) (domain.PoolRoutingConfig, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	overlay := p.overlay
+	if err := updateFn(&overlay); err != nil {
+		return domain.PoolRoutingConfig{}, err
+	}
+
+	if p.overlayFile != "" {
+		if err := parsing.StorePoolRoutingOverlay(overlay, p.overlayFile); err != nil {
+			return domain.PoolRoutingConfig{}, err
+		}
+	}
+
+	p.overlay = overlay
+	p.config = overlay.Apply(p.baseConfig)
+
+	p.logger.Info("pool routing config updated", zap.Any("config", p.config))
+
+	var errs []error
+	for _, listener := range p.listeners {
+		if err := listener.OnPoolRoutingConfigUpdate(ctx, p.config); err != nil {
+			p.logger.Error("failed to notify pool routing config update listener", zap.Error(err))
+			errs = append(errs, err)
+		}
+	}
+
+	return p.config, errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+package poolrouting_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/router/usecase/poolrouting"
+)
+
+var (
+	defaultBaseConfig = domain.PoolRoutingConfig{
+		DeniedPoolIDs:     []uint64{},
+		PreferredPoolIDs:  []uint64{1},
+		TransmuterCodeIDs: []uint64{148},
+	}
+)
+
+// listenerFunc is a pool routing config update listener backed by a function.
+type listenerFunc func(ctx context.Context, config domain.PoolRoutingConfig) error
+
+func (f listenerFunc) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	return f(ctx, config)
+}
+
+// This test validates that the updates are applied on top of the static config, that the listeners
+// are notified in order and that the overlay is restored on restart.
+func TestPoolRoutingUsecase(t *testing.T) {
+	overlayFile := filepath.Join(t.TempDir(), "pool_routing_overlay.json")
+
+	usecase, err := poolrouting.New(defaultBaseConfig, overlayFile, &log.NoOpLogger{})
+	require.NoError(t, err)
+
+	// No overlay yet.
+	require.Equal(t, []uint64{1}, usecase.GetPoolRoutingConfig().PreferredPoolIDs)
+
+	notified := []string{}
+	usecase.RegisterListener(listenerFunc(func(ctx context.Context, config domain.PoolRoutingConfig) error {
+		notified = append(notified, "first")
+		require.Equal(t, []uint64{5}, config.DeniedPoolIDs)
+		return nil
+	}))
+	usecase.RegisterListener(listenerFunc(func(ctx context.Context, config domain.PoolRoutingConfig) error {
+		notified = append(notified, "second")
+		return nil
+	}))
+
+	config, err := usecase.AddIDs(context.TODO(), domain.PoolRoutingListDeniedPoolIDs, []uint64{5})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, config.DeniedPoolIDs)
+	require.Equal(t, []string{"first", "second"}, notified)
+
+	_, err = usecase.RemoveIDs(context.TODO(), domain.PoolRoutingListTransmuterCodeIDs, []uint64{148})
+	require.NoError(t, err)
+	require.Empty(t, usecase.GetPoolRoutingConfig().TransmuterCodeIDs)
+
+	// Restored on restart.
+	restored, err := poolrouting.New(defaultBaseConfig, overlayFile, &log.NoOpLogger{})
+	require.NoError(t, err)
+	require.Equal(t, usecase.GetPoolRoutingConfig(), restored.GetPoolRoutingConfig())
+	require.Equal(t, usecase.GetPoolRoutingOverlay(), restored.GetPoolRoutingOverlay())
+}
+
+// This test validates that a failed update leaves the overlay as is and that
+// the listener errors are returned once all listeners are notified.
+func TestPoolRoutingUsecase_Errors(t *testing.T) {
+	t.Run("invalid list", func(t *testing.T) {
+		usecase, err := poolrouting.New(defaultBaseConfig, "", &log.NoOpLogger{})
+		require.NoError(t, err)
+
+		_, err = usecase.AddIDs(context.TODO(), domain.PoolRoutingList("unknown"), []uint64{5})
+		require.Error(t, err)
+		require.Equal(t, domain.PoolRoutingOverlay{}, usecase.GetPoolRoutingOverlay())
+	})
+
+	t.Run("overlay not persisted", func(t *testing.T) {
+		overlayFile := filepath.Join(t.TempDir(), "missing", "pool_routing_overlay.json")
+
+		usecase, err := poolrouting.New(defaultBaseConfig, overlayFile, &log.NoOpLogger{})
+		require.NoError(t, err)
+
+		_, err = usecase.AddIDs(context.TODO(), domain.PoolRoutingListDeniedPoolIDs, []uint64{5})
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Empty(t, usecase.GetPoolRoutingConfig().DeniedPoolIDs)
+	})
+
+	t.Run("listener error", func(t *testing.T) {
+		usecase, err := poolrouting.New(defaultBaseConfig, "", &log.NoOpLogger{})
+		require.NoError(t, err)
+
+		listenerErr := errors.New("listener error")
+		isSecondNotified := false
+		usecase.RegisterListener(listenerFunc(func(ctx context.Context, config domain.PoolRoutingConfig) error {
+			return listenerErr
+		}))
+		usecase.RegisterListener(listenerFunc(func(ctx context.Context, config domain.PoolRoutingConfig) error {
+			isSecondNotified = true
+			return nil
+		}))
+
+		_, err = usecase.AddIDs(context.TODO(), domain.PoolRoutingListDeniedPoolIDs, []uint64{5})
+		require.ErrorIs(t, err, listenerErr)
+		require.True(t, isSecondNotified)
+		require.Equal(t, []uint64{5}, usecase.GetPoolRoutingConfig().DeniedPoolIDs)
+	})
+
+	t.Run("corrupted overlay file", func(t *testing.T) {
+		overlayFile := filepath.Join(t.TempDir(), "pool_routing_overlay.json")
+		require.NoError(t, os.WriteFile(overlayFile, []byte("{"), 0o600))
+
+		_, err := poolrouting.New(defaultBaseConfig, overlayFile, &log.NoOpLogger{})
+		require.Error(t, err)
+	})
+}
@@ -26,6 +26,20 @@ type quoteExactAmountOut struct {
 	EffectiveFee            osmomath.Dec        "json:\"effective_fee\""
 	PriceImpact             osmomath.Dec        "json:\"price_impact\""
 	InBaseOutQuoteSpotPrice osmomath.Dec        "json:\"in_base_out_quote_spot_price\""
+	Height                  uint64              `json:"height,omitempty"`
+	RecommendedSlippage     *osmomath.Dec       `json:"recommended_slippage,omitempty"`
+}
+
+// SetHeight implements domain.Quote.
+func (q *quoteExactAmountOut) SetHeight(height uint64) {
+	q.quoteExactAmountIn.SetHeight(height)
+	q.Height = height
+}
+
+// SetRecommendedSlippage implements domain.Quote.
+func (q *quoteExactAmountOut) SetRecommendedSlippage(slippage osmomath.Dec) {
+	q.quoteExactAmountIn.SetRecommendedSlippage(slippage)
+	q.RecommendedSlippage = &slippage
 }
 
 // PrepareResult implements domain.Quote.
@@ -43,6 +43,9 @@ type quoteExactAmountIn struct {
 	PriceImpact             osmomath.Dec        "json:\"price_impact\""
 	InBaseOutQuoteSpotPrice osmomath.Dec        "json:\"in_base_out_quote_spot_price\""
 	PriceInfo               *domain.TxFeeInfo   `json:"price_info,omitempty"`
+	Height                  uint64              `json:"height,omitempty"`
+	// RecommendedSlippage is the recommended slippage tolerance, omitted if it is not available.
+	RecommendedSlippage *osmomath.Dec `json:"recommended_slippage,omitempty"`
 }
 
 // PrepareResult implements domain.Quote.
@@ -157,3 +160,13 @@ func (q *quoteExactAmountIn) GetInBaseOutQuoteSpotPrice() osmomath.Dec {
 func (q *quoteExactAmountIn) SetQuotePriceInfo(info *domain.TxFeeInfo) {
 	q.PriceInfo = info
 }
+
+// SetHeight implements domain.Quote.
+func (q *quoteExactAmountIn) SetHeight(height uint64) {
+	q.Height = height
+}
+
+// SetRecommendedSlippage implements domain.Quote.
+func (q *quoteExactAmountIn) SetRecommendedSlippage(slippage osmomath.Dec) {
+	q.RecommendedSlippage = &slippage
+}
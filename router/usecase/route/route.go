@@ -136,7 +136,7 @@ func (r *RouteImpl) CalculateTokenOutByTokenIn(ctx context.Context, tokenIn sdk.
 
 		tokenOut, err = pool.CalculateTokenOutByTokenIn(ctx, tokenIn)
 		if err != nil {
-			return sdk.Coin{}, err
+			return sdk.Coin{}, domain.PoolCalculateTokenOutError{PoolID: pool.GetId(), Err: err}
 		}
 
 		tokenIn = tokenOut
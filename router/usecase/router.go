@@ -43,15 +43,25 @@ func FilterPoolsByMinLiquidity(pools []sqsdomain.PoolI, minPoolLiquidityCap uint
 // according to the given configuration.
 // Filters out pools that have no tvl error set and have zero liquidity.
 // As a second return value, it returns the orderbook pools.
-func ValidateAndSortPools(pools []sqsdomain.PoolI, cosmWasmPoolsConfig domain.CosmWasmPoolRouterConfig, preferredPoolIDs []uint64, logger log.Logger) ([]sqsdomain.PoolI, []sqsdomain.PoolI) {
+func ValidateAndSortPools(pools []sqsdomain.PoolI, cosmWasmPoolsConfig domain.CosmWasmPoolRouterConfig, preferredPoolIDs []uint64, deniedPoolIDs []uint64, logger log.Logger) ([]sqsdomain.PoolI, []sqsdomain.PoolI) {
 	filteredPools := make([]sqsdomain.PoolI, 0, len(pools))
 
+	deniedPoolIDsMap := make(map[uint64]struct{}, len(deniedPoolIDs))
+	for _, poolID := range deniedPoolIDs {
+		deniedPoolIDsMap[poolID] = struct{}{}
+	}
+
 	totalTVL := osmomath.ZeroInt()
 
 	orderbookPools := make([]sqsdomain.PoolI, 0)
 
 	// Make a copy and filter pools
 	for _, pool := range pools {
+		if _, isDenied := deniedPoolIDsMap[pool.GetId()]; isDenied {
+			logger.Debug("pool is denied via config, skip silently", zap.Uint64("pool_id", pool.GetId()))
+			continue
+		}
+
 		// TODO: the zero argument can be removed in a future release
 		// since we will be filtering at a different layer of abstraction.
 		if err := pool.Validate(zero); err != nil {
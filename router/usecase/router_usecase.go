@@ -44,6 +44,10 @@ type routerUseCaseImpl struct {
 	sortedPools   []sqsdomain.PoolI
 
 	candidateRouteCache *cache.Cache
+
+	// poolHealthTracker records the pool misbehavior and quarantines the pools.
+	// Nil if the pool health is not tracked.
+	poolHealthTracker mvc.PoolHealthTracker
 }
 
 const (
@@ -129,7 +133,7 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 	// compute them.
 	if len(candidateRankedRoutes.Routes) == 0 {
 		// Get the dynamic min pool liquidity cap for the given token in and token out denoms.
-		dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(tokenIn.Denom, tokenOutDenom)
+		dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(ctx, tokenIn.Denom, tokenOutDenom)
 		if err == nil {
 			// Set the dynamic min pool liquidity cap only if there is no error retrieving it.
 			// Otherwise, use the default.
@@ -231,7 +235,7 @@ func (r *routerUseCaseImpl) GetSimpleQuote(ctx context.Context, tokenIn sdk.Coin
 		opt(&options)
 	}
 
-	dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(tokenIn.Denom, tokenOutDenom)
+	dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(ctx, tokenIn.Denom, tokenOutDenom)
 	if err == nil {
 		// Set the dynamic min pool liquidity cap only if there is no error retrieving it.
 		// Oterwise, use default.
@@ -248,6 +252,8 @@ func (r *routerUseCaseImpl) GetSimpleQuote(ctx context.Context, tokenIn sdk.Coin
 		MaxRoutes:           options.MaxRoutes,
 		MaxPoolsPerRoute:    options.MaxPoolsPerRoute,
 		MinPoolLiquidityCap: options.MinPoolLiquidityCap,
+		PoolFiltersAnyOf:    r.withQuarantinedPoolFilter(nil),
+		SearchData:          getSearchDataFromContext(ctx),
 	}
 	candidateRoutes, err := r.candidateRouteSearcher.FindCandidateRoutes(tokenIn, tokenOutDenom, candidateRouteSearchOptions)
 	if err != nil {
@@ -255,7 +261,7 @@ func (r *routerUseCaseImpl) GetSimpleQuote(ctx context.Context, tokenIn sdk.Coin
 		return nil, err
 	}
 
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(candidateRoutes, tokenIn.Denom, tokenOutDenom)
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		r.logger.Error("error ranking routes for pricing", zap.Error(err))
 		return nil, err
@@ -331,9 +337,8 @@ func filterAndConvertDuplicatePoolIDRankedRoutes(rankedRoutes []RouteWithOutAmou
 // - fails to convert candidate routes to routes
 // - fails to estimate direct quotes
 func (r *routerUseCaseImpl) rankRoutesByDirectQuote(ctx context.Context, candidateRoutes sqsdomain.CandidateRoutes, tokenIn sdk.Coin, tokenOutDenom string, maxSplitRoutes int) (domain.Quote, []route.RouteImpl, error) {
-	// Note that retrieving pools and taker fees is done in separate transactions.
-	// This is fine because taker fees don't change often.
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(candidateRoutes, tokenIn.Denom, tokenOutDenom)
+	// Pools and taker fees are read from the same state snapshot if one is pinned in the context.
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -361,7 +366,8 @@ func (r *routerUseCaseImpl) computeAndRankRoutesByDirectQuote(ctx context.Contex
 		MaxPoolsPerRoute:    routingOptions.MaxPoolsPerRoute,
 		MinPoolLiquidityCap: routingOptions.MinPoolLiquidityCap,
 		DisableCache:        routingOptions.DisableCache,
-		PoolFiltersAnyOf:    routingOptions.CandidateRoutesPoolFiltersAnyOf,
+		PoolFiltersAnyOf:    r.withQuarantinedPoolFilter(routingOptions.CandidateRoutesPoolFiltersAnyOf),
+		SearchData:          getSearchDataFromContext(ctx),
 	}
 
 	// If top routes are not present in cache, retrieve unranked candidate routes
@@ -435,7 +441,7 @@ var (
 
 // GetCustomDirectQuote implements mvc.RouterUsecase.
 func (r *routerUseCaseImpl) GetCustomDirectQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, poolID uint64) (domain.Quote, error) {
-	pool, err := r.poolsUsecase.GetPool(poolID)
+	pool, err := r.getPool(ctx, poolID)
 	if err != nil {
 		return nil, err
 	}
@@ -453,7 +459,7 @@ func (r *routerUseCaseImpl) GetCustomDirectQuote(ctx context.Context, tokenIn sd
 	candidateRoutes := r.createCandidateRouteByPoolID(tokenOutDenom, poolID)
 
 	// Convert candidate route into a route with all the pool data
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(candidateRoutes, tokenIn.Denom, tokenOutDenom)
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -551,10 +557,12 @@ func (r *routerUseCaseImpl) GetCandidateRoutes(ctx context.Context, tokenIn sdk.
 		MaxRoutes:           r.defaultConfig.MaxRoutes,
 		MaxPoolsPerRoute:    r.defaultConfig.MaxPoolsPerRoute,
 		MinPoolLiquidityCap: r.defaultConfig.MinPoolLiquidityCap,
+		PoolFiltersAnyOf:    r.withQuarantinedPoolFilter(nil),
+		SearchData:          getSearchDataFromContext(ctx),
 	}
 
 	// Get the dynamic min pool liquidity cap for the given token in and token out denoms.
-	dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(tokenIn.Denom, tokenOutDenom)
+	dynamicMinPoolLiquidityCap, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(ctx, tokenIn.Denom, tokenOutDenom)
 	if err == nil {
 		// Set the dynamic min pool liquidity cap only if there is no error retrieving it.
 		// Otherwise, use the default.
@@ -631,6 +639,14 @@ func (r *routerUseCaseImpl) GetCachedCandidateRoutes(ctx context.Context, tokenI
 		return sqsdomain.CandidateRoutes{}, false, fmt.Errorf("error casting candidate routes from cache")
 	}
 
+	// Routes through the pools quarantined since they were cached have to be recomputed.
+	if r.containsQuarantinedPool(candidateRoutes) {
+		return sqsdomain.CandidateRoutes{
+			Routes:        []sqsdomain.CandidateRoute{},
+			UniquePoolIDs: map[uint64]struct{}{},
+		}, false, nil
+	}
+
 	return candidateRoutes, true, nil
 }
 
@@ -661,6 +677,11 @@ func (r *routerUseCaseImpl) GetCachedRankedRoutes(ctx context.Context, tokenInDe
 		return sqsdomain.CandidateRoutes{}, fmt.Errorf("error casting candidate routes from cache")
 	}
 
+	// Routes through the pools quarantined since they were cached have to be recomputed.
+	if r.containsQuarantinedPool(rankedRoutes) {
+		return sqsdomain.CandidateRoutes{}, nil
+	}
+
 	return rankedRoutes, nil
 }
 
@@ -854,10 +875,10 @@ func (r *routerUseCaseImpl) ConvertMinTokensPoolLiquidityCapToFilter(minTokensPo
 // getMinPoolLiquidityCapFilter returns the min liquidity cap filter for the given tokenIn and tokenOutDenom.
 // If the mapping between min liquidity cap and the filter is not found, it will return the default per config.
 // Returns the min liquidity cap filter and an error if any.
-func (r *routerUseCaseImpl) GetMinPoolLiquidityCapFilter(tokenInDenom, tokenOutDenom string) (uint64, error) {
+func (r *routerUseCaseImpl) GetMinPoolLiquidityCapFilter(ctx context.Context, tokenInDenom, tokenOutDenom string) (uint64, error) {
 	defaultMinLiquidityCap := r.defaultConfig.MinPoolLiquidityCap
 
-	minPoolLiquidityCapBetweenTokens, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(tokenInDenom, tokenOutDenom)
+	minPoolLiquidityCapBetweenTokens, err := r.tokenMetadataHolder.GetMinPoolLiquidityCap(ctx, tokenInDenom, tokenOutDenom)
 	if err != nil {
 		// If fallback is enabled, get defaiult config value as fallback
 		return defaultMinLiquidityCap, nil
@@ -872,7 +893,7 @@ func (r *routerUseCaseImpl) GetMinPoolLiquidityCapFilter(tokenInDenom, tokenOutD
 
 // GetPoolSpotPrice implements mvc.RouterUsecase.
 func (r *routerUseCaseImpl) GetPoolSpotPrice(ctx context.Context, poolID uint64, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
-	poolTakerFee, ok := r.routerRepository.GetTakerFee(quoteAsset, baseAsset)
+	poolTakerFee, ok := r.getTakerFee(ctx, quoteAsset, baseAsset)
 	if !ok {
 		return osmomath.BigDec{}, fmt.Errorf("taker fee not found for pool %d, denom in (%s), denom out (%s)", poolID, quoteAsset, baseAsset)
 	}
@@ -885,6 +906,43 @@ func (r *routerUseCaseImpl) GetPoolSpotPrice(ctx context.Context, poolID uint64,
 	return spotPrice, nil
 }
 
+// GetPoolLiquidityCap implements mvc.SimpleRouterUsecase.
+func (r *routerUseCaseImpl) GetPoolLiquidityCap(ctx context.Context, poolID uint64) (osmomath.Int, error) {
+	pool, err := r.getPool(ctx, poolID)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return pool.GetLiquidityCap(), nil
+}
+
+// getPool returns the pool with the given ID from the state snapshot pinned in the context.
+// Falls back to the live pools if no snapshot is pinned.
+func (r *routerUseCaseImpl) getPool(ctx context.Context, poolID uint64) (sqsdomain.PoolI, error) {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		return snapshot.GetPool(poolID)
+	}
+	return r.poolsUsecase.GetPool(poolID)
+}
+
+// getTakerFee returns the taker fee for the given denoms from the state snapshot pinned in the context.
+// Falls back to the live taker fees if no snapshot is pinned.
+func (r *routerUseCaseImpl) getTakerFee(ctx context.Context, denom0, denom1 string) (osmomath.Dec, bool) {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		return snapshot.GetTakerFee(denom0, denom1)
+	}
+	return r.routerRepository.GetTakerFee(denom0, denom1)
+}
+
+// getSearchDataFromContext returns the candidate route search data of the state snapshot
+// pinned in the context. Returns nil if no snapshot is pinned so that the live data is used.
+func getSearchDataFromContext(ctx context.Context) domain.CandidateRouteDenomDataGetter {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		return snapshot
+	}
+	return nil
+}
+
 // GetBaseFee implements mvc.RouterUsecase.
 func (r *routerUseCaseImpl) GetBaseFee() domain.BaseFee {
 	return r.routerRepository.GetBaseFee()
@@ -954,3 +1012,14 @@ func (r *routerUseCaseImpl) createCandidateRouteByPoolID(tokenOutDenom string, p
 		},
 	}
 }
+
+// OnPoolRoutingConfigUpdate implements mvc.RouterUsecase.
+// It flushes the candidate and ranked route caches since any of the cached routes
+// may go through a pool that is denied or whose code ID is no longer supported.
+// CONTRACT: the search data is recomputed with the updated config first.
+func (r *routerUseCaseImpl) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	r.candidateRouteCache.Clear()
+	r.rankedRouteCache.Clear()
+
+	return nil
+}
@@ -19,12 +19,15 @@ import (
 	routerrepo "github.com/osmosis-labs/sqs/router/repository"
 	"github.com/osmosis-labs/sqs/router/types"
 	"github.com/osmosis-labs/sqs/router/usecase"
+	"github.com/osmosis-labs/sqs/router/usecase/poolhealth"
 	"github.com/osmosis-labs/sqs/router/usecase/route"
 	"github.com/osmosis-labs/sqs/router/usecase/routertesting"
 	"github.com/osmosis-labs/sqs/sqsdomain"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v27/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v27/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v27/x/poolmanager/types"
 )
 
 const (
@@ -881,7 +884,7 @@ func (s *RouterTestSuite) TestGetCandidateRoutes_Chain_FindUnsupportedRoutes() {
 			continue
 		}
 
-		minPoolLiquidityCap, err := mainnetUsecase.Tokens.GetMinPoolLiquidityCap(chainDenom, USDC)
+		minPoolLiquidityCap, err := mainnetUsecase.Tokens.GetMinPoolLiquidityCap(context.Background(), chainDenom, USDC)
 		s.Require().NoError(err)
 
 		minPoolLiquidityCapFilter := mainnetUsecase.Router.ConvertMinTokensPoolLiquidityCapToFilter(minPoolLiquidityCap)
@@ -1020,7 +1023,7 @@ func (s *RouterTestSuite) TestSortPools() {
 			orderbookCodeID: {},
 		},
 	}
-	sortedPools, orderBookPools := usecase.ValidateAndSortPools(pools, cosmWasmPoolsConfig, []uint64{}, noOpLogger)
+	sortedPools, orderBookPools := usecase.ValidateAndSortPools(pools, cosmWasmPoolsConfig, []uint64{}, []uint64{}, noOpLogger)
 	s.Require().NotEmpty(orderBookPools)
 
 	// Filter pools by min liquidity
@@ -1037,6 +1040,15 @@ func (s *RouterTestSuite) TestSortPools() {
 		s.Require().NotNil(cosmWasmModel)
 		s.Require().True(pool.GetSQSPoolModel().CosmWasmPoolModel.IsOrderbook())
 	}
+
+	// Denied pools are filtered out.
+	sortedPoolsWithDenied, _ := usecase.ValidateAndSortPools(pools, cosmWasmPoolsConfig, []uint64{}, []uint64{expectedTopPoolID}, noOpLogger)
+	sortedPoolsWithDenied = usecase.FilterPoolsByMinLiquidity(sortedPoolsWithDenied, defaultRouterConfig.MinPoolLiquidityCap)
+
+	s.Require().Len(sortedPoolsWithDenied, len(sortedPools)-1)
+	for _, pool := range sortedPoolsWithDenied {
+		s.Require().NotEqual(expectedTopPoolID, pool.GetId())
+	}
 }
 
 // Validates ConvertMinTokensPoolLiquidityCapToFilter method per its spec.
@@ -1688,7 +1700,7 @@ func (s *RouterTestSuite) TestGetMinPoolLiquidityCapFilter() {
 				},
 			})
 
-			actualFilter, err := mainnetUsecase.Router.GetMinPoolLiquidityCapFilter(tc.tokenInDenom, tc.tokenOutDenom)
+			actualFilter, err := mainnetUsecase.Router.GetMinPoolLiquidityCapFilter(context.Background(), tc.tokenInDenom, tc.tokenOutDenom)
 
 			if tc.expectErr {
 				s.Require().Error(err)
@@ -1718,3 +1730,165 @@ func (s *RouterTestSuite) validatePoolIDInRoute(routerUseCase mvc.RouterUsecase,
 	// Validate that the pool ID is the expected one
 	s.Require().Equal(expectedPoolID, routePools[0].GetId())
 }
+
+// Tests that the pool health tracker records the errors and outlier results of the pools
+// and that the cached routes through the quarantined pools are treated as cache misses.
+func (s *RouterTestSuite) TestPoolHealthTracker_Quarantine() {
+	const (
+		tokenInDenom  = "uosmo"
+		tokenOutDenom = "uion"
+
+		errorPoolID   = uint64(1)
+		outlierPoolID = uint64(2)
+	)
+
+	var (
+		ctx     = context.Background()
+		tokenIn = sdk.NewCoin(tokenInDenom, osmomath.NewInt(100))
+	)
+
+	poolHealthTracker := poolhealth.New(domain.PoolHealthConfig{
+		Enabled:          true,
+		ErrorThreshold:   1,
+		OutlierThreshold: 1,
+		OutlierTolerance: 0.5,
+		WindowBlocks:     10,
+		QuarantineBlocks: 10,
+	}, &log.NoOpLogger{})
+
+	candidateRouteCache := cache.New()
+	candidateRouteCache.Set(usecase.FormatCandidateRouteCacheKey(tokenInDenom, tokenOutDenom), sqsdomain.CandidateRoutes{
+		Routes: []sqsdomain.CandidateRoute{
+			{Pools: []sqsdomain.CandidatePool{{ID: errorPoolID, TokenOutDenom: tokenOutDenom}}},
+		},
+		UniquePoolIDs: map[uint64]struct{}{errorPoolID: {}},
+	}, time.Hour)
+
+	routerUseCase := usecase.NewRouterUsecase(routerrepo.New(&log.NoOpLogger{}), &mocks.PoolsUsecaseMock{}, mocks.CandidateRouteFinderMock{}, &mocks.TokenMetadataHolderMock{}, domain.RouterConfig{
+		RouteCacheEnabled: true,
+	}, emptyCosmWasmPoolsRouterConfig, &log.NoOpLogger{}, cache.New(), candidateRouteCache)
+	routerUseCase.SetPoolHealthTracker(poolHealthTracker)
+
+	routerUseCaseImpl, ok := routerUseCase.(*usecase.RouterUseCaseImpl)
+	s.Require().True(ok)
+
+	// The cached routes are used while the pool is healthy.
+	_, isCached, err := routerUseCaseImpl.GetCachedCandidateRoutes(ctx, tokenInDenom, tokenOutDenom)
+	s.Require().NoError(err)
+	s.Require().True(isCached)
+
+	errorPool := &mocks.MockRoutablePool{
+		ID:            errorPoolID,
+		TakerFee:      osmomath.ZeroDec(),
+		TokenOutDenom: tokenOutDenom,
+		CalculateTokenOutByTokenInFunc: func(ctx context.Context, tokenIn sdk.Coin) (sdk.Coin, error) {
+			return sdk.Coin{}, fmt.Errorf("pool error")
+		},
+	}
+
+	// Returns ten times the amount implied by the spot price of one.
+	outlierPool := &mocks.MockRoutablePool{
+		ID:            outlierPoolID,
+		PoolType:      poolmanagertypes.CosmWasm,
+		TakerFee:      osmomath.ZeroDec(),
+		TokenOutDenom: tokenOutDenom,
+		CalculateTokenOutByTokenInFunc: func(ctx context.Context, tokenIn sdk.Coin) (sdk.Coin, error) {
+			return sdk.NewCoin(tokenOutDenom, tokenIn.Amount.MulRaw(10)), nil
+		},
+	}
+
+	_, _, err = routerUseCaseImpl.EstimateAndRankSingleRouteQuote(ctx, []route.RouteImpl{
+		{Pools: []domain.RoutablePool{errorPool}},
+		{Pools: []domain.RoutablePool{outlierPool}},
+	}, tokenIn, &log.NoOpLogger{})
+	s.Require().NoError(err)
+
+	s.Require().True(poolHealthTracker.IsQuarantined(errorPoolID))
+	s.Require().True(poolHealthTracker.IsQuarantined(outlierPoolID))
+
+	// The cached routes through the quarantined pool are no longer used.
+	cachedCandidateRoutes, isCached, err := routerUseCaseImpl.GetCachedCandidateRoutes(ctx, tokenInDenom, tokenOutDenom)
+	s.Require().NoError(err)
+	s.Require().False(isCached)
+	s.Require().Empty(cachedCandidateRoutes.Routes)
+}
+
+// Tests that the pool errors caused by the requested amount, such as the amount exceeding the pool liquidity,
+// do not count against the pool health while the other pool errors do.
+func (s *RouterTestSuite) TestPoolHealthTracker_AmountDrivenErrorsNotRecorded() {
+	const tokenOutDenom = "uion"
+
+	var (
+		ctx     = context.Background()
+		tokenIn = sdk.NewCoin("uosmo", osmomath.NewInt(100))
+	)
+
+	tests := []struct {
+		name string
+		err  error
+
+		expectQuarantined bool
+	}{
+		{
+			name: "concentrated not enough liquidity",
+			err:  domain.ConcentratedNotEnoughLiquidityToCompleteSwapError{PoolId: 1, AmountIn: tokenIn.String()},
+		},
+		{
+			name: "orderbook not enough liquidity",
+			err:  domain.OrderbookNotEnoughLiquidityToCompleteSwapError{PoolId: 1, AmountIn: tokenIn.String()},
+		},
+		{
+			name: "transmuter insufficient balance",
+			err:  domain.TransmuterInsufficientBalanceError{Denom: tokenOutDenom, BalanceAmount: "1", Amount: "100"},
+		},
+		{
+			name: "alloyed transmuter static rate limiter upper limit",
+			err:  domain.StaticRateLimiterInvalidUpperLimitError{UpperLimit: "0.5", Weight: "0.6", Denom: tokenOutDenom},
+		},
+		{
+			name: "wrapped amount too small",
+			err:  fmt.Errorf("calculating amount out: %w", gammtypes.ErrInvalidMathApprox),
+		},
+		{
+			name: "state error",
+			err:  domain.ConcentratedZeroCurrentSqrtPriceError{PoolId: 1},
+
+			expectQuarantined: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			poolHealthTracker := poolhealth.New(domain.PoolHealthConfig{
+				Enabled:          true,
+				ErrorThreshold:   1,
+				OutlierThreshold: 1,
+				OutlierTolerance: 0.5,
+				WindowBlocks:     10,
+				QuarantineBlocks: 10,
+			}, &log.NoOpLogger{})
+
+			routerUseCase := usecase.NewRouterUsecase(routerrepo.New(&log.NoOpLogger{}), &mocks.PoolsUsecaseMock{}, mocks.CandidateRouteFinderMock{}, &mocks.TokenMetadataHolderMock{}, domain.RouterConfig{}, emptyCosmWasmPoolsRouterConfig, &log.NoOpLogger{}, cache.New(), cache.New())
+			routerUseCase.SetPoolHealthTracker(poolHealthTracker)
+
+			routerUseCaseImpl, ok := routerUseCase.(*usecase.RouterUseCaseImpl)
+			s.Require().True(ok)
+
+			errorPool := &mocks.MockRoutablePool{
+				ID:            1,
+				TakerFee:      osmomath.ZeroDec(),
+				TokenOutDenom: tokenOutDenom,
+				CalculateTokenOutByTokenInFunc: func(ctx context.Context, tokenIn sdk.Coin) (sdk.Coin, error) {
+					return sdk.Coin{}, tc.err
+				},
+			}
+
+			// The quote fails since the only route errors.
+			_, _, _ = routerUseCaseImpl.EstimateAndRankSingleRouteQuote(ctx, []route.RouteImpl{
+				{Pools: []domain.RoutablePool{errorPool}},
+			}, tokenIn, &log.NoOpLogger{})
+
+			s.Require().Equal(tc.expectQuarantined, poolHealthTracker.IsQuarantined(1))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package parsing
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomic writes the data to the file at the given path, overwriting an existing file.
+// The data is written to a temporary file in the same directory first and then renamed so that
+// a crash mid-write never leaves a truncated file behind.
+func writeFileAtomic(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -2,11 +2,13 @@ package parsing_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/sqs/domain"
 	"github.com/osmosis-labs/sqs/domain/mocks"
 	"github.com/osmosis-labs/sqs/router/usecase/routertesting"
 	"github.com/osmosis-labs/sqs/router/usecase/routertesting/parsing"
@@ -133,3 +135,40 @@ func TestMarshalUnmarshalTakerFeeMap(t *testing.T) {
 
 	require.Equal(t, takerFeeMap, unmarshalledTakerFeeMap)
 }
+
+// This test validates that StorePoolHistory overwrites the file without leaving temporary files behind
+// and that ReadPoolHistory reads it back.
+func TestStoreAndReadPoolHistory(t *testing.T) {
+	stateDir := t.TempDir()
+	poolHistoryFile := filepath.Join(stateDir, "pool_history.json")
+
+	currentTick := routertesting.DefaultCurrentTick
+	poolHistory := []domain.PoolHistory{
+		{
+			PoolID: 1,
+			Samples: []domain.PoolHistorySample{
+				{
+					Height:       10,
+					Timestamp:    1_700_000_000,
+					LiquidityCap: osmomath.NewInt(1_000),
+					Balances:     routertesting.DefaultPoolBalances,
+					SpotPrice:    osmomath.MustNewBigDecFromStr("1.5"),
+					CurrentTick:  &currentTick,
+				},
+			},
+		},
+	}
+
+	// Store twice to validate that the existing file is overwritten.
+	require.NoError(t, parsing.StorePoolHistory(nil, poolHistoryFile))
+	require.NoError(t, parsing.StorePoolHistory(poolHistory, poolHistoryFile))
+
+	entries, err := os.ReadDir(stateDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	actualPoolHistory, err := parsing.ReadPoolHistory(poolHistoryFile)
+	require.NoError(t, err)
+
+	require.Equal(t, poolHistory, actualPoolHistory)
+}
@@ -0,0 +1,35 @@
+package parsing
+
+import (
+	"os"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/sqsdomain/json"
+)
+
+// StorePoolHistory stores the pool history to disk at the given path.
+// Contrary to the other router state files, an existing file is overwritten.
+// The file is written atomically so that a crash mid-write never leaves a truncated file behind.
+func StorePoolHistory(poolHistory []domain.PoolHistory, poolHistoryFile string) error {
+	poolHistoryJSON, err := json.Marshal(poolHistory)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(poolHistoryFile, poolHistoryJSON)
+}
+
+// ReadPoolHistory reads the pool history from disk at the given path and returns it.
+func ReadPoolHistory(poolHistoryFile string) ([]domain.PoolHistory, error) {
+	poolHistoryBytes, err := os.ReadFile(poolHistoryFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolHistory []domain.PoolHistory
+	if err := json.Unmarshal(poolHistoryBytes, &poolHistory); err != nil {
+		return nil, err
+	}
+
+	return poolHistory, nil
+}
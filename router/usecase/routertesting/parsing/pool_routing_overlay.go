@@ -0,0 +1,34 @@
+package parsing
+
+import (
+	"os"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/sqsdomain/json"
+)
+
+// StorePoolRoutingOverlay stores the pool routing overlay to disk at the given path.
+// The file is written atomically so that a crash mid-write never leaves a truncated file behind.
+func StorePoolRoutingOverlay(overlay domain.PoolRoutingOverlay, overlayFile string) error {
+	overlayJSON, err := json.Marshal(overlay)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(overlayFile, overlayJSON)
+}
+
+// ReadPoolRoutingOverlay reads the pool routing overlay from disk at the given path and returns it.
+func ReadPoolRoutingOverlay(overlayFile string) (domain.PoolRoutingOverlay, error) {
+	overlayBytes, err := os.ReadFile(overlayFile)
+	if err != nil {
+		return domain.PoolRoutingOverlay{}, err
+	}
+
+	var overlay domain.PoolRoutingOverlay
+	if err := json.Unmarshal(overlayBytes, &overlay); err != nil {
+		return domain.PoolRoutingOverlay{}, err
+	}
+
+	return overlay, nil
+}
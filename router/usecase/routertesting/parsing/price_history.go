@@ -0,0 +1,34 @@
+package parsing
+
+import (
+	"os"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/sqsdomain/json"
+)
+
+// StorePriceHistory stores the price history to disk at the given path, overwriting an existing file.
+// The file is written atomically so that a crash mid-write never leaves a truncated file behind.
+func StorePriceHistory(priceHistory []domain.PriceCandleHistory, priceHistoryFile string) error {
+	priceHistoryJSON, err := json.Marshal(priceHistory)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(priceHistoryFile, priceHistoryJSON)
+}
+
+// ReadPriceHistory reads the price history from disk at the given path and returns it.
+func ReadPriceHistory(priceHistoryFile string) ([]domain.PriceCandleHistory, error) {
+	priceHistoryBytes, err := os.ReadFile(priceHistoryFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var priceHistory []domain.PriceCandleHistory
+	if err := json.Unmarshal(priceHistoryBytes, &priceHistory); err != nil {
+		return nil, err
+	}
+
+	return priceHistory, nil
+}
@@ -0,0 +1,156 @@
+package slippage
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
+)
+
+// recommendedSlippageDecimals is the number of decimals the recommended slippage is rounded to.
+const recommendedSlippageDecimals = 6
+
+// slippageRecommender recommends the slippage tolerance from the realized volatility of the chain prices
+// computed by the pricing worker at the end of the ingested blocks, using the block as the unit of time,
+// and the price impact of the quoted amount.
+type slippageRecommender struct {
+	config     domain.RecommendedSlippageConfig
+	quoteDenom string
+
+	// observations are the recorded chain prices.
+	observations *priceobservation.Store
+}
+
+// logPriceObservation is the natural logarithm of the price recorded at the end of the block with the given height.
+// The price is in effect until the height of the next observation.
+type logPriceObservation struct {
+	height   uint64
+	logPrice float64
+}
+
+var _ domain.SlippageRecommender = &slippageRecommender{}
+
+// New creates a new slippage recommender computing the volatility from the prices recorded in the given store
+// in terms of the given quote denom.
+// CONTRACT: the config is valid and enabled and the store retains the observations covering the window.
+func New(config domain.RecommendedSlippageConfig, quoteDenom string, observations *priceobservation.Store) *slippageRecommender {
+	return &slippageRecommender{
+		config:     config,
+		quoteDenom: quoteDenom,
+
+		observations: observations,
+	}
+}
+
+// GetRecommendedSlippage implements domain.SlippageRecommender.
+func (s *slippageRecommender) GetRecommendedSlippage(tokenInDenom, tokenOutDenom string, priceImpact osmomath.Dec) (osmomath.Dec, bool) {
+	observations, latestHeight := s.observations.GetObservations(s.quoteDenom, tokenInDenom, tokenOutDenom)
+
+	tokenInObservations, tokenInOK := s.toLogPriceObservations(tokenInDenom, observations[0])
+	tokenOutObservations, tokenOutOK := s.toLogPriceObservations(tokenOutDenom, observations[1])
+	if !tokenInOK || !tokenOutOK {
+		return osmomath.Dec{}, false
+	}
+
+	volatility, ok := computeVolatility(tokenInObservations, tokenOutObservations, latestHeight, s.config.WindowBlocks)
+	if !ok {
+		return osmomath.Dec{}, false
+	}
+
+	priceImpactFloat, err := priceImpact.Abs().Float64()
+	if err != nil {
+		return osmomath.Dec{}, false
+	}
+
+	return computeRecommendedSlippage(s.config, volatility, priceImpactFloat), true
+}
+
+// toLogPriceObservations returns the log prices of the given observations of the given denom.
+// The quote denom is always priced at one.
+// The observations with a price that cannot be converted are skipped so that the previous price remains in effect.
+// Returns false if there are no observations.
+func (s *slippageRecommender) toLogPriceObservations(denom string, observations []priceobservation.Observation) ([]logPriceObservation, bool) {
+	if denom == s.quoteDenom {
+		return []logPriceObservation{{height: 0, logPrice: 0}}, true
+	}
+
+	result := make([]logPriceObservation, 0, len(observations))
+	for _, observation := range observations {
+		priceFloat, err := observation.Price.Float64()
+		if err != nil || priceFloat <= 0 {
+			continue
+		}
+
+		result = append(result, logPriceObservation{height: observation.Height, logPrice: math.Log(priceFloat)})
+	}
+
+	return result, len(result) > 0
+}
+
+// computeVolatility returns the standard deviation of the per-block log returns of the price of the base
+// in terms of the quote over the window of the given number of blocks ending at the given height.
+// The base and quote observations are both in terms of the same denom, so the log price of the base in terms
+// of the quote is their difference. The returns are assumed to have a zero mean over the short window.
+// Returns false if the first observation of either is after the block preceding the window start.
+// CONTRACT: observations are non-empty, ordered by height and not after the window end.
+func computeVolatility(base, quote []logPriceObservation, windowEndHeight uint64, windowBlocks uint64) (float64, bool) {
+	if windowEndHeight < windowBlocks {
+		return 0, false
+	}
+
+	// The returns are measured from the price in effect in the block preceding the window.
+	windowStartHeight := windowEndHeight - windowBlocks
+	if base[0].height > windowStartHeight || quote[0].height > windowStartHeight {
+		return 0, false
+	}
+
+	// Advance to the observations in effect at the window start.
+	i, j := 0, 0
+	for i+1 < len(base) && base[i+1].height <= windowStartHeight {
+		i++
+	}
+	for j+1 < len(quote) && quote[j+1].height <= windowStartHeight {
+		j++
+	}
+
+	previous := base[i].logPrice - quote[j].logPrice
+	sumSquaredReturns := 0.0
+	for i+1 < len(base) || j+1 < len(quote) {
+		// Advance to the next height with an observation of either.
+		nextHeight := uint64(math.MaxUint64)
+		if i+1 < len(base) {
+			nextHeight = base[i+1].height
+		}
+		if j+1 < len(quote) && quote[j+1].height < nextHeight {
+			nextHeight = quote[j+1].height
+		}
+
+		if i+1 < len(base) && base[i+1].height == nextHeight {
+			i++
+		}
+		if j+1 < len(quote) && quote[j+1].height == nextHeight {
+			j++
+		}
+
+		current := base[i].logPrice - quote[j].logPrice
+		sumSquaredReturns += (current - previous) * (current - previous)
+		previous = current
+	}
+
+	// The blocks without observations have zero returns.
+	return math.Sqrt(sumSquaredReturns / float64(windowBlocks)), true
+}
+
+// computeRecommendedSlippage returns the slippage tolerating the configured multiple of the volatility
+// scaled to the horizon and of the price impact, bounded by the configured min and max slippage.
+func computeRecommendedSlippage(config domain.RecommendedSlippageConfig, volatility float64, priceImpact float64) osmomath.Dec {
+	slippage := config.VolatilityMultiplier*volatility*math.Sqrt(float64(config.HorizonBlocks)) + config.PriceImpactMultiplier*priceImpact
+
+	slippage = math.Max(slippage, config.MinSlippage)
+	slippage = math.Min(slippage, config.MaxSlippage)
+
+	return osmomath.MustNewDecFromStr(strconv.FormatFloat(slippage, 'f', recommendedSlippageDecimals, 64))
+}
@@ -0,0 +1,181 @@
+package slippage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
+	"github.com/osmosis-labs/sqs/router/usecase/slippage"
+)
+
+const (
+	tokenInDenom  = "uosmo"
+	tokenOutDenom = "uatom"
+	otherDenom    = "uion"
+	quoteDenom    = "usdc"
+)
+
+var defaultConfig = domain.RecommendedSlippageConfig{
+	Enabled:       true,
+	WindowBlocks:  4,
+	HorizonBlocks: 4,
+	// One standard deviation over the horizon of 4 blocks is twice the per-block one.
+	VolatilityMultiplier:  1,
+	PriceImpactMultiplier: 1,
+	MinSlippage:           0.001,
+	MaxSlippage:           0.5,
+}
+
+// priceUpdate is the price of the denom computed at the end of the block with the given height.
+type priceUpdate struct {
+	height uint64
+	denom  string
+	price  string
+}
+
+// TestGetRecommendedSlippage validates that the recommended slippage tolerates the volatility
+// of the recorded prices of the token in in terms of the token out and the price impact,
+// that it is bounded, and that it is only returned once the window is covered.
+func TestGetRecommendedSlippage(t *testing.T) {
+	tests := []struct {
+		name          string
+		updates       []priceUpdate
+		tokenOutDenom string
+		priceImpact   osmomath.Dec
+
+		expectedSlippage string
+		expectedOK       bool
+	}{
+		{
+			name: "stable price tolerates the price impact",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "2"},
+				{height: 1, denom: tokenOutDenom, price: "4"},
+				// Zero price is skipped.
+				{height: 3, denom: tokenInDenom, price: "0"},
+				{height: 5, denom: otherDenom, price: "1"},
+			},
+			tokenOutDenom: tokenOutDenom,
+			priceImpact:   osmomath.MustNewDecFromStr("-0.02"),
+
+			expectedSlippage: "0.020000000000000000",
+			expectedOK:       true,
+		},
+		{
+			name: "volatile price in terms of the quote denom",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "1"},
+				{height: 3, denom: tokenInDenom, price: "1.1"},
+				// Out of order height is skipped.
+				{height: 2, denom: tokenInDenom, price: "100"},
+				{height: 5, denom: tokenInDenom, price: "1"},
+			},
+			tokenOutDenom: quoteDenom,
+			priceImpact:   osmomath.MustNewDecFromStr("-0.02"),
+
+			// sqrt(2 * ln(1.1)^2 / 4) * sqrt(4) + 0.02
+			expectedSlippage: "0.154789000000000000",
+			expectedOK:       true,
+		},
+		{
+			name: "prices moving together are not volatile in terms of each other",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "1"},
+				{height: 1, denom: tokenOutDenom, price: "2"},
+				{height: 3, denom: tokenInDenom, price: "1.1"},
+				{height: 3, denom: tokenOutDenom, price: "2.2"},
+				{height: 5, denom: tokenInDenom, price: "1"},
+				{height: 5, denom: tokenOutDenom, price: "2"},
+			},
+			tokenOutDenom: tokenOutDenom,
+			priceImpact:   osmomath.ZeroDec(),
+
+			expectedSlippage: "0.001000000000000000",
+			expectedOK:       true,
+		},
+		{
+			name: "bounded by the max slippage",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "1"},
+				{height: 4, denom: tokenInDenom, price: "10"},
+				{height: 5, denom: tokenInDenom, price: "1"},
+			},
+			tokenOutDenom: quoteDenom,
+			priceImpact:   osmomath.ZeroDec(),
+
+			expectedSlippage: "0.500000000000000000",
+			expectedOK:       true,
+		},
+		{
+			name: "window not covered",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "1"},
+				{height: 3, denom: tokenOutDenom, price: "1"},
+				{height: 5, denom: tokenInDenom, price: "1"},
+			},
+			tokenOutDenom: tokenOutDenom,
+			priceImpact:   osmomath.ZeroDec(),
+
+			expectedOK: false,
+		},
+		{
+			name: "no prices recorded",
+			updates: []priceUpdate{
+				{height: 1, denom: tokenInDenom, price: "1"},
+				{height: 5, denom: otherDenom, price: "1"},
+			},
+			tokenOutDenom: tokenOutDenom,
+			priceImpact:   osmomath.ZeroDec(),
+
+			expectedOK: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := priceobservation.New(defaultConfig.WindowBlocks)
+			recommender := slippage.New(defaultConfig, quoteDenom, observations)
+
+			for _, update := range tc.updates {
+				prices := domain.PricesResult{
+					update.denom: {quoteDenom: osmomath.MustNewBigDecFromStr(update.price)},
+				}
+				require.NoError(t, observations.OnPricingUpdate(context.Background(), update.height, domain.BlockPoolMetadata{}, prices, quoteDenom))
+			}
+
+			recommendedSlippage, ok := recommender.GetRecommendedSlippage(tokenInDenom, tc.tokenOutDenom, tc.priceImpact)
+			require.Equal(t, tc.expectedOK, ok)
+			if !tc.expectedOK {
+				return
+			}
+
+			require.Equal(t, tc.expectedSlippage, recommendedSlippage.String())
+
+			// The volatility is the same in both directions.
+			reversedSlippage, ok := recommender.GetRecommendedSlippage(tc.tokenOutDenom, tokenInDenom, tc.priceImpact)
+			require.True(t, ok)
+			require.Equal(t, recommendedSlippage.String(), reversedSlippage.String())
+		})
+	}
+}
+
+// TestGetRecommendedSlippage_OtherQuoteDenom validates that the prices in terms of other quote denoms are not used.
+func TestGetRecommendedSlippage_OtherQuoteDenom(t *testing.T) {
+	observations := priceobservation.New(defaultConfig.WindowBlocks)
+	recommender := slippage.New(defaultConfig, quoteDenom, observations)
+
+	for _, height := range []uint64{1, 5} {
+		prices := domain.PricesResult{
+			tokenInDenom: {otherDenom: osmomath.OneBigDec()},
+		}
+		require.NoError(t, observations.OnPricingUpdate(context.Background(), height, domain.BlockPoolMetadata{}, prices, otherDenom))
+	}
+
+	_, ok := recommender.GetRecommendedSlippage(tokenInDenom, quoteDenom, osmomath.ZeroDec())
+	require.False(t, ok)
+}
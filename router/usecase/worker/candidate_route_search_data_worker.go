@@ -17,21 +17,28 @@ type candidateRouteSearchDataWorker struct {
 	listeners                []domain.CandidateRouteSearchDataUpdateListener
 	poolsHandler             mvc.CandidateRouteSearchPoolHandler
 	candidateRouteDataHolder mvc.CandidateRouteSearchDataHolder
-	preferredPoolIDs         []uint64
-	cosmWasmPoolConfig       domain.CosmWasmPoolRouterConfig
-	logger                   log.Logger
+
+	// configMx guards the pool routing config that may be updated at runtime.
+	configMx           sync.RWMutex
+	preferredPoolIDs   []uint64
+	deniedPoolIDs      []uint64
+	cosmWasmPoolConfig domain.CosmWasmPoolRouterConfig
+
+	logger log.Logger
 }
 
 var (
-	_ domain.CandidateRouteSearchDataWorker = &candidateRouteSearchDataWorker{}
+	_ domain.CandidateRouteSearchDataWorker  = &candidateRouteSearchDataWorker{}
+	_ domain.PoolRoutingConfigUpdateListener = &candidateRouteSearchDataWorker{}
 )
 
-func NewCandidateRouteSearchDataWorker(poolHandler mvc.CandidateRouteSearchPoolHandler, candidateRouteDataHolder mvc.CandidateRouteSearchDataHolder, preferredPoolIDs []uint64, cosmWasmPoolConfig domain.CosmWasmPoolRouterConfig, logger log.Logger) *candidateRouteSearchDataWorker {
+func NewCandidateRouteSearchDataWorker(poolHandler mvc.CandidateRouteSearchPoolHandler, candidateRouteDataHolder mvc.CandidateRouteSearchDataHolder, preferredPoolIDs []uint64, deniedPoolIDs []uint64, cosmWasmPoolConfig domain.CosmWasmPoolRouterConfig, logger log.Logger) *candidateRouteSearchDataWorker {
 	return &candidateRouteSearchDataWorker{
 		listeners:                []domain.CandidateRouteSearchDataUpdateListener{},
 		poolsHandler:             poolHandler,
 		candidateRouteDataHolder: candidateRouteDataHolder,
 		preferredPoolIDs:         preferredPoolIDs,
+		deniedPoolIDs:            deniedPoolIDs,
 		cosmWasmPoolConfig:       cosmWasmPoolConfig,
 		logger:                   logger,
 	}
@@ -69,6 +76,10 @@ func (c *candidateRouteSearchDataWorker) compute(blockPoolMetaData domain.BlockP
 
 	candidateRouteData := make(map[string]domain.CandidateRouteDenomData, len(blockPoolMetaData.UpdatedDenoms))
 
+	c.configMx.RLock()
+	preferredPoolIDs, deniedPoolIDs, cosmWasmPoolConfig := c.preferredPoolIDs, c.deniedPoolIDs, c.cosmWasmPoolConfig
+	c.configMx.RUnlock()
+
 	wg := sync.WaitGroup{}
 
 	for denom := range blockPoolMetaData.UpdatedDenoms {
@@ -96,7 +107,7 @@ func (c *candidateRouteSearchDataWorker) compute(blockPoolMetaData domain.BlockP
 			}
 
 			// Sort pools
-			sortedDenomPools, orderbookPools := routerusecase.ValidateAndSortPools(unsortedDenomPools, c.cosmWasmPoolConfig, c.preferredPoolIDs, c.logger)
+			sortedDenomPools, orderbookPools := routerusecase.ValidateAndSortPools(unsortedDenomPools, cosmWasmPoolConfig, preferredPoolIDs, deniedPoolIDs, c.logger)
 
 			canonicalOrderbookPoolMapByPairToken := make(map[string]sqsdomain.PoolI, len(orderbookPools))
 			for _, pool := range orderbookPools {
@@ -129,3 +140,16 @@ func (c *candidateRouteSearchDataWorker) compute(blockPoolMetaData domain.BlockP
 func (c *candidateRouteSearchDataWorker) RegisterListener(listener domain.CandidateRouteSearchDataUpdateListener) {
 	c.listeners = append(c.listeners, listener)
 }
+
+// OnPoolRoutingConfigUpdate implements domain.PoolRoutingConfigUpdateListener.
+// The updated config applies to the search data computed from then on.
+func (c *candidateRouteSearchDataWorker) OnPoolRoutingConfigUpdate(ctx context.Context, config domain.PoolRoutingConfig) error {
+	c.configMx.Lock()
+	defer c.configMx.Unlock()
+
+	c.preferredPoolIDs = config.PreferredPoolIDs
+	c.deniedPoolIDs = config.DeniedPoolIDs
+	c.cosmWasmPoolConfig = domain.NewCosmWasmPoolRouterConfig(config.GetPoolsConfig(), c.cosmWasmPoolConfig.ChainGRPCGatewayEndpoint)
+
+	return nil
+}
@@ -89,6 +89,9 @@ type SQSPool struct {
 	Balances     sdk.Coins    `json:"balances"`
 	PoolDenoms   []string     `json:"pool_denoms"`
 	SpreadFactor osmomath.Dec `json:"spread_factor"`
+	// CreationHeight is the height of the first ingested block containing the pool.
+	// Zero if unknown, e.g. for the pools created before SQS started ingesting.
+	CreationHeight uint64 `json:"creation_height,omitempty"`
 
 	// Only CosmWasm pools need CosmWasmPoolModel appended
 	CosmWasmPoolModel *cosmwasmpool.CosmWasmPoolModel `json:"cosmwasm_pool_model,omitempty"`
@@ -226,7 +229,6 @@ func (p *PoolWrapper) GetAPRData() sqspassthroughdomain.PoolAPRDataStatusWrap {
 	return p.APRData
 }
 
-
 // Incentive implements PoolI.
 func (p *PoolWrapper) Incentive() api.IncentiveType {
 	apr := p.GetAPRData()
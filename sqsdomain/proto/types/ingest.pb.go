@@ -31,7 +31,8 @@ type PoolData struct {
 	// SqsModel is additional pool data used by the sidecar query server.
 	SqsModel []byte `protobuf:"bytes,2,opt,name=sqs_model,json=sqsModel,proto3" json:"sqs_model,omitempty"`
 	// TickModel is the tick data of a concentrated liquidity pool.
-	// This field is only valid and set for concentrated pools. It is nil otherwise.
+	// This field is only valid and set for concentrated pools. It is nil
+	// otherwise.
 	TickModel []byte `protobuf:"bytes,3,opt,name=tick_model,json=tickModel,proto3" json:"tick_model,omitempty"`
 }
 
@@ -195,6 +196,207 @@ func (*ProcessBlockReply) Descriptor() ([]byte, []int) {
 	return file_ingest_proto_rawDescGZIP(), []int{2}
 }
 
+// ProcessBlockChunk is a single message of a chunked block stream.
+// All chunks of the same stream must have the same block height.
+type ProcessBlockChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block height is the height of the block being processed.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Types that are assignable to Chunk:
+	//	*ProcessBlockChunk_Pools
+	//	*ProcessBlockChunk_Commit
+	Chunk isProcessBlockChunk_Chunk `protobuf_oneof:"chunk"`
+}
+
+func (x *ProcessBlockChunk) Reset() {
+	*x = ProcessBlockChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ingest_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBlockChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBlockChunk) ProtoMessage() {}
+
+func (x *ProcessBlockChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ingest_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBlockChunk.ProtoReflect.Descriptor instead.
+func (*ProcessBlockChunk) Descriptor() ([]byte, []int) {
+	return file_ingest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProcessBlockChunk) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (m *ProcessBlockChunk) GetChunk() isProcessBlockChunk_Chunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (x *ProcessBlockChunk) GetPools() *ProcessBlockPoolsChunk {
+	if x, ok := x.GetChunk().(*ProcessBlockChunk_Pools); ok {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ProcessBlockChunk) GetCommit() *ProcessBlockCommit {
+	if x, ok := x.GetChunk().(*ProcessBlockChunk_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+type isProcessBlockChunk_Chunk interface {
+	isProcessBlockChunk_Chunk()
+}
+
+type ProcessBlockChunk_Pools struct {
+	// pools is a chunk of the pools in the block.
+	Pools *ProcessBlockPoolsChunk `protobuf:"bytes,2,opt,name=pools,proto3,oneof"`
+}
+
+type ProcessBlockChunk_Commit struct {
+	// commit finalizes the block. It must be the last message in the stream.
+	Commit *ProcessBlockCommit `protobuf:"bytes,3,opt,name=commit,proto3,oneof"`
+}
+
+func (*ProcessBlockChunk_Pools) isProcessBlockChunk_Chunk() {}
+
+func (*ProcessBlockChunk_Commit) isProcessBlockChunk_Chunk() {}
+
+// ProcessBlockPoolsChunk is a chunk of the pools in the block.
+type ProcessBlockPoolsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pools in the chunk.
+	Pools []*PoolData `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *ProcessBlockPoolsChunk) Reset() {
+	*x = ProcessBlockPoolsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ingest_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBlockPoolsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBlockPoolsChunk) ProtoMessage() {}
+
+func (x *ProcessBlockPoolsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ingest_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBlockPoolsChunk.ProtoReflect.Descriptor instead.
+func (*ProcessBlockPoolsChunk) Descriptor() ([]byte, []int) {
+	return file_ingest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProcessBlockPoolsChunk) GetPools() []*PoolData {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// ProcessBlockCommit finalizes a chunked block.
+type ProcessBlockCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// taker_fees_map is the map of taker fees for the block.
+	TakerFeesMap []byte `protobuf:"bytes,1,opt,name=taker_fees_map,json=takerFeesMap,proto3" json:"taker_fees_map,omitempty"`
+	// num_pools is the total number of pools sent across all chunks.
+	// It is used to validate that no chunk was lost.
+	NumPools uint64 `protobuf:"varint,2,opt,name=num_pools,json=numPools,proto3" json:"num_pools,omitempty"`
+}
+
+func (x *ProcessBlockCommit) Reset() {
+	*x = ProcessBlockCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ingest_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBlockCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBlockCommit) ProtoMessage() {}
+
+func (x *ProcessBlockCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_ingest_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBlockCommit.ProtoReflect.Descriptor instead.
+func (*ProcessBlockCommit) Descriptor() ([]byte, []int) {
+	return file_ingest_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProcessBlockCommit) GetTakerFeesMap() []byte {
+	if x != nil {
+		return x.TakerFeesMap
+	}
+	return nil
+}
+
+func (x *ProcessBlockCommit) GetNumPools() uint64 {
+	if x != nil {
+		return x.NumPools
+	}
+	return 0
+}
+
 var File_ingest_proto protoreflect.FileDescriptor
 
 var file_ingest_proto_rawDesc = []byte{
@@ -217,16 +419,45 @@ var file_ingest_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
 	0x22, 0x13, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x6f, 0x0a, 0x0b, 0x53, 0x51, 0x53, 0x49, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42,
+	0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x4c, 0x0a,
+	0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x57, 0x0a, 0x12, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x32, 0xd8, 0x01, 0x0a, 0x0b, 0x53, 0x51, 0x53, 0x49, 0x6e, 0x67, 0x65,
 	0x73, 0x74, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42,
 	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73,
 	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
 	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
 	0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x73, 0x71, 0x73, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e,
+	0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x25, 0x2e, 0x73, 0x71, 0x73, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x42,
+	0x17, 0x5a, 0x15, 0x73, 0x71, 0x73, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -241,21 +472,29 @@ func file_ingest_proto_rawDescGZIP() []byte {
 	return file_ingest_proto_rawDescData
 }
 
-var file_ingest_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ingest_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_ingest_proto_goTypes = []interface{}{
-	(*PoolData)(nil),            // 0: sqs.ingest.v1beta1.PoolData
-	(*ProcessBlockRequest)(nil), // 1: sqs.ingest.v1beta1.ProcessBlockRequest
-	(*ProcessBlockReply)(nil),   // 2: sqs.ingest.v1beta1.ProcessBlockReply
+	(*PoolData)(nil),               // 0: sqs.ingest.v1beta1.PoolData
+	(*ProcessBlockRequest)(nil),    // 1: sqs.ingest.v1beta1.ProcessBlockRequest
+	(*ProcessBlockReply)(nil),      // 2: sqs.ingest.v1beta1.ProcessBlockReply
+	(*ProcessBlockChunk)(nil),      // 3: sqs.ingest.v1beta1.ProcessBlockChunk
+	(*ProcessBlockPoolsChunk)(nil), // 4: sqs.ingest.v1beta1.ProcessBlockPoolsChunk
+	(*ProcessBlockCommit)(nil),     // 5: sqs.ingest.v1beta1.ProcessBlockCommit
 }
 var file_ingest_proto_depIdxs = []int32{
 	0, // 0: sqs.ingest.v1beta1.ProcessBlockRequest.pools:type_name -> sqs.ingest.v1beta1.PoolData
-	1, // 1: sqs.ingest.v1beta1.SQSIngester.ProcessBlock:input_type -> sqs.ingest.v1beta1.ProcessBlockRequest
-	2, // 2: sqs.ingest.v1beta1.SQSIngester.ProcessBlock:output_type -> sqs.ingest.v1beta1.ProcessBlockReply
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 1: sqs.ingest.v1beta1.ProcessBlockChunk.pools:type_name -> sqs.ingest.v1beta1.ProcessBlockPoolsChunk
+	5, // 2: sqs.ingest.v1beta1.ProcessBlockChunk.commit:type_name -> sqs.ingest.v1beta1.ProcessBlockCommit
+	0, // 3: sqs.ingest.v1beta1.ProcessBlockPoolsChunk.pools:type_name -> sqs.ingest.v1beta1.PoolData
+	1, // 4: sqs.ingest.v1beta1.SQSIngester.ProcessBlock:input_type -> sqs.ingest.v1beta1.ProcessBlockRequest
+	3, // 5: sqs.ingest.v1beta1.SQSIngester.ProcessChunkedBlock:input_type -> sqs.ingest.v1beta1.ProcessBlockChunk
+	2, // 6: sqs.ingest.v1beta1.SQSIngester.ProcessBlock:output_type -> sqs.ingest.v1beta1.ProcessBlockReply
+	2, // 7: sqs.ingest.v1beta1.SQSIngester.ProcessChunkedBlock:output_type -> sqs.ingest.v1beta1.ProcessBlockReply
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_ingest_proto_init() }
@@ -300,6 +539,46 @@ func file_ingest_proto_init() {
 				return nil
 			}
 		}
+		file_ingest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBlockChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ingest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBlockPoolsChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ingest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBlockCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ingest_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ProcessBlockChunk_Pools)(nil),
+		(*ProcessBlockChunk_Commit)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -307,7 +586,7 @@ func file_ingest_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ingest_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
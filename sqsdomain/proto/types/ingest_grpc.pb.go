@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SQSIngester_ProcessBlock_FullMethodName = "/sqs.ingest.v1beta1.SQSIngester/ProcessBlock"
+	SQSIngester_ProcessBlock_FullMethodName        = "/sqs.ingest.v1beta1.SQSIngester/ProcessBlock"
+	SQSIngester_ProcessChunkedBlock_FullMethodName = "/sqs.ingest.v1beta1.SQSIngester/ProcessChunkedBlock"
 )
 
 // SQSIngesterClient is the client API for SQSIngester service.
@@ -28,6 +29,11 @@ const (
 type SQSIngesterClient interface {
 	// ProcessBlock processes a block from the Osmosis node.
 	ProcessBlock(ctx context.Context, in *ProcessBlockRequest, opts ...grpc.CallOption) (*ProcessBlockReply, error)
+	// ProcessChunkedBlock processes a block from the Osmosis node that is
+	// streamed in chunks. The client sends any number of pool chunks followed
+	// by a single commit message. The block is only applied on commit so that
+	// a partially received block is never observed.
+	ProcessChunkedBlock(ctx context.Context, opts ...grpc.CallOption) (SQSIngester_ProcessChunkedBlockClient, error)
 }
 
 type sQSIngesterClient struct {
@@ -47,12 +53,51 @@ func (c *sQSIngesterClient) ProcessBlock(ctx context.Context, in *ProcessBlockRe
 	return out, nil
 }
 
+func (c *sQSIngesterClient) ProcessChunkedBlock(ctx context.Context, opts ...grpc.CallOption) (SQSIngester_ProcessChunkedBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SQSIngester_ServiceDesc.Streams[0], SQSIngester_ProcessChunkedBlock_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sQSIngesterProcessChunkedBlockClient{stream}
+	return x, nil
+}
+
+type SQSIngester_ProcessChunkedBlockClient interface {
+	Send(*ProcessBlockChunk) error
+	CloseAndRecv() (*ProcessBlockReply, error)
+	grpc.ClientStream
+}
+
+type sQSIngesterProcessChunkedBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *sQSIngesterProcessChunkedBlockClient) Send(m *ProcessBlockChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sQSIngesterProcessChunkedBlockClient) CloseAndRecv() (*ProcessBlockReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ProcessBlockReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SQSIngesterServer is the server API for SQSIngester service.
 // All implementations must embed UnimplementedSQSIngesterServer
 // for forward compatibility
 type SQSIngesterServer interface {
 	// ProcessBlock processes a block from the Osmosis node.
 	ProcessBlock(context.Context, *ProcessBlockRequest) (*ProcessBlockReply, error)
+	// ProcessChunkedBlock processes a block from the Osmosis node that is
+	// streamed in chunks. The client sends any number of pool chunks followed
+	// by a single commit message. The block is only applied on commit so that
+	// a partially received block is never observed.
+	ProcessChunkedBlock(SQSIngester_ProcessChunkedBlockServer) error
 	mustEmbedUnimplementedSQSIngesterServer()
 }
 
@@ -63,6 +108,9 @@ type UnimplementedSQSIngesterServer struct {
 func (UnimplementedSQSIngesterServer) ProcessBlock(context.Context, *ProcessBlockRequest) (*ProcessBlockReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessBlock not implemented")
 }
+func (UnimplementedSQSIngesterServer) ProcessChunkedBlock(SQSIngester_ProcessChunkedBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessChunkedBlock not implemented")
+}
 func (UnimplementedSQSIngesterServer) mustEmbedUnimplementedSQSIngesterServer() {}
 
 // UnsafeSQSIngesterServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +142,32 @@ func _SQSIngester_ProcessBlock_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQSIngester_ProcessChunkedBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SQSIngesterServer).ProcessChunkedBlock(&sQSIngesterProcessChunkedBlockServer{stream})
+}
+
+type SQSIngester_ProcessChunkedBlockServer interface {
+	SendAndClose(*ProcessBlockReply) error
+	Recv() (*ProcessBlockChunk, error)
+	grpc.ServerStream
+}
+
+type sQSIngesterProcessChunkedBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *sQSIngesterProcessChunkedBlockServer) SendAndClose(m *ProcessBlockReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sQSIngesterProcessChunkedBlockServer) Recv() (*ProcessBlockChunk, error) {
+	m := new(ProcessBlockChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SQSIngester_ServiceDesc is the grpc.ServiceDesc for SQSIngester service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -106,6 +180,12 @@ var SQSIngester_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _SQSIngester_ProcessBlock_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessChunkedBlock",
+			Handler:       _SQSIngester_ProcessChunkedBlock_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "ingest.proto",
 }
@@ -27,7 +27,9 @@ type SystemHandler struct {
 	logger      log.Logger
 	grpcAddress string
 	CIUsecase   mvc.ChainInfoUsecase
-	config      domain.Config
+	// IUsecase is nil if the ingester is disabled.
+	IUsecase mvc.IngestUsecase
+	config   domain.Config
 }
 
 // Parse the response from the GRPC Gateway status endpoint
@@ -51,12 +53,26 @@ const (
 	whiteSpacePlaceholder = " "
 )
 
+// HealthStatusResponse defines the response for the /healthcheck endpoint
+type HealthStatusResponse struct {
+	GRPCGatewayStatus string `json:"grpc_gateway_status"`
+	ChainLatestHeight string `json:"chain_latest_height"`
+	StoreLatestHeight string `json:"store_latest_height"`
+	// IngestActiveSource is the source that delivered the latest processed height.
+	// Omitted if the ingester is disabled or no block was received yet.
+	IngestActiveSource string `json:"ingest_active_source,omitempty"`
+	// IngestSources is the status of every source that pushed blocks to the ingester.
+	IngestSources []domain.IngestSourceStatus `json:"ingest_sources,omitempty"`
+}
+
 // NewSystemHandler will initialize the /debug/ppof resources endpoint
-func NewSystemHandler(e *echo.Echo, config domain.Config, logger log.Logger, us mvc.ChainInfoUsecase) {
+// The ingest use case is optional and may be nil if the ingester is disabled.
+func NewSystemHandler(e *echo.Echo, config domain.Config, logger log.Logger, us mvc.ChainInfoUsecase, ius mvc.IngestUsecase) {
 	handler := &SystemHandler{
 		logger:      logger,
 		grpcAddress: config.ChainTendermintRPCEndpoint,
 		CIUsecase:   us,
+		IUsecase:    ius,
 		config:      config,
 	}
 
@@ -207,10 +223,22 @@ func (h *SystemHandler) GetHealthStatus(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
 	}
 
+	response := HealthStatusResponse{
+		GRPCGatewayStatus: "running",
+		ChainLatestHeight: fmt.Sprint(latestChainHeight),
+		StoreLatestHeight: fmt.Sprint(latestStoreHeight),
+	}
+
+	// Report the ingest sources status if the ingester is enabled
+	if h.IUsecase != nil {
+		response.IngestSources = h.IUsecase.GetSourcesStatus()
+		for _, source := range response.IngestSources {
+			if source.IsActive {
+				response.IngestActiveSource = source.Source
+			}
+		}
+	}
+
 	// Return combined status
-	return c.JSON(http.StatusOK, map[string]string{
-		"grpc_gateway_status": "running",
-		"chain_latest_height": fmt.Sprint(latestChainHeight),
-		"store_latest_height": fmt.Sprint(latestStoreHeight),
-	})
+	return c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,247 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/sqs/delivery/grpc/server"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/pipeline"
+	"github.com/osmosis-labs/sqs/log"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/tokens"
+)
+
+// TokensGRPCHandler represents the GRPC handler for the tokens queries.
+type TokensGRPCHandler struct {
+	TUsecase mvc.TokensUsecase
+
+	defaultQuoteChainDenom string
+	defaultCoingeckoDenom  string
+
+	logger log.Logger
+
+	api.UnimplementedQueryServer
+}
+
+var _ api.QueryServer = &TokensGRPCHandler{}
+
+// getTokensSortFuncs is a map of available sort functions for the token metadata.
+var getTokensSortFuncs = map[string]func(a, b domain.Token, desc bool) bool{
+	"denom": func(a, b domain.Token, desc bool) bool {
+		if desc {
+			return a.CoinMinimalDenom > b.CoinMinimalDenom
+		}
+		return a.CoinMinimalDenom < b.CoinMinimalDenom
+	},
+	"humanDenom": func(a, b domain.Token, desc bool) bool {
+		if desc {
+			return a.HumanDenom > b.HumanDenom
+		}
+		return a.HumanDenom < b.HumanDenom
+	},
+	"precision": func(a, b domain.Token, desc bool) bool {
+		if desc {
+			return a.Precision > b.Precision
+		}
+		return a.Precision < b.Precision
+	},
+}
+
+// NewTokensGRPCHandler will register the tokens query service on the given GRPC server.
+func NewTokensGRPCHandler(s *grpc.Server, pricingConfig domain.PricingConfig, ts mvc.TokensUsecase, logger log.Logger) error {
+	defaultQuoteChainDenom, err := ts.GetChainDenom(pricingConfig.DefaultQuoteHumanDenom)
+	if err != nil {
+		return err
+	}
+
+	api.RegisterQueryServer(s, &TokensGRPCHandler{
+		TUsecase: ts,
+
+		defaultQuoteChainDenom: defaultQuoteChainDenom,
+
+		logger: logger,
+	})
+
+	return nil
+}
+
+// TokensMetadata implements api.QueryServer.
+// It mirrors the GET /tokens/metadata endpoint with support for sorting and pagination.
+// The tokens are sorted by chain denom unless other sort fields are given.
+func (a *TokensGRPCHandler) TokensMetadata(ctx context.Context, req *api.GetTokensMetadataRequest) (*api.GetTokensMetadataResponse, error) {
+	if req.Pagination != nil {
+		if err := req.Pagination.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	tokensMetadata, err := a.getTokensMetadata(req.Denoms, req.HumanDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensMap := &sync.Map{}
+	for denom, token := range tokensMetadata {
+		tokensMap.Store(denom, token)
+	}
+
+	transformer := pipeline.NewSyncMapTransformer[string, domain.Token](tokensMap)
+
+	// Sorting options for token results
+	var sortopts []func(domain.Token, domain.Token) bool
+	if sortReq := req.Sort; sortReq != nil {
+		for _, v := range sortReq.Fields {
+			sortFunc, ok := getTokensSortFuncs[v.Field]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unsupported sort field: %s", v.Field)
+			}
+
+			// Pass direction as a parameter to avoid duplication
+			desc := v.Direction == v1beta1.SortDirection_DESCENDING
+			sortopts = append(sortopts, func(a, b domain.Token) bool {
+				return sortFunc(a, b, desc)
+			})
+		}
+	}
+
+	// Sort by chain denom for deterministic results if no sort options are given.
+	if len(sortopts) == 0 {
+		sortopts = append(sortopts, func(a, b domain.Token) bool {
+			return getTokensSortFuncs["denom"](a, b, false)
+		})
+	}
+	transformer.Sort(sortopts...) // apply sort options
+
+	var tokens []domain.Token
+	if pagination := req.Pagination; pagination == nil {
+		tokens = transformer.Data()
+	} else {
+		iterator := pipeline.NewSyncMapIterator[string, domain.Token](tokensMap, transformer.Keys())
+		paginator := pipeline.NewPaginator[string](iterator, pagination)
+		tokens = paginator.GetPage()
+	}
+
+	resultTokens := make([]api.Token, 0, len(tokens))
+	for _, token := range tokens {
+		resultTokens = append(resultTokens, api.Token{
+			Denom:       token.CoinMinimalDenom,
+			HumanDenom:  token.HumanDenom,
+			Name:        token.Name,
+			Precision:   int64(token.Precision),
+			IsUnlisted:  token.IsUnlisted,
+			CoingeckoId: token.CoingeckoID,
+		})
+	}
+
+	return &api.GetTokensMetadataResponse{
+		Tokens: resultTokens,
+		Meta:   v1beta1.NewPaginationResponse(req.Pagination, transformer.Count()),
+		Height: domain.GetHeightFromContext(ctx),
+	}, nil
+}
+
+// Prices implements api.QueryServer.
+// It mirrors the GET /tokens/prices endpoint.
+func (a *TokensGRPCHandler) Prices(ctx context.Context, req *api.GetPricesRequest) (*api.GetPricesResponse, error) {
+	if len(req.BaseDenoms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "base denoms must be non-empty")
+	}
+
+	baseDenoms, err := mvc.ValidateChainDenoms(a.TUsecase, req.BaseDenoms, req.HumanDenoms)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pricingSourceType := domain.PricingSourceType(req.PricingSource)
+	if !a.TUsecase.IsValidPricingSource(int(pricingSourceType)) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pricing source: %d", pricingSourceType)
+	}
+
+	// Get quote denom based on pricing source type.
+	quoteDenom, err := a.getQuoteDenom(pricingSourceType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prices, err := a.TUsecase.GetPrices(ctx, baseDenoms, []string{quoteDenom}, pricingSourceType)
+	if err != nil {
+		return nil, server.StatusError(err)
+	}
+
+	return &api.GetPricesResponse{
+		Prices: convertPricesToResponse(prices),
+		Height: domain.GetHeightFromContext(ctx),
+	}, nil
+}
+
+// getTokensMetadata returns the token metadata for the given denoms keyed by chain denom.
+// Returns the metadata of all tokens if no denoms are given.
+func (a *TokensGRPCHandler) getTokensMetadata(denoms []string, isHumanDenoms bool) (map[string]domain.Token, error) {
+	if len(denoms) == 0 {
+		tokensMetadata, err := a.TUsecase.GetFullTokenMetadata()
+		if err != nil {
+			return nil, server.StatusError(err)
+		}
+		return tokensMetadata, nil
+	}
+
+	chainDenoms, err := mvc.ValidateChainDenoms(a.TUsecase, denoms, isHumanDenoms)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokensMetadata := make(map[string]domain.Token, len(chainDenoms))
+	for _, chainDenom := range chainDenoms {
+		tokenMetadata, err := a.TUsecase.GetMetadataByChainDenom(chainDenom)
+		if err != nil {
+			return nil, server.StatusError(err)
+		}
+		tokensMetadata[chainDenom] = tokenMetadata
+	}
+
+	return tokensMetadata, nil
+}
+
+// getQuoteDenom returns the quote denomination based on the pricing source type.
+func (a *TokensGRPCHandler) getQuoteDenom(pricingSourceType domain.PricingSourceType) (string, error) {
+	switch pricingSourceType {
+	case domain.ChainPricingSourceType, domain.TWAPPricingSourceType, domain.AggregatedPricingSourceType:
+		return a.defaultQuoteChainDenom, nil
+	case domain.CoinGeckoPricingSourceType:
+		return a.defaultCoingeckoDenom, nil
+	default:
+		return "", errors.New("unsupported pricing source type")
+	}
+}
+
+// convertPricesToResponse flattens the prices result into a list of prices
+// sorted by base and then quote denom for deterministic output.
+func convertPricesToResponse(prices domain.PricesResult) []api.Price {
+	result := make([]api.Price, 0, len(prices))
+	for baseDenom, quotePrices := range prices {
+		for quoteDenom, price := range quotePrices {
+			result = append(result, api.Price{
+				BaseDenom:  baseDenom,
+				QuoteDenom: quoteDenom,
+				Price:      price.String(),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].BaseDenom != result[j].BaseDenom {
+			return result[i].BaseDenom < result[j].BaseDenom
+		}
+		return result[i].QuoteDenom < result[j].QuoteDenom
+	})
+
+	return result
+}
@@ -0,0 +1,189 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	deliverygrpc "github.com/osmosis-labs/sqs/delivery/grpc"
+	"github.com/osmosis-labs/sqs/delivery/grpc/server"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	api "github.com/osmosis-labs/sqs/pkg/api/v1beta1/tokens"
+	tokensgrpc "github.com/osmosis-labs/sqs/tokens/delivery/grpc"
+)
+
+const (
+	defaultQuoteHumanDenom = "usdc"
+	defaultQuoteChainDenom = "ibc/usdc"
+	snapshotHeight         = uint64(10)
+)
+
+var tokensMetadata = map[string]domain.Token{
+	"uosmo":    {CoinMinimalDenom: "uosmo", HumanDenom: "osmo", Precision: 6},
+	"uion":     {CoinMinimalDenom: "uion", HumanDenom: "ion", Precision: 6},
+	"ibc/usdc": {CoinMinimalDenom: "ibc/usdc", HumanDenom: "usdc", Precision: 6},
+}
+
+// setupTokensQueryClient starts the query server with the tokens service over an in-memory connection
+// and returns a client connected to it.
+func setupTokensQueryClient(t *testing.T, tokensUsecase *mocks.TokensUsecaseMock) api.QueryClient {
+	t.Helper()
+
+	stateSnapshotUsecase := &mocks.StateSnapshotUsecaseMock{
+		GetLatestStateSnapshotFunc: func() (*domain.StateSnapshot, bool) {
+			return domain.NewStateSnapshot(snapshotHeight, nil, nil, nil, nil), true
+		},
+	}
+
+	s := server.NewQueryServer(domain.GRPCQueryConfig{MaxReceiveMsgSizeBytes: 1 << 20}, stateSnapshotUsecase)
+	err := tokensgrpc.NewTokensGRPCHandler(s, domain.PricingConfig{DefaultQuoteHumanDenom: defaultQuoteHumanDenom}, tokensUsecase, &log.NoOpLogger{})
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(deliverygrpc.OsmomathCodec{})),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return api.NewQueryClient(conn)
+}
+
+// TestTokensMetadata validates that the token metadata is sorted, paginated
+// and reported at the height of the pinned state snapshot.
+func TestTokensMetadata(t *testing.T) {
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetChainDenomFunc: func(humanDenom string) (string, error) {
+			return defaultQuoteChainDenom, nil
+		},
+		GetFullTokenMetadataFunc: func() (map[string]domain.Token, error) {
+			return tokensMetadata, nil
+		},
+	}
+
+	client := setupTokensQueryClient(t, tokensUsecase)
+
+	tests := []struct {
+		name string
+
+		req *api.GetTokensMetadataRequest
+
+		expectedDenoms     []string
+		expectedTotalItems uint64
+		expectedCode       codes.Code
+	}{
+		{
+			name:               "all tokens sorted by denom by default",
+			req:                &api.GetTokensMetadataRequest{},
+			expectedDenoms:     []string{"ibc/usdc", "uion", "uosmo"},
+			expectedTotalItems: 3,
+		},
+		{
+			name: "sorted by human denom descending and paginated",
+			req: &api.GetTokensMetadataRequest{
+				Pagination: &v1beta1.PaginationRequest{Cursor: 0, Limit: 2, Strategy: v1beta1.PaginationStrategy_CURSOR},
+				Sort: &v1beta1.SortRequest{Fields: []*v1beta1.SortField{
+					{Field: "humanDenom", Direction: v1beta1.SortDirection_DESCENDING},
+				}},
+			},
+			expectedDenoms:     []string{"ibc/usdc", "uosmo"},
+			expectedTotalItems: 3,
+		},
+		{
+			name: "unsupported sort field",
+			req: &api.GetTokensMetadataRequest{
+				Sort: &v1beta1.SortRequest{Fields: []*v1beta1.SortField{{Field: "unknown"}}},
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var header metadata.MD
+			response, err := client.TokensMetadata(context.Background(), tc.req, grpc.Header(&header))
+
+			if tc.expectedCode != codes.OK {
+				require.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			actualDenoms := make([]string, 0, len(response.Tokens))
+			for _, token := range response.Tokens {
+				actualDenoms = append(actualDenoms, token.Denom)
+			}
+
+			require.Equal(t, tc.expectedDenoms, actualDenoms)
+			require.Equal(t, tc.expectedTotalItems, response.Meta.TotalItems)
+			require.Equal(t, snapshotHeight, response.Height)
+			require.Equal(t, []string{"10"}, header.Get(strings.ToLower(domain.HeightHeader)))
+		})
+	}
+}
+
+// TestPrices validates that the prices are returned in the default quote denom.
+func TestPrices(t *testing.T) {
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetChainDenomFunc: func(humanDenom string) (string, error) {
+			return defaultQuoteChainDenom, nil
+		},
+		IsValidChainDenomFunc: func(chainDenom string) bool {
+			_, ok := tokensMetadata[chainDenom]
+			return ok
+		},
+		IsValidPricingSourceFunc: func(pricingSource int) bool {
+			return pricingSource == int(domain.ChainPricingSourceType)
+		},
+		GetPricesFunc: func(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PricesResult, error) {
+			result := domain.PricesResult{}
+			for _, baseDenom := range baseDenoms {
+				result[baseDenom] = map[string]osmomath.BigDec{}
+				for _, quoteDenom := range quoteDenoms {
+					result[baseDenom][quoteDenom] = osmomath.NewBigDec(2)
+				}
+			}
+			return result, nil
+		},
+	}
+
+	client := setupTokensQueryClient(t, tokensUsecase)
+
+	response, err := client.Prices(context.Background(), &api.GetPricesRequest{BaseDenoms: []string{"uosmo", "uion"}})
+	require.NoError(t, err)
+	require.Equal(t, snapshotHeight, response.Height)
+	require.Equal(t, []api.Price{
+		{BaseDenom: "uion", QuoteDenom: defaultQuoteChainDenom, Price: osmomath.NewBigDec(2).String()},
+		{BaseDenom: "uosmo", QuoteDenom: defaultQuoteChainDenom, Price: osmomath.NewBigDec(2).String()},
+	}, response.Prices)
+
+	// Invalid base denom
+	_, err = client.Prices(context.Background(), &api.GetPricesRequest{BaseDenoms: []string{"unknown"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Invalid pricing source
+	_, err = client.Prices(context.Background(), &api.GetPricesRequest{BaseDenoms: []string{"uosmo"}, PricingSource: api.PricingSource_COINGECKO})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
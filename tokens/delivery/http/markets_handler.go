@@ -0,0 +1,134 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	deliveryhttp "github.com/osmosis-labs/sqs/delivery/http"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+)
+
+const (
+	maxMarketsSearchQueryLength = 50
+
+	queryMarketsFilterSearch = "filter[search]"
+)
+
+// TokensMarketsHandler represent the httphandler for the token markets
+type TokensMarketsHandler struct {
+	TUsecase mvc.TokensUsecase
+}
+
+// GetTokensMarketsRequest is the request for the token markets.
+type GetTokensMarketsRequest struct {
+	// Search is the fuzzy search by symbol, name or chain denom.
+	Search     string
+	Pagination *v1beta1.PaginationRequest
+	Sort       *v1beta1.SortRequest
+}
+
+// GetTokensMarketsResponse is a structure for serializing the token markets returned to clients.
+type GetTokensMarketsResponse struct {
+	Data []domain.TokenMarket        `json:"data"`
+	Meta *v1beta1.PaginationResponse `json:"meta"`
+	// Height is the height of the state the markets were read at.
+	Height uint64 `json:"height,omitempty"`
+}
+
+// NewTokensMarketsHandler will initialize the tokens/markets resource endpoint
+func NewTokensMarketsHandler(e *echo.Echo, ts mvc.TokensUsecase) {
+	handler := &TokensMarketsHandler{
+		TUsecase: ts,
+	}
+
+	e.GET(formatTokensResource("/markets"), handler.GetMarkets)
+}
+
+// UnmarshalHTTPRequest implements deliveryhttp.RequestUnmarshaler.
+func (r *GetTokensMarketsRequest) UnmarshalHTTPRequest(c echo.Context) error {
+	if search := c.QueryParam(queryMarketsFilterSearch); search != "" {
+		if len(search) > maxMarketsSearchQueryLength {
+			return fmt.Errorf("search query is too long")
+		}
+		r.Search = search
+	}
+
+	if pagination := new(v1beta1.PaginationRequest); pagination.IsPresent(c) {
+		if err := pagination.UnmarshalHTTPRequest(c); err != nil {
+			return err
+		}
+
+		r.Pagination = pagination
+	}
+
+	if sort := new(v1beta1.SortRequest); sort.IsPresent(c) {
+		if err := sort.UnmarshalHTTPRequest(c); err != nil {
+			return err
+		}
+
+		r.Sort = sort
+	}
+
+	return nil
+}
+
+// Validate implements validator.Validator.
+func (r *GetTokensMarketsRequest) Validate() error {
+	if r.Pagination != nil {
+		if err := r.Pagination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// @Summary Token markets
+// @Description Returns the market stats of the tokens: the price and total liquidity capitalization in the default quote denom,
+// @Description the number of pools and the price change over the last 24 hours computed from the recorded prices.
+// @Description The price change is only available if the price history is enabled.
+// @Description Given a search, only the tokens whose symbol, name or chain denom fuzzily match it are returned, the best matches first.
+// @Description Otherwise, the tokens are sorted by liquidity capitalization in descending order unless other sort fields are given.
+// @ID get-token-markets
+// @Produce  json
+// @Param  filter[search]  query  string  false  "Fuzzy search by symbol, name or chain denom"
+// @Param  sort  query  string  false  "Comma-separated sort fields, prefixed with - for descending order. One of denom, symbol, name, price, liquidityCap, numPools or priceChange24h"
+// @Param  page[number]  query  int  false  "Page number for page-based pagination"
+// @Param  page[size]  query  int  false  "Page size"
+// @Param  page[cursor]  query  int  false  "Cursor for cursor-based pagination"
+// @Success 200  {object}  GetTokensMarketsResponse  "Market stats of the tokens"
+// @Router /tokens/markets [get]
+func (a *TokensMarketsHandler) GetMarkets(c echo.Context) error {
+	var req GetTokensMarketsRequest
+	if err := deliveryhttp.ParseRequest(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	markets, total, err := a.TUsecase.GetTokenMarkets(
+		c.Request().Context(),
+		domain.WithTokenMarketsSearch(req.Search),
+		domain.WithTokenMarketsPagination(req.Pagination),
+		domain.WithTokenMarketsSort(req.Sort),
+	)
+	if err != nil {
+		if errors.As(err, &domain.UnsupportedTokenMarketsSortFieldError{}) {
+			return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
+	}
+
+	if markets == nil {
+		markets = []domain.TokenMarket{}
+	}
+
+	return c.JSON(http.StatusOK, GetTokensMarketsResponse{
+		Data:   markets,
+		Meta:   v1beta1.NewPaginationResponse(req.Pagination, total),
+		Height: domain.GetHeightFromContext(c.Request().Context()),
+	})
+}
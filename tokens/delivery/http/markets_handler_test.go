@@ -0,0 +1,146 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	tokenshttpdelivery "github.com/osmosis-labs/sqs/tokens/delivery/http"
+)
+
+const (
+	USDC  = "usdc"
+	UOSMO = "uosmo"
+	ATOM  = "ibc/atom"
+)
+
+// TestGetMarkets validates that the token markets request is parsed into the usecase options
+// and that the markets returned by the usecase are serialized with the pagination metadata.
+func TestGetMarkets(t *testing.T) {
+	var options domain.TokenMarketsOptions
+
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetTokenMarketsFunc: func(ctx context.Context, opts ...domain.TokenMarketsOption) ([]domain.TokenMarket, uint64, error) {
+			options = domain.TokenMarketsOptions{}
+			for _, opt := range opts {
+				opt(&options)
+			}
+
+			if options.Sort != nil && options.Sort.Fields[0].Field == "volume" {
+				return nil, 0, domain.UnsupportedTokenMarketsSortFieldError{Field: "volume"}
+			}
+
+			if options.Search == "nomatch" {
+				return nil, 0, nil
+			}
+
+			return []domain.TokenMarket{
+				{Denom: UOSMO, Symbol: "OSMO", Price: osmomath.MustNewBigDecFromStr("0.5"), LiquidityCap: osmomath.NewInt(1000), NumPools: 2},
+				{Denom: ATOM, Symbol: "ATOM", Price: osmomath.NewBigDec(4), LiquidityCap: osmomath.NewInt(500), NumPools: 1},
+			}, 4, nil
+		},
+	}
+
+	e := echo.New()
+	tokenshttpdelivery.NewTokensMarketsHandler(e, tokensUsecase)
+
+	tests := []struct {
+		name  string
+		query string
+
+		expectedStatusCode int
+		expectedOptions    domain.TokenMarketsOptions
+		expectedDenoms     []string
+		expectedMeta       string
+	}{
+		{
+			name:  "no options",
+			query: "",
+
+			expectedStatusCode: http.StatusOK,
+			expectedDenoms:     []string{UOSMO, ATOM},
+			expectedMeta:       `{"total_items":4}`,
+		},
+		{
+			name:  "search, sort and cursor pagination",
+			query: "filter[search]=osmo&sort=-numPools,symbol&page[cursor]=0&page[size]=2",
+
+			expectedStatusCode: http.StatusOK,
+			expectedOptions: domain.TokenMarketsOptions{
+				Search: "osmo",
+				Pagination: &v1beta1.PaginationRequest{
+					Strategy: v1beta1.PaginationStrategy_CURSOR,
+					Cursor:   0,
+					Limit:    2,
+				},
+				Sort: &v1beta1.SortRequest{
+					Fields: []*v1beta1.SortField{
+						{Field: "numPools", Direction: v1beta1.SortDirection_DESCENDING},
+						{Field: "symbol", Direction: v1beta1.SortDirection_ASCENDING},
+					},
+				},
+			},
+			expectedDenoms: []string{UOSMO, ATOM},
+			expectedMeta:   `{"next_cursor":2,"total_items":4}`,
+		},
+		{
+			name:  "no match is serialized as an empty list",
+			query: "filter[search]=nomatch",
+
+			expectedStatusCode: http.StatusOK,
+			expectedOptions:    domain.TokenMarketsOptions{Search: "nomatch"},
+			expectedDenoms:     []string{},
+			expectedMeta:       `{}`,
+		},
+		{
+			name:  "unsupported sort field",
+			query: "sort=volume",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid pagination",
+			query: "page[cursor]=0&page[size]=0",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tokens/markets?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedStatusCode, rec.Code)
+			if tc.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, tc.expectedOptions, options)
+
+			var response struct {
+				Data []domain.TokenMarket `json:"data"`
+				Meta json.RawMessage      `json:"meta"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			denoms := make([]string, 0, len(response.Data))
+			for _, market := range response.Data {
+				denoms = append(denoms, market.Denom)
+			}
+			require.Equal(t, tc.expectedDenoms, denoms)
+			require.JSONEq(t, tc.expectedMeta, string(response.Meta))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+)
+
+// PriceHistoryHandler represent the httphandler for the price history
+type PriceHistoryHandler struct {
+	PHUsecase mvc.PriceHistoryUsecase
+	TUsecase  mvc.TokensUsecase
+
+	defaultQuoteChainDenom string
+}
+
+// PriceHistoryResponse is a structure for serializing the price candles returned to clients.
+type PriceHistoryResponse struct {
+	BaseDenom  string                       `json:"base_denom"`
+	QuoteDenom string                       `json:"quote_denom"`
+	Resolution domain.PriceCandleResolution `json:"resolution"`
+	Candles    []domain.PriceCandle         `json:"candles"`
+}
+
+// NewPriceHistoryHandler will initialize the tokens/prices/history resource endpoint
+func NewPriceHistoryHandler(e *echo.Echo, pricingConfig domain.PricingConfig, us mvc.PriceHistoryUsecase, ts mvc.TokensUsecase) error {
+	defaultQuoteChainDenom, err := ts.GetChainDenom(pricingConfig.DefaultQuoteHumanDenom)
+	if err != nil {
+		return err
+	}
+
+	handler := &PriceHistoryHandler{
+		PHUsecase: us,
+		TUsecase:  ts,
+
+		defaultQuoteChainDenom: defaultQuoteChainDenom,
+	}
+
+	e.GET(formatTokensResource("/prices/history"), handler.GetPriceHistory)
+
+	return nil
+}
+
+// @Summary Get the price history of a token
+// @Description Returns the open, high, low and close prices of the base denom in terms of the default quote denom
+// @Description computed at the end of the ingested blocks, ordered from the oldest to the newest candle.
+// @Description Only the most recent candles are kept and the newest candle may still be open.
+// @Description Candles without any computed price, for example because the denom was not updated, are omitted.
+// @ID get-price-history
+// @Produce  json
+// @Param  base  query  string  true  "Base denom, either human or chain based on humanDenoms"
+// @Param  humanDenoms  query  bool  false  "Boolean flag indicating whether the given denom is human readable or not. Human denoms get converted to chain internally"
+// @Param  resolution  query  string  false  "One of 1m, 5m, 1h or 1d. Defaults to 1h"
+// @Param  from  query  int  false  "Inclusive lower bound of the candle open unix times in seconds"
+// @Param  to  query  int  false  "Inclusive upper bound of the candle open unix times in seconds"
+// @Success 200  {object}  PriceHistoryResponse  "Price candles of the token"
+// @Router /tokens/prices/history [get]
+func (a *PriceHistoryHandler) GetPriceHistory(c echo.Context) (err error) {
+	baseDenoms, err := validateDenomsParam(c.QueryParam("base"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+	if len(baseDenoms) != 1 {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: "exactly one base denom must be provided"})
+	}
+	baseDenom := baseDenoms[0]
+
+	isHumanDenomsStr := c.QueryParam("humanDenoms")
+	if len(isHumanDenomsStr) > 0 {
+		isHumanDenoms, err := strconv.ParseBool(isHumanDenomsStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+		}
+
+		if isHumanDenoms {
+			baseDenom, err = a.TUsecase.GetChainDenom(baseDenom)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+			}
+		}
+	}
+
+	resolution := domain.PriceCandleResolutionHour
+	if resolutionStr := c.QueryParam("resolution"); resolutionStr != "" {
+		resolution, err = domain.ParsePriceCandleResolution(resolutionStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+		}
+	}
+
+	from, err := parseOptionalUnixTimeQueryParam(c, "from")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	to, err := parseOptionalUnixTimeQueryParam(c, "to")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	if to > 0 && from > to {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: "from must be less than or equal to to"})
+	}
+
+	return c.JSON(http.StatusOK, PriceHistoryResponse{
+		BaseDenom:  baseDenom,
+		QuoteDenom: a.defaultQuoteChainDenom,
+		Resolution: resolution,
+		Candles:    a.PHUsecase.GetPriceCandles(baseDenom, a.defaultQuoteChainDenom, resolution, from, to),
+	})
+}
+
+// parseOptionalUnixTimeQueryParam parses the given query parameter as a non-negative unix time in seconds.
+// Returns zero if the parameter is not present.
+func parseOptionalUnixTimeQueryParam(c echo.Context, name string) (int64, error) {
+	valueStr := c.QueryParam(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return int64(value), nil
+}
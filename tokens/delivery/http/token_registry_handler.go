@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/osmosis-labs/sqs/domain"
+)
+
+// TokenRegistryHandler represent the httphandler for the token registry
+type TokenRegistryHandler struct {
+	Reporter domain.TokenRegistryReporter
+}
+
+// NewTokenRegistryAdminHandler will initialize the token registry resources on the given admin group.
+func NewTokenRegistryAdminHandler(g *echo.Group, reporter domain.TokenRegistryReporter) {
+	handler := &TokenRegistryHandler{
+		Reporter: reporter,
+	}
+
+	g.GET(formatTokensResource("/registry-report"), handler.GetTokenRegistryReport)
+}
+
+// @Summary Get the token registry validation report
+// @Description Returns the validation issues of the tokens last loaded from the token registry sources:
+// @Description the chain denoms sharing a symbol, the ones without decimals and the conflicting CoinGecko IDs.
+// @ID get-token-registry-report
+// @Produce  json
+// @Success 200  {object}  domain.TokenRegistryReport  "Validation report of the token registry"
+// @Router /admin/tokens/registry-report [get]
+func (a *TokenRegistryHandler) GetTokenRegistryReport(c echo.Context) error {
+	return c.JSON(http.StatusOK, a.Reporter.GetReport())
+}
@@ -29,11 +29,19 @@ type TokensHandler struct {
 	defaultQuoteChainDenom string
 	defaultCoingeckoDenom  string
 
+	// precomputedQuoteChainDenoms are the chain denoms of the quotes
+	// with pool denom metadata pre-computed at ingest, including the default one.
+	precomputedQuoteChainDenoms map[string]struct{}
+
 	logger log.Logger
 }
 
 const (
 	routerResource = "/tokens"
+
+	// heightResponseKey is the key of the denom-keyed responses reporting the height of the state
+	// the request was served at.
+	heightResponseKey = "height"
 )
 
 func formatTokensResource(resource string) string {
@@ -47,12 +55,25 @@ func NewTokensHandler(e *echo.Echo, pricingConfig domain.PricingConfig, ts mvc.T
 		return err
 	}
 
+	// The quotes that cannot be resolved are not pre-computed.
+	quoteChainDenoms, _, err := pricingConfig.GetQuoteChainDenoms(ts.GetChainDenom)
+	if err != nil {
+		return err
+	}
+
+	precomputedQuoteChainDenoms := make(map[string]struct{}, len(quoteChainDenoms))
+	for _, quoteChainDenom := range quoteChainDenoms {
+		precomputedQuoteChainDenoms[quoteChainDenom] = struct{}{}
+	}
+
 	handler := &TokensHandler{
 		TUsecase: ts,
 		RUsecase: ru,
 
 		defaultQuoteChainDenom: defaultQuoteChainDenom,
 
+		precomputedQuoteChainDenoms: precomputedQuoteChainDenoms,
+
 		logger: logger,
 	}
 
@@ -72,7 +93,7 @@ func NewTokensHandler(e *echo.Echo, pricingConfig domain.PricingConfig, ts mvc.T
 // @ID get-token-metadata
 // @Produce  json
 // @Param  denoms  query  string  false  "List of denoms where each can either be a human denom or a chain denom"
-// @Success 200 {object} map[string]domain.Token "Success"
+// @Success 200 {object} map[string]domain.Token "Token metadata by chain denom, along with the height of the state the request was served at in the height key"
 // @Router /tokens/metadata [get]
 func (a *TokensHandler) GetMetadata(c echo.Context) (err error) {
 	denomsStr := c.QueryParam("denoms")
@@ -81,7 +102,7 @@ func (a *TokensHandler) GetMetadata(c echo.Context) (err error) {
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
 		}
-		return c.JSON(http.StatusOK, tokenMetadata)
+		return c.JSON(http.StatusOK, withHeight(c, tokenMetadata))
 	}
 
 	denoms := strings.Split(denomsStr, ",")
@@ -119,7 +140,7 @@ func (a *TokensHandler) GetMetadata(c echo.Context) (err error) {
 		tokenMetadataResult[chainDenom] = tokenMetadata
 	}
 
-	return c.JSON(http.StatusOK, tokenMetadataResult)
+	return c.JSON(http.StatusOK, withHeight(c, tokenMetadataResult))
 }
 
 // @Summary Pool Denom Metadata
@@ -130,24 +151,53 @@ func (a *TokensHandler) GetMetadata(c echo.Context) (err error) {
 // @Produce  json
 // @Param  denoms  query  string  false  "List of denoms where each can either be a human denom or a chain denom"
 // @Param humanDenoms query bool true "Boolean flag indicating whether the given denoms are human readable or not. Human denoms get converted to chain internally"
+// @Param  quote  query  string  false  "Quote denom of the price and liquidity capitalization, either human or chain per humanDenoms. Must be one of the pre-computed quote denoms; defaults to the default quote denom"
+// @Success 200 {object} map[string]domain.PoolDenomMetaData "Pool denom metadata by chain denom, along with the height of the state the request was served at in the height key"
 // @Router /tokens/pool-metadata [get]
 func (a *TokensHandler) GetPoolDenomMetadata(c echo.Context) (err error) {
+	isHumanDenoms, err := domain.GetIsHumanDenomsQueryParam(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	quoteDenom, err := a.getQuoteDenomParam(c, isHumanDenoms)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+	}
+
+	ctx := c.Request().Context()
+
+	isDefaultQuote := quoteDenom == "" || quoteDenom == a.defaultQuoteChainDenom
+	if !isDefaultQuote {
+		if _, ok := a.precomputedQuoteChainDenoms[quoteDenom]; !ok {
+			return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: fmt.Sprintf("pool denom metadata is not pre-computed for quote denom (%s)", quoteDenom)})
+		}
+	}
+
 	denomsStr := c.QueryParam("denoms")
 	if len(denomsStr) == 0 {
 		// Return all pool denom metadata
-		result := a.TUsecase.GetFullPoolDenomMetadata()
-		return c.JSON(http.StatusOK, result)
+		if !isDefaultQuote {
+			return c.JSON(http.StatusOK, withHeight(c, a.TUsecase.GetFullQuotePoolDenomMetadata(ctx, quoteDenom)))
+		}
+
+		result := a.TUsecase.GetFullPoolDenomMetadata(ctx)
+		return c.JSON(http.StatusOK, withHeight(c, result))
 	}
 
 	denoms := strings.Split(denomsStr, ",")
 	// Validate denom parameters and convert to chain denoms if necessary.
-	chainDenoms, err := mvc.ValidateChainDenomsQueryParam(c, a.TUsecase, denoms)
+	chainDenoms, err := mvc.ValidateChainDenoms(a.TUsecase, denoms, isHumanDenoms)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
 	}
 
-	result := a.TUsecase.GetPoolDenomsMetadata(chainDenoms)
-	return c.JSON(http.StatusOK, result)
+	if !isDefaultQuote {
+		return c.JSON(http.StatusOK, withHeight(c, a.TUsecase.GetQuotePoolDenomsMetadata(ctx, quoteDenom, chainDenoms)))
+	}
+
+	result := a.TUsecase.GetPoolDenomsMetadata(ctx, chainDenoms)
+	return c.JSON(http.StatusOK, withHeight(c, result))
 }
 
 // @Summary Get prices
@@ -160,8 +210,10 @@ func (a *TokensHandler) GetPoolDenomMetadata(c echo.Context) (err error) {
 // @Produce  json
 // @Param   base          query     string  true  "Comma-separated list of base denominations (human-readable or chain format based on humanDenoms parameter)"
 // @Param   humanDenoms   query     bool    false "Specify true if input denominations are in human-readable format; defaults to false"
-// @Param	pricingSource query     int     false "Specify the pricing source. Values can be 0 (chain) or 1 (coingecko); default to 0 (chain)"
-// @Success 200 {object} map[string]map[string]string "A map where each key is a base denomination (on-chain format), containing another map with a key as the quote denomination (on-chain format) and the value as the spot price."
+// @Param	pricingSource query     int     false "Specify the pricing source. Values can be 0 (chain), 1 (coingecko), 2 (twap) or 3 (aggregated); default to 0 (chain)"
+// @Param   quote         query     string  false "Quote denomination, human-readable or chain format based on humanDenoms parameter; defaults to the system-configured quote denomination. Not supported by the coingecko pricing source"
+// @Param   details       query     bool    false "Specify true to return the pricing source along with every price, as well as the source prices and their divergence for the aggregated source or the route confidence for the chain source; defaults to false"
+// @Success 200 {object} map[string]map[string]string "A map where each key is a base denomination (on-chain format), containing another map with a key as the quote denomination (on-chain format) and the value as the spot price. The height of the state the request was served at is reported in the height key."
 // @Router /tokens/prices [get]
 func (a *TokensHandler) GetPrices(c echo.Context) (err error) {
 	ctx := c.Request().Context()
@@ -187,8 +239,8 @@ func (a *TokensHandler) GetPrices(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
 	}
 
-	// Get quote denom based on pricing source type.
-	quoteDenom, err := a.getQuoteDenom(pricingSourceType)
+	// Get quote denom based on pricing source type unless given.
+	quoteDenom, err := a.getQuoteDenom(c, pricingSourceType, isHumanDenoms)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
 	}
@@ -198,11 +250,43 @@ func (a *TokensHandler) GetPrices(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
 	}
 
+	isDetailsStr := c.QueryParam("details")
+	isDetails := false
+	if len(isDetailsStr) > 0 {
+		isDetails, err = strconv.ParseBool(isDetailsStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, domain.ResponseError{Message: err.Error()})
+		}
+	}
+
+	if isDetails {
+		priceDetails, err := a.TUsecase.GetPriceDetails(ctx, baseDenoms, []string{quoteDenom}, pricingSourceType)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
+		}
+		return c.JSON(http.StatusOK, withHeight(c, priceDetails))
+	}
+
 	prices, err := a.TUsecase.GetPrices(ctx, baseDenoms, []string{quoteDenom}, pricingSourceType)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
 	}
-	return c.JSON(http.StatusOK, prices)
+	return c.JSON(http.StatusOK, withHeight(c, prices))
+}
+
+// withHeight returns the given denom-keyed response with the height of the state the request was served at
+// reported in the height key alongside the denoms. The height is omitted if no state is pinned for the request.
+func withHeight[T any](c echo.Context, denomsResponse map[string]T) map[string]any {
+	result := make(map[string]any, len(denomsResponse)+1)
+	for denom, value := range denomsResponse {
+		result[denom] = value
+	}
+
+	if height := domain.GetHeightFromContext(c.Request().Context()); height > 0 {
+		result[heightResponseKey] = height
+	}
+
+	return result
 }
 
 // getPricingSource retrieves the pricing sources.
@@ -226,9 +310,22 @@ func (a TokensHandler) getPricingSource(c echo.Context) (domain.PricingSourceTyp
 	return domain.PricingSourceType(pricingSourceInt), nil
 }
 
-// getQuoteDenom returns the quote denomination based on the pricing source type.
-func (a TokensHandler) getQuoteDenom(pricingSourceType domain.PricingSourceType) (string, error) {
-	if pricingSourceType == domain.ChainPricingSourceType {
+// getQuoteDenom returns the quote denomination given by the quote parameter or, otherwise, based on the pricing source type.
+// The coingecko pricing source only supports its default quote denomination.
+func (a TokensHandler) getQuoteDenom(c echo.Context, pricingSourceType domain.PricingSourceType, isHumanDenoms bool) (string, error) {
+	quoteDenom, err := a.getQuoteDenomParam(c, isHumanDenoms)
+	if err != nil {
+		return "", err
+	}
+
+	if quoteDenom != "" {
+		if pricingSourceType == domain.CoinGeckoPricingSourceType {
+			return "", errors.New("quote denom is not supported by the coingecko pricing source")
+		}
+		return quoteDenom, nil
+	}
+
+	if pricingSourceType == domain.ChainPricingSourceType || pricingSourceType == domain.TWAPPricingSourceType || pricingSourceType == domain.AggregatedPricingSourceType {
 		return a.defaultQuoteChainDenom, nil
 	} else if pricingSourceType == domain.CoinGeckoPricingSourceType {
 		return a.defaultCoingeckoDenom, nil
@@ -237,6 +334,17 @@ func (a TokensHandler) getQuoteDenom(pricingSourceType domain.PricingSourceType)
 	}
 }
 
+// getQuoteDenomParam returns the chain denom of the quote parameter, converting it from human denom if isHumanDenoms is true.
+// Returns an empty string if the parameter is not given.
+func (a TokensHandler) getQuoteDenomParam(c echo.Context, isHumanDenoms bool) (string, error) {
+	quoteDenom := c.QueryParam("quote")
+	if quoteDenom == "" {
+		return "", nil
+	}
+
+	return mvc.ValidateChainDenomQueryParam(a.TUsecase, quoteDenom, isHumanDenoms)
+}
+
 // validateBaseDenoms validates the base denominations. If the base denominations are in human-readable format, it translates them to chain format.
 // Check if the provided denoms (which can be human or chain) are valid and existing in the asset list
 // If human denoms, convert to chain denoms
@@ -322,7 +430,7 @@ func (a *TokensHandler) StoreTokensStateInFiles(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, domain.ResponseError{Message: err.Error()})
 	}
 
-	poolDenomMetaData := a.TUsecase.GetFullPoolDenomMetadata()
+	poolDenomMetaData := a.TUsecase.GetFullPoolDenomMetadata(c.Request().Context())
 
 	err = parsing.StorePoolDenomMetaData(poolDenomMetaData, "pool_denom_metadata.json")
 	if err != nil {
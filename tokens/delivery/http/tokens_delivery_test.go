@@ -0,0 +1,98 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	tokenshttpdelivery "github.com/osmosis-labs/sqs/tokens/delivery/http"
+)
+
+// TestTokensHandler_Height validates that the denom-keyed token responses report the height
+// of the state snapshot pinned for the request in the height key alongside the denoms
+// and omit it if no snapshot is pinned.
+func TestTokensHandler_Height(t *testing.T) {
+	const snapshotHeight = uint64(100)
+
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetChainDenomFunc: func(humanDenom string) (string, error) {
+			return USDC, nil
+		},
+		IsValidChainDenomFunc: func(chainDenom string) bool {
+			return true
+		},
+		GetFullTokenMetadataFunc: func() (map[string]domain.Token, error) {
+			return map[string]domain.Token{
+				UOSMO: {Name: "Osmosis", HumanDenom: "OSMO", CoinMinimalDenom: UOSMO, Precision: 6},
+			}, nil
+		},
+		GetFullPoolDenomMetadataFunc: func(ctx context.Context) domain.PoolDenomMetaDataMap {
+			return domain.PoolDenomMetaDataMap{
+				UOSMO: {Price: osmomath.NewBigDec(2), TotalLiquidity: osmomath.NewInt(1000), TotalLiquidityCap: osmomath.NewInt(2000)},
+			}
+		},
+		GetPricesFunc: func(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PricesResult, error) {
+			return domain.PricesResult{
+				UOSMO: {USDC: osmomath.NewBigDec(2)},
+			}, nil
+		},
+	}
+
+	e := echo.New()
+	err := tokenshttpdelivery.NewTokensHandler(e, domain.PricingConfig{DefaultQuoteHumanDenom: "usdc"}, tokensUsecase, &mocks.RouterUsecaseMock{}, &log.NoOpLogger{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "metadata",
+			path: "/tokens/metadata",
+		},
+		{
+			name: "pool metadata",
+			path: "/tokens/pool-metadata",
+		},
+		{
+			name: "prices",
+			path: "/tokens/prices?base=" + UOSMO,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, isPinned := range []bool{true, false} {
+				req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+				if isPinned {
+					req = req.WithContext(domain.ContextWithStateSnapshot(req.Context(), domain.NewStateSnapshot(snapshotHeight, nil, nil, nil, nil)))
+				}
+				rec := httptest.NewRecorder()
+
+				e.ServeHTTP(rec, req)
+
+				require.Equal(t, http.StatusOK, rec.Code)
+
+				var response map[string]json.RawMessage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+				require.Contains(t, response, UOSMO)
+
+				height, ok := response["height"]
+				require.Equal(t, isPinned, ok)
+				if isPinned {
+					require.JSONEq(t, "100", string(height))
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package usecase
 
+import "github.com/osmosis-labs/sqs/domain"
+
 // PutArbitraryTypeTokenMetadata is a test helper to put arbitrary types to token metadata
 func (t *tokensUseCase) SetTokenMetadataByChainDenom(key string, value any) {
 	t.tokenMetadataByChainDenom.Store(key, value)
@@ -29,3 +31,13 @@ func (f *ChainRegistryHTTPFetcher) SetLastFetchHash(value string) {
 func (f *ChainRegistryHTTPFetcher) GetLastFetchHash() string {
 	return f.lastFetchHash
 }
+
+// SetTimeNowUnixSeconds is a test helper to set the clock of the price history usecase.
+func (p *priceHistoryUseCase) SetTimeNowUnixSeconds(timeNowUnixSeconds func() int64) {
+	p.timeNowUnixSeconds = timeNowUnixSeconds
+}
+
+// MergeTokens is a test helper to merge the tokens of the token registry sources.
+func MergeTokens(sourceTokens []map[string]domain.Token, overrides []domain.TokenRegistryOverride) (map[string]domain.Token, domain.TokenRegistryReport) {
+	return mergeTokens(sourceTokens, overrides)
+}
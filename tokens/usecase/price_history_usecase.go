@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/ringbuffer"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/router/usecase/routertesting/parsing"
+)
+
+type priceHistoryUseCase struct {
+	config domain.PriceHistoryConfig
+
+	// historyMx guards history.
+	historyMx sync.RWMutex
+	history   map[priceSeriesKey]*priceSeries
+
+	// persistHeightMx guards lastPersistHeight since the pricing worker
+	// may notify the updates of consecutive blocks concurrently.
+	persistHeightMx   sync.Mutex
+	lastPersistHeight uint64
+
+	// persistMx serializes the writes of the state file.
+	persistMx sync.Mutex
+
+	timeNowUnixSeconds func() int64
+
+	logger log.Logger
+}
+
+// priceSeriesKey identifies the price series of a base denom in terms of a quote denom.
+type priceSeriesKey struct {
+	baseDenom  string
+	quoteDenom string
+}
+
+// priceSeries is the price history of a base denom in terms of a quote denom at every supported resolution.
+type priceSeries struct {
+	// lastHeight is the height of the latest price folded into the series.
+	// Prices of older heights, notified out of order, are skipped.
+	lastHeight uint64
+	// closed are the closed candles of every resolution.
+	closed map[domain.PriceCandleResolution]*ringbuffer.RingBuffer[domain.PriceCandle]
+	// open is the open candle of every resolution, if any.
+	open map[domain.PriceCandleResolution]*domain.PriceCandle
+}
+
+var _ mvc.PriceHistoryUsecase = &priceHistoryUseCase{}
+
+// NewPriceHistoryUsecase returns a new price history usecase.
+// If the state file is configured and exists, the history is restored from it.
+func NewPriceHistoryUsecase(config domain.PriceHistoryConfig, logger log.Logger) (*priceHistoryUseCase, error) {
+	p := &priceHistoryUseCase{
+		config: config,
+
+		history: make(map[priceSeriesKey]*priceSeries),
+
+		timeNowUnixSeconds: func() int64 { return time.Now().Unix() },
+
+		logger: logger,
+	}
+
+	if config.StateFile == "" {
+		return p, nil
+	}
+
+	priceHistory, err := parsing.ReadPriceHistory(config.StateFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return p, nil
+		}
+		return nil, err
+	}
+
+	for _, candleHistory := range priceHistory {
+		if len(candleHistory.Candles) == 0 || candleHistory.Resolution.Seconds() == 0 {
+			continue
+		}
+
+		series := p.getOrCreatePriceSeries(priceSeriesKey{baseDenom: candleHistory.BaseDenom, quoteDenom: candleHistory.QuoteDenom})
+
+		// The newest candle is restored as the open one. It is closed by the first price of a later candle.
+		lastIndex := len(candleHistory.Candles) - 1
+		for _, candle := range candleHistory.Candles[:lastIndex] {
+			series.closed[candleHistory.Resolution].Push(candle)
+		}
+		openCandle := candleHistory.Candles[lastIndex]
+		series.open[candleHistory.Resolution] = &openCandle
+	}
+
+	logger.Info("restored price history", zap.String("state_file", config.StateFile), zap.Int("num_series", len(p.history)))
+
+	return p, nil
+}
+
+// OnPricingUpdate implements domain.PricingUpdateListener.
+// It folds the prices of the block into the candles of every resolution and
+// persists the history once every configured number of blocks.
+// Zero prices, which denote that the price could not be computed, are skipped.
+func (p *priceHistoryUseCase) OnPricingUpdate(ctx context.Context, height uint64, blockMetaData domain.BlockPoolMetadata, pricesBaseQuoteDenomMap domain.PricesResult, quoteDenom string) error {
+	timestamp := p.timeNowUnixSeconds()
+
+	p.historyMx.Lock()
+	for baseDenom, quotePrices := range pricesBaseQuoteDenomMap {
+		price, ok := quotePrices[quoteDenom]
+		if !ok || price.IsNil() || !price.IsPositive() {
+			continue
+		}
+
+		series := p.getOrCreatePriceSeries(priceSeriesKey{baseDenom: baseDenom, quoteDenom: quoteDenom})
+		if height <= series.lastHeight {
+			continue
+		}
+		series.lastHeight = height
+
+		for _, resolution := range domain.PriceCandleResolutions {
+			series.update(resolution, timestamp, price)
+		}
+	}
+	p.historyMx.Unlock()
+
+	if p.config.StateFile == "" {
+		return nil
+	}
+
+	p.persistHeightMx.Lock()
+	shouldPersist := height >= p.lastPersistHeight+p.config.PersistIntervalBlocks
+	if shouldPersist {
+		p.lastPersistHeight = height
+	}
+	p.persistHeightMx.Unlock()
+
+	if shouldPersist {
+		if err := p.StorePriceHistory(); err != nil {
+			p.logger.Error("failed to store price history", zap.Uint64("height", height), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// GetPriceCandles implements mvc.PriceHistoryUsecase.
+func (p *priceHistoryUseCase) GetPriceCandles(baseDenom, quoteDenom string, resolution domain.PriceCandleResolution, from, to int64) []domain.PriceCandle {
+	p.historyMx.RLock()
+	series, ok := p.history[priceSeriesKey{baseDenom: baseDenom, quoteDenom: quoteDenom}]
+	var candles []domain.PriceCandle
+	if ok {
+		candles = series.candles(resolution)
+	}
+	p.historyMx.RUnlock()
+
+	result := make([]domain.PriceCandle, 0, len(candles))
+	for _, candle := range candles {
+		if candle.Time < from || (to > 0 && candle.Time > to) {
+			continue
+		}
+		result = append(result, candle)
+	}
+
+	return result
+}
+
+// StorePriceHistory implements mvc.PriceHistoryUsecase.
+func (p *priceHistoryUseCase) StorePriceHistory() error {
+	if p.config.StateFile == "" {
+		return nil
+	}
+
+	p.historyMx.RLock()
+	priceHistory := make([]domain.PriceCandleHistory, 0, len(p.history)*len(domain.PriceCandleResolutions))
+	for key, series := range p.history {
+		for _, resolution := range domain.PriceCandleResolutions {
+			candles := series.candles(resolution)
+			if len(candles) == 0 {
+				continue
+			}
+
+			priceHistory = append(priceHistory, domain.PriceCandleHistory{
+				BaseDenom:  key.baseDenom,
+				QuoteDenom: key.quoteDenom,
+				Resolution: resolution,
+				Candles:    candles,
+			})
+		}
+	}
+	p.historyMx.RUnlock()
+
+	p.persistMx.Lock()
+	defer p.persistMx.Unlock()
+
+	return parsing.StorePriceHistory(priceHistory, p.config.StateFile)
+}
+
+// getOrCreatePriceSeries returns the price series for the given key, creating it if it does not exist.
+// CONTRACT: the caller holds the history write lock or has exclusive access to the usecase.
+func (p *priceHistoryUseCase) getOrCreatePriceSeries(key priceSeriesKey) *priceSeries {
+	series, ok := p.history[key]
+	if !ok {
+		series = &priceSeries{
+			closed: make(map[domain.PriceCandleResolution]*ringbuffer.RingBuffer[domain.PriceCandle], len(domain.PriceCandleResolutions)),
+			open:   make(map[domain.PriceCandleResolution]*domain.PriceCandle, len(domain.PriceCandleResolutions)),
+		}
+		for _, resolution := range domain.PriceCandleResolutions {
+			series.closed[resolution] = ringbuffer.New[domain.PriceCandle](p.config.MaxCandles)
+		}
+		p.history[key] = series
+	}
+	return series
+}
+
+// update folds the given price observed at the given unix time in seconds into the candle of the given resolution.
+// If the time falls after the open candle, the open candle is closed and a new one is opened.
+// Prices observed before the open candle, which may only happen if the clock moves backwards, are skipped.
+func (s *priceSeries) update(resolution domain.PriceCandleResolution, timestamp int64, price osmomath.BigDec) {
+	candleTime := timestamp - timestamp%resolution.Seconds()
+
+	openCandle, ok := s.open[resolution]
+	switch {
+	case !ok:
+	case candleTime == openCandle.Time:
+		openCandle.Update(price)
+		return
+	case candleTime > openCandle.Time:
+		s.closed[resolution].Push(*openCandle)
+	default:
+		return
+	}
+
+	newCandle := domain.NewPriceCandle(candleTime, price)
+	s.open[resolution] = &newCandle
+}
+
+// candles returns the closed candles of the given resolution followed by the open one, if any.
+func (s *priceSeries) candles(resolution domain.PriceCandleResolution) []domain.PriceCandle {
+	closed, ok := s.closed[resolution]
+	if !ok {
+		return nil
+	}
+
+	candles := closed.Items()
+	if openCandle, ok := s.open[resolution]; ok {
+		candles = append(candles, *openCandle)
+	}
+	return candles
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
+	tokensusecase "github.com/osmosis-labs/sqs/tokens/usecase"
+)
+
+// TestPriceHistory validates that the prices are folded into the candles of every resolution,
+// that zero and out of order prices are skipped, that the oldest candles are overwritten
+// once the max candles are reached and that the history is restored from the state file.
+func TestPriceHistory(t *testing.T) {
+	const (
+		baseDenom  = "uosmo"
+		quoteDenom = "usdc"
+
+		// 1970-01-02T00:00:00Z, aligned to every resolution.
+		dayStart = int64(24 * 60 * 60)
+	)
+
+	config := domain.PriceHistoryConfig{
+		Enabled:               true,
+		MaxCandles:            2,
+		PersistIntervalBlocks: 1,
+		StateFile:             filepath.Join(t.TempDir(), "price_history.json"),
+	}
+
+	priceHistoryUsecase, err := tokensusecase.NewPriceHistoryUsecase(config, &log.NoOpLogger{})
+	require.NoError(t, err)
+
+	now := dayStart
+	priceHistoryUsecase.SetTimeNowUnixSeconds(func() int64 { return now })
+
+	update := func(height uint64, timestamp int64, price string) {
+		now = timestamp
+		prices := domain.PricesResult{
+			baseDenom: {quoteDenom: osmomath.MustNewBigDecFromStr(price)},
+		}
+		require.NoError(t, priceHistoryUsecase.OnPricingUpdate(context.Background(), height, domain.BlockPoolMetadata{}, prices, quoteDenom))
+	}
+
+	// First minute.
+	update(10, dayStart, "2")
+	update(11, dayStart+10, "3")
+	update(12, dayStart+20, "1")
+	// Zero price is skipped.
+	update(13, dayStart+30, "0")
+	// Out of order height is skipped.
+	update(9, dayStart+40, "10")
+	update(14, dayStart+50, "1.5")
+	// Second minute.
+	update(15, dayStart+60, "4")
+	// Fourth minute, overwriting the first one.
+	update(16, dayStart+3*60, "5")
+	// Second five minutes.
+	update(17, dayStart+5*60, "6")
+
+	candle := func(time int64, open, high, low, close string) domain.PriceCandle {
+		return domain.PriceCandle{
+			Time:  time,
+			Open:  osmomath.MustNewBigDecFromStr(open),
+			High:  osmomath.MustNewBigDecFromStr(high),
+			Low:   osmomath.MustNewBigDecFromStr(low),
+			Close: osmomath.MustNewBigDecFromStr(close),
+		}
+	}
+
+	expectedMinuteCandles := []domain.PriceCandle{
+		candle(dayStart+60, "4", "4", "4", "4"),
+		candle(dayStart+3*60, "5", "5", "5", "5"),
+		candle(dayStart+5*60, "6", "6", "6", "6"),
+	}
+	require.Equal(t, expectedMinuteCandles, priceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionMinute, 0, 0))
+
+	require.Equal(t, []domain.PriceCandle{
+		candle(dayStart, "2", "5", "1", "5"),
+		candle(dayStart+5*60, "6", "6", "6", "6"),
+	}, priceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionFiveMinute, 0, 0))
+
+	expectedDayCandles := []domain.PriceCandle{
+		candle(dayStart, "2", "6", "1", "6"),
+	}
+	require.Equal(t, expectedDayCandles, priceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionDay, 0, 0))
+
+	// Time range.
+	require.Equal(t, expectedMinuteCandles[1:2], priceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionMinute, dayStart+2*60, dayStart+4*60))
+
+	// Unknown denom.
+	require.Empty(t, priceHistoryUsecase.GetPriceCandles(quoteDenom, baseDenom, domain.PriceCandleResolutionMinute, 0, 0))
+
+	// Restored from the state file with the newest candle still open.
+	restoredPriceHistoryUsecase, err := tokensusecase.NewPriceHistoryUsecase(config, &log.NoOpLogger{})
+	require.NoError(t, err)
+	restoredPriceHistoryUsecase.SetTimeNowUnixSeconds(func() int64 { return dayStart + 5*60 + 30 })
+
+	require.Equal(t, expectedMinuteCandles, restoredPriceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionMinute, 0, 0))
+
+	prices := domain.PricesResult{
+		baseDenom: {quoteDenom: osmomath.MustNewBigDecFromStr("7")},
+	}
+	require.NoError(t, restoredPriceHistoryUsecase.OnPricingUpdate(context.Background(), 18, domain.BlockPoolMetadata{}, prices, quoteDenom))
+
+	require.Equal(t, []domain.PriceCandle{
+		candle(dayStart, "2", "7", "1", "7"),
+	}, restoredPriceHistoryUsecase.GetPriceCandles(baseDenom, quoteDenom, domain.PriceCandleResolutionDay, 0, 0))
+}
@@ -0,0 +1,252 @@
+package aggregatedpricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/cache"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+// aggregatedPricing is a pricing source that queries several other pricing sources
+// and aggregates their prices into a median or a weighted average.
+// Source prices that deviate from the aggregated price by more than the configured threshold
+// are flagged as divergent and counted.
+type aggregatedPricing struct {
+	sources []aggregatedSource
+	method  domain.PricingAggregationMethod
+
+	divergenceThreshold osmomath.BigDec
+
+	logger log.Logger
+}
+
+// aggregatedSource is a pricing source together with its weight.
+type aggregatedSource struct {
+	sourceType domain.PricingSourceType
+	source     domain.PricingSource
+	// weight is only used by the weighted method.
+	weight osmomath.BigDec
+}
+
+// sourcePrice is the price computed by an aggregated source.
+type sourcePrice struct {
+	source aggregatedSource
+	price  osmomath.BigDec
+}
+
+var _ domain.DetailedPricingSource = &aggregatedPricing{}
+
+// New creates a new aggregated pricing source over the configured sources, looked up in the given sources by type.
+// Returns error if the config is invalid or if a configured source is not given.
+func New(config domain.PricingAggregationConfig, sources map[domain.PricingSourceType]domain.PricingSource, logger log.Logger) (*aggregatedPricing, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	divergenceThreshold, err := floatToBigDec(config.DivergenceThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregatedSources := make([]aggregatedSource, 0, len(config.Sources))
+	for i, sourceType := range config.Sources {
+		source, ok := sources[sourceType]
+		if !ok {
+			return nil, fmt.Errorf("pricing source (%s) is not available for aggregation", sourceType)
+		}
+
+		weight := osmomath.OneBigDec()
+		if config.Method == domain.PricingAggregationMethodWeighted {
+			weight, err = floatToBigDec(config.Weights[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		aggregatedSources = append(aggregatedSources, aggregatedSource{
+			sourceType: sourceType,
+			source:     source,
+			weight:     weight,
+		})
+	}
+
+	return &aggregatedPricing{
+		sources: aggregatedSources,
+		method:  config.Method,
+
+		divergenceThreshold: divergenceThreshold,
+
+		logger: logger,
+	}, nil
+}
+
+// GetPrice implements domain.PricingSource.
+func (a *aggregatedPricing) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	priceDetails, err := a.GetPriceDetails(ctx, baseDenom, quoteDenom, opts...)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return priceDetails.Price, nil
+}
+
+// GetPriceDetails implements domain.DetailedPricingSource.
+// It queries the sources concurrently and aggregates the prices of the sources that succeed.
+// The options are passed through to the sources.
+// Returns error if all sources fail.
+func (a *aggregatedPricing) GetPriceDetails(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (domain.PriceDetails, error) {
+	sourcePrices, err := a.getSourcePrices(ctx, baseDenom, quoteDenom, opts...)
+	if err != nil {
+		return domain.PriceDetails{}, err
+	}
+
+	var price osmomath.BigDec
+	if a.method == domain.PricingAggregationMethodWeighted {
+		price = computeWeightedAverage(sourcePrices)
+	} else {
+		price = computeMedian(sourcePrices)
+	}
+
+	aggregation := &domain.PriceAggregation{
+		SourcePrices: make(map[string]osmomath.BigDec, len(sourcePrices)),
+		MaxDeviation: osmomath.ZeroBigDec(),
+	}
+
+	divergentSources := []string{}
+	for _, sourcePrice := range sourcePrices {
+		sourceName := sourcePrice.source.sourceType.String()
+		aggregation.SourcePrices[sourceName] = sourcePrice.price
+
+		deviation := sourcePrice.price.Sub(price).AbsMut().QuoMut(price)
+		if deviation.GT(aggregation.MaxDeviation) {
+			aggregation.MaxDeviation = deviation
+		}
+
+		if deviation.GT(a.divergenceThreshold) {
+			divergentSources = append(divergentSources, sourceName)
+			domain.SQSPricingDivergenceCounter.WithLabelValues(sourceName).Inc()
+		}
+	}
+
+	if len(divergentSources) > 0 {
+		aggregation.IsDivergent = true
+
+		a.logger.Warn(domain.SQSPricingDivergenceCounterMetricName, zap.String("base_denom", baseDenom), zap.String("quote_denom", quoteDenom), zap.Strings("divergent_sources", divergentSources), zap.Any("source_prices", aggregation.SourcePrices), zap.Stringer("price", price))
+	}
+
+	return domain.PriceDetails{
+		Price:       price,
+		Source:      domain.AggregatedPricingSourceType.String(),
+		Aggregation: aggregation,
+	}, nil
+}
+
+// InitializeCache implements domain.PricingSource.
+// The aggregated prices are not cached since the sources cache their own prices.
+func (a *aggregatedPricing) InitializeCache(cache *cache.Cache) {
+}
+
+// GetFallbackStrategy implements domain.PricingSource.
+// There is no fallback since the aggregated sources already stand in for each other.
+func (a *aggregatedPricing) GetFallbackStrategy(quoteDenom string) domain.PricingSourceType {
+	return domain.NoneSourceType
+}
+
+// getSourcePrices queries the sources concurrently and returns the positive prices in the order of the sources.
+// Returns the joined errors if all sources fail.
+func (a *aggregatedPricing) getSourcePrices(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) ([]sourcePrice, error) {
+	prices := make([]osmomath.BigDec, len(a.sources))
+	errs := make([]error, len(a.sources))
+
+	var wg sync.WaitGroup
+	for i, source := range a.sources {
+		wg.Add(1)
+		go func(i int, source aggregatedSource) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					errs[i] = fmt.Errorf("panic in %s pricing source: %v", source.sourceType, r)
+				}
+			}()
+
+			prices[i], errs[i] = source.source.GetPrice(ctx, baseDenom, quoteDenom, opts...)
+		}(i, source)
+	}
+	wg.Wait()
+
+	sourcePrices := make([]sourcePrice, 0, len(a.sources))
+	for i, source := range a.sources {
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("%s pricing source: %w", source.sourceType, errs[i])
+			continue
+		}
+
+		if prices[i].IsNil() || !prices[i].IsPositive() {
+			errs[i] = fmt.Errorf("%s pricing source returned a non-positive price", source.sourceType)
+			continue
+		}
+
+		sourcePrices = append(sourcePrices, sourcePrice{source: source, price: prices[i]})
+	}
+
+	if len(sourcePrices) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			a.logger.Debug("failed to get aggregated source price", zap.String("base_denom", baseDenom), zap.String("quote_denom", quoteDenom), zap.Error(err))
+		}
+	}
+
+	return sourcePrices, nil
+}
+
+// computeMedian returns the median of the given prices.
+// For an even number of prices, returns the average of the two middle ones.
+// CONTRACT: sourcePrices is non-empty.
+func computeMedian(sourcePrices []sourcePrice) osmomath.BigDec {
+	prices := make([]osmomath.BigDec, 0, len(sourcePrices))
+	for _, sourcePrice := range sourcePrices {
+		prices = append(prices, sourcePrice.price)
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+
+	middle := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[middle]
+	}
+
+	return prices[middle-1].Add(prices[middle]).QuoInt64(2)
+}
+
+// computeWeightedAverage returns the average of the given prices weighted by the weights of their sources.
+// CONTRACT: sourcePrices is non-empty and the weights are positive.
+func computeWeightedAverage(sourcePrices []sourcePrice) osmomath.BigDec {
+	weightedSum := osmomath.ZeroBigDec()
+	totalWeight := osmomath.ZeroBigDec()
+	for _, sourcePrice := range sourcePrices {
+		weightedSum.AddMut(sourcePrice.price.Mul(sourcePrice.source.weight))
+		totalWeight.AddMut(sourcePrice.source.weight)
+	}
+
+	return weightedSum.QuoMut(totalWeight)
+}
+
+// floatToBigDec converts the given float to a BigDec.
+func floatToBigDec(value float64) (osmomath.BigDec, error) {
+	return osmomath.NewBigDecFromStr(strconv.FormatFloat(value, 'f', -1, 64))
+}
@@ -0,0 +1,178 @@
+package aggregatedpricing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/cache"
+	"github.com/osmosis-labs/sqs/log"
+	aggregatedpricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/aggregated"
+)
+
+const (
+	baseDenom  = "uosmo"
+	quoteDenom = "usdc"
+)
+
+// mockPricingSource is a pricing source returning a fixed price or error.
+type mockPricingSource struct {
+	price string
+	err   error
+}
+
+var _ domain.PricingSource = &mockPricingSource{}
+
+// GetPrice implements domain.PricingSource.
+func (m *mockPricingSource) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	if m.err != nil {
+		return osmomath.BigDec{}, m.err
+	}
+	return osmomath.MustNewBigDecFromStr(m.price), nil
+}
+
+// InitializeCache implements domain.PricingSource.
+func (m *mockPricingSource) InitializeCache(cache *cache.Cache) {
+}
+
+// GetFallbackStrategy implements domain.PricingSource.
+func (m *mockPricingSource) GetFallbackStrategy(quoteDenom string) domain.PricingSourceType {
+	return domain.NoneSourceType
+}
+
+// TestGetPriceDetails validates that the prices of the sources that succeed are aggregated
+// by the configured method and that the sources deviating by more than the threshold are flagged.
+func TestGetPriceDetails(t *testing.T) {
+	var (
+		allSources = []domain.PricingSourceType{domain.ChainPricingSourceType, domain.CoinGeckoPricingSourceType, domain.TWAPPricingSourceType}
+		errSource  = errors.New("source failed")
+	)
+
+	tests := []struct {
+		name    string
+		method  domain.PricingAggregationMethod
+		weights []float64
+		sources map[domain.PricingSourceType]domain.PricingSource
+
+		expectedPrice        osmomath.BigDec
+		expectedSourcePrices map[string]osmomath.BigDec
+		expectedMaxDeviation osmomath.BigDec
+		expectedIsDivergent  bool
+		expectErr            bool
+	}{
+		{
+			name:   "median of odd number of sources with divergence",
+			method: domain.PricingAggregationMethodMedian,
+			sources: map[domain.PricingSourceType]domain.PricingSource{
+				domain.ChainPricingSourceType:     &mockPricingSource{price: "1"},
+				domain.CoinGeckoPricingSourceType: &mockPricingSource{price: "1.01"},
+				domain.TWAPPricingSourceType:      &mockPricingSource{price: "1.5"},
+			},
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("1.01"),
+			expectedSourcePrices: map[string]osmomath.BigDec{
+				"chain":     osmomath.MustNewBigDecFromStr("1"),
+				"coingecko": osmomath.MustNewBigDecFromStr("1.01"),
+				"twap":      osmomath.MustNewBigDecFromStr("1.5"),
+			},
+			expectedMaxDeviation: osmomath.MustNewBigDecFromStr("0.49").QuoMut(osmomath.MustNewBigDecFromStr("1.01")),
+			expectedIsDivergent:  true,
+		},
+		{
+			name:   "median of even number of sources after failure",
+			method: domain.PricingAggregationMethodMedian,
+			sources: map[domain.PricingSourceType]domain.PricingSource{
+				domain.ChainPricingSourceType:     &mockPricingSource{price: "1"},
+				domain.CoinGeckoPricingSourceType: &mockPricingSource{price: "1.02"},
+				domain.TWAPPricingSourceType:      &mockPricingSource{err: errSource},
+			},
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("1.01"),
+			expectedSourcePrices: map[string]osmomath.BigDec{
+				"chain":     osmomath.MustNewBigDecFromStr("1"),
+				"coingecko": osmomath.MustNewBigDecFromStr("1.02"),
+			},
+			expectedMaxDeviation: osmomath.MustNewBigDecFromStr("0.01").QuoMut(osmomath.MustNewBigDecFromStr("1.01")),
+		},
+		{
+			name:    "weighted average skipping zero price",
+			method:  domain.PricingAggregationMethodWeighted,
+			weights: []float64{3, 1, 1},
+			sources: map[domain.PricingSourceType]domain.PricingSource{
+				domain.ChainPricingSourceType:     &mockPricingSource{price: "1"},
+				domain.CoinGeckoPricingSourceType: &mockPricingSource{price: "1.04"},
+				domain.TWAPPricingSourceType:      &mockPricingSource{price: "0"},
+			},
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("1.01"),
+			expectedSourcePrices: map[string]osmomath.BigDec{
+				"chain":     osmomath.MustNewBigDecFromStr("1"),
+				"coingecko": osmomath.MustNewBigDecFromStr("1.04"),
+			},
+			expectedMaxDeviation: osmomath.MustNewBigDecFromStr("0.03").QuoMut(osmomath.MustNewBigDecFromStr("1.01")),
+		},
+		{
+			name:   "all sources fail",
+			method: domain.PricingAggregationMethodMedian,
+			sources: map[domain.PricingSourceType]domain.PricingSource{
+				domain.ChainPricingSourceType:     &mockPricingSource{err: errSource},
+				domain.CoinGeckoPricingSourceType: &mockPricingSource{err: errSource},
+				domain.TWAPPricingSourceType:      &mockPricingSource{price: "0"},
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := domain.PricingAggregationConfig{
+				Sources:             allSources,
+				Method:              tc.method,
+				Weights:             tc.weights,
+				DivergenceThreshold: 0.05,
+			}
+
+			aggregatedPricing, err := aggregatedpricing.New(config, tc.sources, &log.NoOpLogger{})
+			require.NoError(t, err)
+
+			priceDetails, err := aggregatedPricing.GetPriceDetails(context.Background(), baseDenom, quoteDenom)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.ErrorIs(t, err, errSource)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), priceDetails.Price.String())
+			require.Equal(t, "aggregated", priceDetails.Source)
+			require.NotNil(t, priceDetails.Aggregation)
+			require.Equal(t, tc.expectedSourcePrices, priceDetails.Aggregation.SourcePrices)
+			require.Equal(t, tc.expectedMaxDeviation.String(), priceDetails.Aggregation.MaxDeviation.String())
+			require.Equal(t, tc.expectedIsDivergent, priceDetails.Aggregation.IsDivergent)
+
+			price, err := aggregatedPricing.GetPrice(context.Background(), baseDenom, quoteDenom)
+			require.NoError(t, err)
+			require.Equal(t, priceDetails.Price.String(), price.String())
+		})
+	}
+}
+
+// TestNew_MissingSource validates that a configured source that is not available is rejected.
+func TestNew_MissingSource(t *testing.T) {
+	config := domain.PricingAggregationConfig{
+		Sources:             []domain.PricingSourceType{domain.ChainPricingSourceType, domain.CoinGeckoPricingSourceType},
+		Method:              domain.PricingAggregationMethodMedian,
+		DivergenceThreshold: 0.05,
+	}
+
+	_, err := aggregatedpricing.New(config, map[domain.PricingSourceType]domain.PricingSource{
+		domain.ChainPricingSourceType: &mockPricingSource{price: "1"},
+	}, &log.NoOpLogger{})
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/sqs/domain"
 	"github.com/osmosis-labs/sqs/domain/cache"
 	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
 )
 
 type chainPricing struct {
@@ -21,13 +22,15 @@ type chainPricing struct {
 	cacheExpiryNs time.Duration
 
 	defaultQuoteDenom string
+	// precomputedQuoteDenoms are the chain denoms of the quotes pre-computed at ingest, including the default one.
+	precomputedQuoteDenoms map[string]struct{}
 
 	maxPoolsPerRoute    int
 	maxRoutes           int
 	minPoolLiquidityCap uint64
 }
 
-var _ domain.PricingSource = &chainPricing{}
+var _ domain.DetailedPricingSource = &chainPricing{}
 
 const (
 	// We use multiplier so that stablecoin quotes avoid selecting low liquidity routes.
@@ -52,6 +55,17 @@ func New(routerUseCase mvc.SimpleRouterUsecase, tokenUseCase mvc.TokensUsecase,
 		panic(fmt.Sprintf("failed to get chain denom for default quote human denom (%s): %s", config.DefaultQuoteHumanDenom, err))
 	}
 
+	// The quotes that cannot be resolved are not pre-computed.
+	quoteChainDenoms, _, err := config.GetQuoteChainDenoms(tokenUseCase.GetChainDenom)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	precomputedQuoteDenoms := make(map[string]struct{}, len(quoteChainDenoms))
+	for _, quoteChainDenom := range quoteChainDenoms {
+		precomputedQuoteDenoms[quoteChainDenom] = struct{}{}
+	}
+
 	return &chainPricing{
 		RUsecase: routerUseCase,
 		TUsecase: tokenUseCase,
@@ -62,25 +76,20 @@ func New(routerUseCase mvc.SimpleRouterUsecase, tokenUseCase mvc.TokensUsecase,
 		maxRoutes:           config.MaxRoutes,
 		minPoolLiquidityCap: config.MinPoolLiquidityCap,
 		defaultQuoteDenom:   chainDefaultHumanDenom,
+
+		precomputedQuoteDenoms: precomputedQuoteDenoms,
 	}
 }
 
 // GetPrice implements pricing.PricingStrategy.
 func (c *chainPricing) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
-	options := domain.PricingOptions{
-		MinPoolLiquidityCap:                     c.minPoolLiquidityCap,
-		RecomputePricesIsSpotPriceComputeMethod: defaultIsSpotPriceComputeMethod,
-		RecomputePrices:                         false,
-	}
-
-	for _, opt := range opts {
-		opt(&options)
-	}
+	options := c.getPricingOptions(opts...)
 
 	// Recompute prices if desired by configuration.
 	// Otherwise, look into cache first.
 	if options.RecomputePrices {
-		return c.computePrice(ctx, baseDenom, quoteDenom, options.MinPoolLiquidityCap, options.RecomputePricesIsSpotPriceComputeMethod)
+		price, _, err := c.computePrice(ctx, baseDenom, quoteDenom, options.MinPoolLiquidityCap, options.RecomputePricesIsSpotPriceComputeMethod, false)
+		return price, err
 	}
 
 	// equal base and quote yield the price of one
@@ -107,27 +116,64 @@ func (c *chainPricing) GetPrice(ctx context.Context, baseDenom string, quoteDeno
 	}
 
 	// If cache miss occurs, we compute the price.
-	return c.computePrice(ctx, baseDenom, quoteDenom, options.MinPoolLiquidityCap, options.RecomputePricesIsSpotPriceComputeMethod)
+	price, _, err := c.computePrice(ctx, baseDenom, quoteDenom, options.MinPoolLiquidityCap, options.RecomputePricesIsSpotPriceComputeMethod, false)
+	return price, err
+}
+
+// GetPriceDetails implements domain.DetailedPricingSource.
+// It returns the price together with the confidence describing the route it was computed over.
+// The price is always recomputed since the routes are not cached.
+// The confidence is nil if the base and quote denoms are equal.
+func (c *chainPricing) GetPriceDetails(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (domain.PriceDetails, error) {
+	options := c.getPricingOptions(opts...)
+
+	price, confidence, err := c.computePrice(ctx, baseDenom, quoteDenom, options.MinPoolLiquidityCap, options.RecomputePricesIsSpotPriceComputeMethod, true)
+	if err != nil {
+		return domain.PriceDetails{}, err
+	}
+
+	return domain.PriceDetails{
+		Price:      price,
+		Source:     domain.ChainPricingSourceType.String(),
+		Confidence: confidence,
+	}, nil
+}
+
+// getPricingOptions returns the default pricing options overwritten by the given ones.
+func (c *chainPricing) getPricingOptions(opts ...domain.PricingOption) domain.PricingOptions {
+	options := domain.PricingOptions{
+		MinPoolLiquidityCap:                     c.minPoolLiquidityCap,
+		RecomputePricesIsSpotPriceComputeMethod: defaultIsSpotPriceComputeMethod,
+		RecomputePrices:                         false,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
 }
 
 // computePrice computes the price for a given base and quote denom
-func (c *chainPricing) computePrice(ctx context.Context, baseDenom string, quoteDenom string, minPoolLiquidityCap uint64, isSpotPriceComputeMethod bool) (osmomath.BigDec, error) {
+// If isDetailed is true, it also returns the confidence describing the route the price was computed over.
+// Otherwise, the returned confidence is nil.
+func (c *chainPricing) computePrice(ctx context.Context, baseDenom string, quoteDenom string, minPoolLiquidityCap uint64, isSpotPriceComputeMethod bool, isDetailed bool) (osmomath.BigDec, *domain.PriceConfidence, error) {
 	cacheKey := domain.FormatPricingCacheKey(baseDenom, quoteDenom)
 
 	if baseDenom == quoteDenom {
-		return osmomath.OneBigDec(), nil
+		return osmomath.OneBigDec(), nil, nil
 	}
 
 	// Get on-chain scaling factor for base denom.
 	baseDenomScalingFactor, err := c.TUsecase.GetChainScalingFactorByDenomMut(baseDenom)
 	if err != nil {
-		return osmomath.BigDec{}, err
+		return osmomath.BigDec{}, nil, err
 	}
 
 	// Get on-chain scaling factor for quote denom.
 	quoteDenomScalingFactor, err := c.TUsecase.GetChainScalingFactorByDenomMut(quoteDenom)
 	if err != nil {
-		return osmomath.BigDec{}, err
+		return osmomath.BigDec{}, nil, err
 	}
 
 	// Create a quote denom coin.
@@ -148,15 +194,15 @@ func (c *chainPricing) computePrice(ctx context.Context, baseDenom string, quote
 	// Compute a quote for one quote coin.
 	quote, err := c.RUsecase.GetSimpleQuote(ctx, tenQuoteCoin, baseDenom, routingOptions...)
 	if err != nil {
-		return osmomath.BigDec{}, err
+		return osmomath.BigDec{}, nil, err
 	}
 	if quote == nil {
-		return osmomath.BigDec{}, fmt.Errorf("no quote found when computing pricing for %s (base) -> %s (quote)", baseDenom, quoteDenom)
+		return osmomath.BigDec{}, nil, fmt.Errorf("no quote found when computing pricing for %s (base) -> %s (quote)", baseDenom, quoteDenom)
 	}
 
 	routes := quote.GetRoute()
 	if len(routes) == 0 {
-		return osmomath.BigDec{}, fmt.Errorf("no route found when computing pricing for %s (base) -> %s (quote)", baseDenom, quoteDenom)
+		return osmomath.BigDec{}, nil, fmt.Errorf("no route found when computing pricing for %s (base) -> %s (quote)", baseDenom, quoteDenom)
 	}
 
 	route := routes[0]
@@ -217,16 +263,69 @@ func (c *chainPricing) computePrice(ctx context.Context, baseDenom string, quote
 	// Only store values that are valid.
 	if !chainPrice.IsNil() {
 		expirationTTL := c.cacheExpiryNs
-		// We pre-compute the price for the default quote denom in ingest handler via the background
-		// pricing worker. As a result, we store them indefinitely.
+		// We pre-compute the price for the default and the configured quote denoms in ingest handler via the background
+		// pricing workers. As a result, we store them indefinitely.
 		// We track the tokens that are modified within the block and update the prices only for those tokens.
-		if quoteDenom == c.defaultQuoteDenom {
+		if _, ok := c.precomputedQuoteDenoms[quoteDenom]; ok {
 			expirationTTL = cache.NoExpirationTTL
 		}
 		c.cache.Set(cacheKey, chainPrice, expirationTTL)
 	}
 
-	return chainPrice, nil
+	if !isDetailed {
+		return chainPrice, nil, nil
+	}
+
+	confidence, err := c.computeConfidence(ctx, quote, isSpotPriceComputeMethod)
+	if err != nil {
+		return osmomath.BigDec{}, nil, err
+	}
+
+	return chainPrice, confidence, nil
+}
+
+// computeConfidence returns the confidence describing the route of the given quote
+// that the price was computed over.
+// Note that it mutates the quote.
+// CONTRACT: the quote has a single route.
+func (c *chainPricing) computeConfidence(ctx context.Context, quote domain.Quote, isSpotPriceComputeMethod bool) (*domain.PriceConfidence, error) {
+	pools := quote.GetRoute()[0].GetPools()
+
+	confidence := &domain.PriceConfidence{
+		Route:             make([]domain.PriceRoutePool, 0, len(pools)),
+		ReferenceAmountIn: quote.GetAmountIn(),
+		IsSpotPrice:       isSpotPriceComputeMethod,
+		Height:            domain.GetHeightFromContext(ctx),
+	}
+
+	for i, pool := range pools {
+		liquidityCap, err := c.RUsecase.GetPoolLiquidityCap(ctx, pool.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 || liquidityCap.LT(confidence.MinPoolLiquidityCap) {
+			confidence.MinPoolLiquidityCap = liquidityCap
+		}
+
+		confidence.Route = append(confidence.Route, domain.PriceRoutePool{
+			ID:            pool.GetId(),
+			TokenOutDenom: pool.GetTokenOutDenom(),
+		})
+	}
+
+	// Compute the price impact of the reference amount.
+	// The scaling factor only applies to the spot price of the quote and does not affect the price impact.
+	if _, _, err := quote.PrepareResult(ctx, osmomath.OneDec(), &log.NoOpLogger{}); err != nil {
+		return nil, err
+	}
+
+	confidence.PriceImpact = quote.GetPriceImpact()
+	if confidence.PriceImpact.IsNil() {
+		confidence.PriceImpact = osmomath.ZeroDec()
+	}
+
+	return confidence, nil
 }
 
 // InitializeCache implements domain.PricingSource.
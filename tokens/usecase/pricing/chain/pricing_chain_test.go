@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
 	"github.com/osmosis-labs/sqs/router/usecase/routertesting"
 	"github.com/osmosis-labs/sqs/tokens/usecase/pricing"
+	chainpricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/chain"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -107,3 +112,162 @@ func (s *PricingTestSuite) TestComputePrice_QuoteBasedMethod() {
 	// 0.1 additive tolerance.
 	osmoassert.DecApproxEq(s.T(), priceQuoteBasedMethod.Dec(), priceSpotPriceMethod.Dec(), osmomath.MustNewDecFromStr("0.1"))
 }
+
+// This test validates that the price details report the chain source and the confidence describing
+// the route the price was computed over, and that the price matches the recomputed one.
+// The router and tokens usecases are mocked so that the test does not depend on the mainnet state files.
+func (s *PricingTestSuite) TestGetPriceDetails_Chain() {
+	const (
+		baseDenom  = "uatom"
+		quoteDenom = "uusdc"
+	)
+
+	referenceAmountIn := sdk.NewCoin(quoteDenom, osmomath.NewInt(10_000_000))
+
+	quote := &mocks.MockQuote{
+		GetAmountInFunc: func() sdk.Coin { return referenceAmountIn },
+		GetRouteFunc: func() []domain.SplitRoute {
+			return []domain.SplitRoute{&mocks.RouteMock{
+				GetPoolsFunc: func() []domain.RoutablePool {
+					return []domain.RoutablePool{
+						&mocks.MockRoutablePool{ID: 1, TokenOutDenom: baseDenom},
+					}
+				},
+			}}
+		},
+		GetPriceImpactFunc: func() osmomath.Dec { return osmomath.ZeroDec() },
+		PrepareResultFunc: func(ctx context.Context, scalingFactor osmomath.Dec, logger log.Logger) ([]domain.SplitRoute, osmomath.Dec, error) {
+			return nil, osmomath.ZeroDec(), nil
+		},
+	}
+
+	routerUsecase := &mocks.RouterUsecaseMock{
+		GetSimpleQuoteFunc: func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
+			return quote, nil
+		},
+		GetPoolSpotPriceFunc: func(ctx context.Context, poolID uint64, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
+			return osmomath.MustNewBigDecFromStr("11.5"), nil
+		},
+		GetPoolLiquidityCapFunc: func(ctx context.Context, poolID uint64) (osmomath.Int, error) {
+			return osmomath.NewInt(1_000), nil
+		},
+	}
+
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetChainDenomFunc: func(humanDenom string) (string, error) {
+			return quoteDenom, nil
+		},
+		GetChainScalingFactorByDenomMutFunc: func(denom string) (osmomath.Dec, error) {
+			return osmomath.NewDec(1_000_000), nil
+		},
+	}
+
+	pricingStrategy := chainpricing.New(routerUsecase, tokensUsecase, defaultPricingConfig)
+
+	detailedPricingStrategy, ok := pricingStrategy.(domain.DetailedPricingSource)
+	s.Require().True(ok)
+
+	// System under test.
+	priceDetails, err := detailedPricingStrategy.GetPriceDetails(context.Background(), baseDenom, quoteDenom)
+	s.Require().NoError(err)
+
+	expectedPrice, err := pricingStrategy.GetPrice(context.Background(), baseDenom, quoteDenom, domain.WithRecomputePrices())
+	s.Require().NoError(err)
+
+	s.Require().Equal(expectedPrice, priceDetails.Price)
+	s.Require().Equal("chain", priceDetails.Source)
+
+	confidence := priceDetails.Confidence
+	s.Require().NotNil(confidence)
+	s.Require().Equal([]domain.PriceRoutePool{{ID: 1, TokenOutDenom: baseDenom}}, confidence.Route)
+	s.Require().Equal(osmomath.NewInt(1_000), confidence.MinPoolLiquidityCap)
+	s.Require().Equal(referenceAmountIn, confidence.ReferenceAmountIn)
+	s.Require().True(confidence.IsSpotPrice)
+
+	// Equal base and quote have no route.
+	priceDetails, err = detailedPricingStrategy.GetPriceDetails(context.Background(), quoteDenom, quoteDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneBigDec(), priceDetails.Price)
+	s.Require().Nil(priceDetails.Confidence)
+}
+
+// This test validates that the confidence reports the route of the quote, the least liquid pool along it
+// and the price impact of the reference amount using mocked router and tokens usecases.
+func (s *PricingTestSuite) TestGetPriceDetails_Confidence() {
+	const (
+		baseDenom  = "uatom"
+		quoteDenom = "uusdc"
+	)
+
+	var (
+		referenceAmountIn = sdk.NewCoin(quoteDenom, osmomath.NewInt(10_000_000))
+		priceImpact       = osmomath.MustNewDecFromStr("-0.01")
+
+		spotPrices = map[uint64]osmomath.BigDec{
+			1: osmomath.NewBigDec(2),
+			2: osmomath.NewBigDec(3),
+		}
+		liquidityCaps = map[uint64]osmomath.Int{
+			1: osmomath.NewInt(1_000),
+			2: osmomath.NewInt(500),
+		}
+	)
+
+	quote := &mocks.MockQuote{
+		GetAmountInFunc: func() sdk.Coin { return referenceAmountIn },
+		GetRouteFunc: func() []domain.SplitRoute {
+			return []domain.SplitRoute{&mocks.RouteMock{
+				GetPoolsFunc: func() []domain.RoutablePool {
+					return []domain.RoutablePool{
+						&mocks.MockRoutablePool{ID: 1, TokenOutDenom: "uosmo"},
+						&mocks.MockRoutablePool{ID: 2, TokenOutDenom: baseDenom},
+					}
+				},
+			}}
+		},
+		GetPriceImpactFunc: func() osmomath.Dec { return priceImpact },
+		PrepareResultFunc: func(ctx context.Context, scalingFactor osmomath.Dec, logger log.Logger) ([]domain.SplitRoute, osmomath.Dec, error) {
+			return nil, osmomath.ZeroDec(), nil
+		},
+	}
+
+	routerUsecase := &mocks.RouterUsecaseMock{
+		GetSimpleQuoteFunc: func(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, opts ...domain.RouterOption) (domain.Quote, error) {
+			return quote, nil
+		},
+		GetPoolSpotPriceFunc: func(ctx context.Context, poolID uint64, quoteAsset, baseAsset string) (osmomath.BigDec, error) {
+			return spotPrices[poolID], nil
+		},
+		GetPoolLiquidityCapFunc: func(ctx context.Context, poolID uint64) (osmomath.Int, error) {
+			return liquidityCaps[poolID], nil
+		},
+	}
+
+	tokensUsecase := &mocks.TokensUsecaseMock{
+		GetChainDenomFunc: func(humanDenom string) (string, error) {
+			return quoteDenom, nil
+		},
+		GetChainScalingFactorByDenomMutFunc: func(denom string) (osmomath.Dec, error) {
+			return osmomath.NewDec(1_000_000), nil
+		},
+	}
+
+	pricingStrategy, ok := chainpricing.New(routerUsecase, tokensUsecase, defaultPricingConfig).(domain.DetailedPricingSource)
+	s.Require().True(ok)
+
+	// System under test.
+	priceDetails, err := pricingStrategy.GetPriceDetails(context.Background(), baseDenom, quoteDenom)
+	s.Require().NoError(err)
+
+	s.Require().Equal(osmomath.NewBigDec(6).String(), priceDetails.Price.String())
+	s.Require().Equal(&domain.PriceConfidence{
+		Route: []domain.PriceRoutePool{
+			{ID: 1, TokenOutDenom: "uosmo"},
+			{ID: 2, TokenOutDenom: baseDenom},
+		},
+		MinPoolLiquidityCap: osmomath.NewInt(500),
+		ReferenceAmountIn:   referenceAmountIn,
+		PriceImpact:         priceImpact,
+		IsSpotPrice:         true,
+	}, priceDetails.Confidence)
+}
@@ -0,0 +1,269 @@
+package sharepricing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v27/x/gamm/types"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/cache"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// gammShareExponent is the precision of the GAMM share denoms.
+const gammShareExponent = 18
+
+// sharePricing is a pricing source for the pool share denoms that do not appear as swappable denoms.
+// GAMM shares are priced by dividing the liquidity capitalization of their pool by the total shares.
+// Alloyed transmuter shares are priced by dividing the liquidity capitalization of their constituent assets
+// by the alloyed supply, which is minted one to one with the normalized constituent assets.
+// Concentrated liquidity positions are priced by the value of the assets they hold.
+// The liquidity capitalization is computed in the default quote denom from the prices of the underlying pricing source.
+// The pools are read from the state snapshot pinned in the context, if any.
+type sharePricing struct {
+	poolsUsecase  mvc.PoolsUsecase
+	tokensUsecase mvc.TokensUsecase
+
+	liquidityPricer domain.LiquidityPricer
+	pricingSource   domain.PricingSource
+
+	defaultQuoteDenom string
+}
+
+var (
+	_ domain.PricingSource = &sharePricing{}
+
+	gammShareScalingFactor = osmomath.NewDecFromInt(osmomath.NewIntWithDecimal(1, gammShareExponent))
+)
+
+// New creates a new share pricing source pricing the liquidity of the pools with the given pricing source
+// and liquidity pricer in the given default quote denom.
+func New(poolsUsecase mvc.PoolsUsecase, tokensUsecase mvc.TokensUsecase, liquidityPricer domain.LiquidityPricer, pricingSource domain.PricingSource, defaultQuoteDenom string) *sharePricing {
+	return &sharePricing{
+		poolsUsecase:  poolsUsecase,
+		tokensUsecase: tokensUsecase,
+
+		liquidityPricer: liquidityPricer,
+		pricingSource:   pricingSource,
+
+		defaultQuoteDenom: defaultQuoteDenom,
+	}
+}
+
+// GetPrice implements domain.PricingSource.
+// The base denom must be a share denom as defined by domain.IsShareDenom.
+// The options are passed through to the underlying pricing source.
+// Returns error if any of the pool assets cannot be priced so that the shares are never underpriced.
+func (s *sharePricing) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	if baseDenom == quoteDenom {
+		return osmomath.OneBigDec(), nil
+	}
+
+	var (
+		price osmomath.BigDec
+		err   error
+	)
+	if strings.HasPrefix(baseDenom, domain.GAMMSharePrefix) {
+		price, err = s.getGAMMSharePrice(ctx, baseDenom, opts...)
+	} else if domain.IsAlloyedDenom(baseDenom) {
+		price, err = s.getAlloyedSharePrice(ctx, baseDenom, opts...)
+	} else if strings.HasPrefix(baseDenom, domain.ConcentratedPositionPrefix) {
+		price, err = s.getConcentratedPositionPrice(ctx, baseDenom, opts...)
+	} else {
+		return osmomath.BigDec{}, fmt.Errorf("denom (%s) is not a share denom", baseDenom)
+	}
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	if quoteDenom == s.defaultQuoteDenom {
+		return price, nil
+	}
+
+	// Convert from the default quote denom to the given one.
+	defaultQuotePrice, err := s.pricingSource.GetPrice(ctx, s.defaultQuoteDenom, quoteDenom, opts...)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return price.MulMut(defaultQuotePrice), nil
+}
+
+// InitializeCache implements domain.PricingSource.
+// The share prices are not cached since the underlying pricing source caches the asset prices.
+func (s *sharePricing) InitializeCache(cache *cache.Cache) {
+}
+
+// GetFallbackStrategy implements domain.PricingSource.
+func (s *sharePricing) GetFallbackStrategy(quoteDenom string) domain.PricingSourceType {
+	return domain.NoneSourceType
+}
+
+// getGAMMSharePrice returns the price of the given GAMM share denom in the default quote denom.
+// Returns error if the pool is not a CFMM pool.
+func (s *sharePricing) getGAMMSharePrice(ctx context.Context, shareDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	poolID, err := strconv.ParseUint(strings.TrimPrefix(shareDenom, domain.GAMMSharePrefix+"/"), 10, 64)
+	if err != nil {
+		return osmomath.BigDec{}, fmt.Errorf("invalid share denom (%s): %w", shareDenom, err)
+	}
+
+	pool, err := s.getPool(ctx, poolID)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	cfmmPool, ok := pool.GetUnderlyingPool().(gammtypes.CFMMPoolI)
+	if !ok {
+		return osmomath.BigDec{}, fmt.Errorf("pool (%d) of type (%s) has no shares", poolID, pool.GetType())
+	}
+
+	liquidityCap, err := s.getLiquidityCap(ctx, pool, opts...)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return computeSharePrice(liquidityCap, osmomath.BigDecFromSDKInt(cfmmPool.GetTotalShares()), gammShareScalingFactor)
+}
+
+// getAlloyedSharePrice returns the price of the given alloyed denom in the default quote denom.
+// The alloyed supply is computed from the constituent balances scaled by their normalization factors.
+// Returns error if no alloyed transmuter pool mints the alloyed denom.
+func (s *sharePricing) getAlloyedSharePrice(ctx context.Context, alloyedDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	poolID, err := s.poolsUsecase.GetAlloyedPoolID(alloyedDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	pool, err := s.getPool(ctx, poolID)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	cosmWasmModel := pool.GetSQSPoolModel().CosmWasmPoolModel
+	if cosmWasmModel == nil || cosmWasmModel.Data.AlloyTransmuter == nil {
+		return osmomath.BigDec{}, fmt.Errorf("pool (%d) is not an alloyed transmuter pool", poolID)
+	}
+	alloyTransmuter := cosmWasmModel.Data.AlloyTransmuter
+
+	normalizationFactors := make(map[string]osmomath.Int, len(alloyTransmuter.AssetConfigs))
+	for _, assetConfig := range alloyTransmuter.AssetConfigs {
+		normalizationFactors[assetConfig.Denom] = assetConfig.NormalizationFactor
+	}
+
+	alloyedNormalizationFactor, ok := normalizationFactors[alloyedDenom]
+	if !ok || !alloyedNormalizationFactor.IsPositive() {
+		return osmomath.BigDec{}, fmt.Errorf("normalization factor not found for alloyed denom (%s) in pool (%d)", alloyedDenom, pool.GetId())
+	}
+
+	// alloyed supply = sum(balance * alloyed normalization factor / asset normalization factor)
+	alloyedSupply := osmomath.ZeroBigDec()
+	for _, balance := range pool.GetSQSPoolModel().Balances {
+		if balance.Denom == alloyedDenom {
+			continue
+		}
+
+		normalizationFactor, ok := normalizationFactors[balance.Denom]
+		if !ok || !normalizationFactor.IsPositive() {
+			return osmomath.BigDec{}, fmt.Errorf("normalization factor not found for denom (%s) in pool (%d)", balance.Denom, pool.GetId())
+		}
+
+		alloyedSupply.AddMut(osmomath.BigDecFromSDKInt(balance.Amount).MulMut(osmomath.BigDecFromSDKInt(alloyedNormalizationFactor)).QuoMut(osmomath.BigDecFromSDKInt(normalizationFactor)))
+	}
+
+	alloyedScalingFactor, err := s.tokensUsecase.GetChainScalingFactorByDenomMut(alloyedDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	liquidityCap, err := s.getLiquidityCap(ctx, pool, opts...)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return computeSharePrice(liquidityCap, alloyedSupply, alloyedScalingFactor)
+}
+
+// getConcentratedPositionPrice returns the value of the given concentrated liquidity position in the default quote denom.
+// The value is that of the assets held by the position at the current price, excluding the claimable rewards.
+// Returns error if any of the assets cannot be priced.
+func (s *sharePricing) getConcentratedPositionPrice(ctx context.Context, positionDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	positionID, err := strconv.ParseUint(strings.TrimPrefix(positionDenom, domain.ConcentratedPositionPrefix+"/"), 10, 64)
+	if err != nil {
+		return osmomath.BigDec{}, fmt.Errorf("invalid position denom (%s): %w", positionDenom, err)
+	}
+
+	assets, err := s.poolsUsecase.GetConcentratedPositionAssets(ctx, positionID)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	value := osmomath.ZeroBigDec()
+	for _, asset := range assets {
+		price, err := s.pricingSource.GetPrice(ctx, asset.Denom, s.defaultQuoteDenom, opts...)
+		if err != nil {
+			return osmomath.BigDec{}, fmt.Errorf("failed to price denom (%s) of position (%d): %w", asset.Denom, positionID, err)
+		}
+
+		value.AddMut(osmomath.BigDecFromDec(s.liquidityPricer.PriceCoin(asset, price)))
+	}
+
+	return value, nil
+}
+
+// getPool returns the pool with the given ID from the state snapshot pinned in the context
+// or from the pools usecase if none is pinned.
+func (s *sharePricing) getPool(ctx context.Context, poolID uint64) (sqsdomain.PoolI, error) {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		return snapshot.GetPool(poolID)
+	}
+
+	return s.poolsUsecase.GetPool(poolID)
+}
+
+// getLiquidityCap returns the liquidity capitalization of the given pool balances in the default quote denom.
+// Returns error if any of the balances cannot be priced.
+func (s *sharePricing) getLiquidityCap(ctx context.Context, pool sqsdomain.PoolI, opts ...domain.PricingOption) (osmomath.Int, error) {
+	balances := pool.GetSQSPoolModel().Balances
+
+	constituentBalances := make(sdk.Coins, 0, len(balances))
+	prices := make(domain.PricesResult, len(balances))
+	for _, balance := range balances {
+		// The share denoms may be part of the pool balances but are not constituent assets.
+		if domain.IsShareDenom(balance.Denom) {
+			continue
+		}
+
+		price, err := s.pricingSource.GetPrice(ctx, balance.Denom, s.defaultQuoteDenom, opts...)
+		if err != nil {
+			return osmomath.Int{}, fmt.Errorf("failed to price denom (%s) of pool (%d): %w", balance.Denom, pool.GetId(), err)
+		}
+
+		constituentBalances = append(constituentBalances, balance)
+		prices[balance.Denom] = map[string]osmomath.BigDec{s.defaultQuoteDenom: price}
+	}
+
+	liquidityCap, liquidityCapErr := s.liquidityPricer.PriceBalances(constituentBalances, prices)
+	if liquidityCapErr != "" {
+		return osmomath.Int{}, fmt.Errorf("failed to price liquidity of pool (%d): %s", pool.GetId(), liquidityCapErr)
+	}
+
+	return liquidityCap, nil
+}
+
+// computeSharePrice returns the price of one share given the liquidity capitalization,
+// the total shares and the share scaling factor.
+// Returns error if the total shares are not positive.
+func computeSharePrice(liquidityCap osmomath.Int, totalShares osmomath.BigDec, shareScalingFactor osmomath.Dec) (osmomath.BigDec, error) {
+	if !totalShares.IsPositive() {
+		return osmomath.BigDec{}, fmt.Errorf("total shares are zero")
+	}
+
+	return osmomath.BigDecFromSDKInt(liquidityCap).MulMut(osmomath.BigDecFromDec(shareScalingFactor)).QuoMut(totalShares), nil
+}
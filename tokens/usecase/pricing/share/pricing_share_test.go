@@ -0,0 +1,267 @@
+package sharepricing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v27/x/gamm/pool-models/balancer"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/cache"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+	"github.com/osmosis-labs/sqs/sqsdomain/cosmwasmpool"
+	sharepricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/share"
+	"github.com/osmosis-labs/sqs/tokens/usecase/pricing/worker"
+)
+
+const (
+	UOSMO = "uosmo"
+	USDC  = "uusdc"
+	ATOM  = "uatom"
+	WBTC  = "wbtc"
+	NBTC  = "nbtc"
+
+	allBTC = "factory/osmo1contract/alloyed/allBTC"
+)
+
+// mockPricingSource is a pricing source returning the prices by base and quote denom
+// and one for equal base and quote denoms.
+type mockPricingSource struct {
+	prices map[string]map[string]string
+}
+
+var _ domain.PricingSource = &mockPricingSource{}
+
+// GetPrice implements domain.PricingSource.
+func (m *mockPricingSource) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	if baseDenom == quoteDenom {
+		return osmomath.OneBigDec(), nil
+	}
+
+	price, ok := m.prices[baseDenom][quoteDenom]
+	if !ok {
+		return osmomath.BigDec{}, fmt.Errorf("no price for base (%s) and quote (%s)", baseDenom, quoteDenom)
+	}
+	return osmomath.MustNewBigDecFromStr(price), nil
+}
+
+// InitializeCache implements domain.PricingSource.
+func (m *mockPricingSource) InitializeCache(cache *cache.Cache) {
+}
+
+// GetFallbackStrategy implements domain.PricingSource.
+func (m *mockPricingSource) GetFallbackStrategy(quoteDenom string) domain.PricingSourceType {
+	return domain.NoneSourceType
+}
+
+// TestGetPrice validates that the GAMM shares are priced from the pool liquidity cap divided by the total shares,
+// that the alloyed shares are priced from the constituent liquidity cap divided by the normalized alloyed supply
+// and that the concentrated liquidity positions are priced from the value of their assets.
+func TestGetPrice(t *testing.T) {
+	var (
+		scalingFactors = map[string]osmomath.Dec{
+			UOSMO:  osmomath.NewDec(1_000_000),
+			USDC:   osmomath.NewDec(1_000_000),
+			WBTC:   osmomath.NewDec(100_000_000),
+			NBTC:   osmomath.NewDec(1_000_000),
+			allBTC: osmomath.NewDec(100_000_000),
+		}
+
+		defaultPrices = map[string]map[string]string{
+			UOSMO: {USDC: "0.5"},
+			USDC:  {ATOM: "0.2"},
+			WBTC:  {USDC: "60000"},
+			NBTC:  {USDC: "58000"},
+		}
+
+		// 2 OSMO and 1 USDC for 4 shares.
+		gammPool = &mocks.MockRoutablePool{
+			ID: 1,
+			ChainPoolModel: &balancer.Pool{
+				Id:          1,
+				TotalShares: sdk.NewCoin("gamm/pool/1", osmomath.NewIntWithDecimal(4, 18)),
+			},
+			Balances: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(2_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))),
+		}
+
+		// 1 WBTC and 1 NBTC for 2 allBTC.
+		alloyedPool = &mocks.MockRoutablePool{
+			ID: 2,
+			CosmWasmPoolModel: cosmwasmpool.NewCWPoolModel(cosmwasmpool.ALLOY_TRANSMUTER_CONTRACT_NAME, cosmwasmpool.ALLOY_TRANSMUTER_MIN_CONTRACT_VERSION, cosmwasmpool.CosmWasmPoolData{
+				AlloyTransmuter: &cosmwasmpool.AlloyTransmuterData{
+					AlloyedDenom: allBTC,
+					AssetConfigs: []cosmwasmpool.TransmuterAssetConfig{
+						{Denom: WBTC, NormalizationFactor: osmomath.NewInt(100_000_000)},
+						{Denom: NBTC, NormalizationFactor: osmomath.NewInt(1_000_000)},
+						{Denom: allBTC, NormalizationFactor: osmomath.NewInt(100_000_000)},
+					},
+				},
+			}),
+			Balances: sdk.NewCoins(sdk.NewCoin(WBTC, osmomath.NewInt(100_000_000)), sdk.NewCoin(NBTC, osmomath.NewInt(1_000_000))),
+		}
+
+		// Concentrated pools have no shares.
+		concentratedPool = &mocks.MockRoutablePool{
+			ID:       3,
+			Balances: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(2_000_000))),
+		}
+
+		// 3 OSMO and 0.5 USDC held by position 1.
+		positionAssets = map[uint64]sdk.Coins{
+			1: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(3_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(500_000))),
+		}
+	)
+
+	tests := []struct {
+		name       string
+		prices     map[string]map[string]string
+		baseDenom  string
+		quoteDenom string
+
+		expectedPrice osmomath.BigDec
+		expectErr     bool
+	}{
+		{
+			name:       "gamm share in default quote",
+			prices:     defaultPrices,
+			baseDenom:  "gamm/pool/1",
+			quoteDenom: USDC,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("0.5"),
+		},
+		{
+			name:       "gamm share in other quote",
+			prices:     defaultPrices,
+			baseDenom:  "gamm/pool/1",
+			quoteDenom: ATOM,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("0.1"),
+		},
+		{
+			name:       "alloyed share",
+			prices:     defaultPrices,
+			baseDenom:  allBTC,
+			quoteDenom: USDC,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("59000"),
+		},
+		{
+			name: "pool asset without price",
+			prices: map[string]map[string]string{
+				WBTC: {USDC: "60000"},
+			},
+			baseDenom:  allBTC,
+			quoteDenom: USDC,
+
+			expectErr: true,
+		},
+		{
+			name:       "pool without shares",
+			prices:     defaultPrices,
+			baseDenom:  "gamm/pool/3",
+			quoteDenom: USDC,
+
+			expectErr: true,
+		},
+		{
+			name:       "unknown alloyed denom",
+			prices:     defaultPrices,
+			baseDenom:  "factory/osmo1other/alloyed/allETH",
+			quoteDenom: USDC,
+
+			expectErr: true,
+		},
+		{
+			name:       "concentrated position",
+			prices:     defaultPrices,
+			baseDenom:  "cl/position/1",
+			quoteDenom: USDC,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("2"),
+		},
+		{
+			name:       "concentrated position in other quote",
+			prices:     defaultPrices,
+			baseDenom:  "cl/position/1",
+			quoteDenom: ATOM,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("0.4"),
+		},
+		{
+			name:       "unknown concentrated position",
+			prices:     defaultPrices,
+			baseDenom:  "cl/position/2",
+			quoteDenom: USDC,
+
+			expectErr: true,
+		},
+		{
+			name:       "not a share denom",
+			prices:     defaultPrices,
+			baseDenom:  UOSMO,
+			quoteDenom: USDC,
+
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pools := []sqsdomain.PoolI{gammPool, alloyedPool, concentratedPool}
+
+			poolsUsecase := &mocks.PoolsUsecaseMock{
+				Pools: pools,
+				GetPoolFunc: func(poolID uint64) (sqsdomain.PoolI, error) {
+					for _, pool := range pools {
+						if pool.GetId() == poolID {
+							return pool, nil
+						}
+					}
+					return nil, fmt.Errorf("pool (%d) not found", poolID)
+				},
+				GetAlloyedPoolIDFunc: func(alloyedDenom string) (uint64, error) {
+					if alloyedDenom != allBTC {
+						return 0, fmt.Errorf("no alloyed transmuter pool found for denom (%s)", alloyedDenom)
+					}
+					return alloyedPool.ID, nil
+				},
+				GetConcentratedPositionAssetsFunc: func(ctx context.Context, positionID uint64) (sdk.Coins, error) {
+					assets, ok := positionAssets[positionID]
+					if !ok {
+						return nil, fmt.Errorf("position (%d) not found", positionID)
+					}
+					return assets, nil
+				},
+			}
+
+			getScalingFactor := func(denom string) (osmomath.Dec, error) {
+				scalingFactor, ok := scalingFactors[denom]
+				if !ok {
+					return osmomath.Dec{}, fmt.Errorf("no scaling factor for denom (%s)", denom)
+				}
+				return scalingFactor, nil
+			}
+
+			tokensUsecase := &mocks.TokensUsecaseMock{
+				GetChainScalingFactorByDenomMutFunc: getScalingFactor,
+			}
+
+			sharePricing := sharepricing.New(poolsUsecase, tokensUsecase, worker.NewLiquidityPricer(USDC, getScalingFactor), &mockPricingSource{prices: tc.prices}, USDC)
+
+			price, err := sharePricing.GetPrice(context.Background(), tc.baseDenom, tc.quoteDenom)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), price.String())
+		})
+	}
+}
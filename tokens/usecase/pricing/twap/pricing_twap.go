@@ -0,0 +1,108 @@
+package twappricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/cache"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
+)
+
+// twapPricing is a pricing source that returns the time-weighted average of the chain prices
+// computed by the pricing worker at the end of the ingested blocks, using the block as the unit of time.
+// Since a single block may only move the price for one block out of the window, the prices
+// are resistant to the manipulation of thin pools.
+type twapPricing struct {
+	windowBlocks uint64
+
+	// observations are the recorded chain prices.
+	observations *priceobservation.Store
+}
+
+var (
+	_ domain.PricingSource = &twapPricing{}
+
+	// ErrInsufficientPriceHistory is returned when the recorded prices do not cover the whole window.
+	ErrInsufficientPriceHistory = errors.New("recorded prices do not cover the twap window")
+)
+
+// New creates a new TWAP pricing source averaging the prices recorded in the given store over the given number of recent blocks.
+// CONTRACT: windowBlocks is positive and the store retains the observations covering the window.
+func New(windowBlocks uint64, observations *priceobservation.Store) *twapPricing {
+	return &twapPricing{
+		windowBlocks: windowBlocks,
+		observations: observations,
+	}
+}
+
+// GetPrice implements domain.PricingSource.
+// It returns the average of the prices recorded over the window ending at the latest height with recorded prices in terms of the quote denom,
+// where each price is weighted by the number of blocks it is in effect for.
+// The prices of denoms not updated within a block remain in effect from their latest update.
+// Returns ErrInsufficientPriceHistory if the recorded prices do not cover the whole window, for example right after startup.
+// The pricing options are ignored since the prices are always computed from the recorded ones.
+func (t *twapPricing) GetPrice(ctx context.Context, baseDenom string, quoteDenom string, opts ...domain.PricingOption) (osmomath.BigDec, error) {
+	if baseDenom == quoteDenom {
+		return osmomath.OneBigDec(), nil
+	}
+
+	observations, latestHeight := t.observations.GetObservations(quoteDenom, baseDenom)
+	items := observations[0]
+
+	if len(items) == 0 {
+		return osmomath.BigDec{}, fmt.Errorf("no prices recorded for base (%s) and quote (%s)", baseDenom, quoteDenom)
+	}
+
+	return computeTWAP(items, latestHeight, t.windowBlocks)
+}
+
+// InitializeCache implements domain.PricingSource.
+// TWAP prices are computed from the recorded prices on every call and are not cached.
+func (t *twapPricing) InitializeCache(cache *cache.Cache) {
+}
+
+// GetFallbackStrategy implements domain.PricingSource.
+// There is no fallback since falling back to the spot prices would defeat the manipulation resistance.
+func (t *twapPricing) GetFallbackStrategy(quoteDenom string) domain.PricingSourceType {
+	return domain.NoneSourceType
+}
+
+// computeTWAP returns the average of the given observations over the window of the given number of blocks
+// ending at the given height, where each price is weighted by the number of blocks it is in effect for.
+// Returns ErrInsufficientPriceHistory if the first observation is after the window start.
+// CONTRACT: observations are non-empty, ordered by height and not after the window end.
+func computeTWAP(observations []priceobservation.Observation, windowEndHeight uint64, windowBlocks uint64) (osmomath.BigDec, error) {
+	if windowEndHeight < windowBlocks {
+		return osmomath.BigDec{}, ErrInsufficientPriceHistory
+	}
+
+	windowStartHeight := windowEndHeight - windowBlocks + 1
+	if observations[0].Height > windowStartHeight {
+		return osmomath.BigDec{}, ErrInsufficientPriceHistory
+	}
+
+	weightedSum := osmomath.ZeroBigDec()
+	for i, observation := range observations {
+		endHeight := windowEndHeight + 1
+		if i+1 < len(observations) {
+			endHeight = observations[i+1].Height
+		}
+
+		startHeight := observation.Height
+		if startHeight < windowStartHeight {
+			startHeight = windowStartHeight
+		}
+
+		if endHeight <= startHeight {
+			continue
+		}
+
+		weightedSum.AddMut(observation.Price.MulInt64(int64(endHeight - startHeight)))
+	}
+
+	return weightedSum.QuoInt64(int64(windowBlocks)), nil
+}
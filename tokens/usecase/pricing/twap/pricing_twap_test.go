@@ -0,0 +1,143 @@
+package twappricing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/priceobservation"
+	twappricing "github.com/osmosis-labs/sqs/tokens/usecase/pricing/twap"
+)
+
+const (
+	baseDenom  = "uosmo"
+	otherDenom = "uatom"
+	quoteDenom = "usdc"
+)
+
+// priceUpdate is the price of the denom computed at the end of the block with the given height.
+type priceUpdate struct {
+	height uint64
+	denom  string
+	price  string
+}
+
+// TestGetPrice validates that the recorded prices are weighted by the number of blocks they are in effect for,
+// that zero and out of order prices are skipped and that the prices are only returned once the window is covered.
+func TestGetPrice(t *testing.T) {
+	tests := []struct {
+		name         string
+		windowBlocks uint64
+		updates      []priceUpdate
+		baseDenom    string
+
+		expectedPrice osmomath.BigDec
+		expectErr     bool
+		// expectedErr is the error expected to be wrapped, if any.
+		expectedErr error
+	}{
+		{
+			name:         "price carried over until the next update",
+			windowBlocks: 4,
+			updates: []priceUpdate{
+				{height: 10, denom: baseDenom, price: "2"},
+				{height: 12, denom: baseDenom, price: "4"},
+				// Zero price is skipped.
+				{height: 13, denom: baseDenom, price: "0"},
+				// Out of order height is skipped.
+				{height: 11, denom: baseDenom, price: "100"},
+				// The window ends at the latest height of any denom.
+				{height: 14, denom: otherDenom, price: "1"},
+			},
+			baseDenom: baseDenom,
+
+			// Height 11 at 2, heights 12 to 14 at 4.
+			expectedPrice: osmomath.MustNewBigDecFromStr("3.5"),
+		},
+		{
+			name:         "single block manipulation is dampened",
+			windowBlocks: 10,
+			updates: []priceUpdate{
+				{height: 10, denom: baseDenom, price: "1"},
+				{height: 18, denom: baseDenom, price: "100"},
+				{height: 19, denom: baseDenom, price: "1"},
+				{height: 20, denom: baseDenom, price: "1"},
+			},
+			baseDenom: baseDenom,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("10.9"),
+		},
+		{
+			name:         "oldest updates overwritten",
+			windowBlocks: 2,
+			updates: []priceUpdate{
+				{height: 1, denom: baseDenom, price: "1"},
+				{height: 2, denom: baseDenom, price: "2"},
+				{height: 3, denom: baseDenom, price: "3"},
+				{height: 4, denom: baseDenom, price: "4"},
+			},
+			baseDenom: baseDenom,
+
+			expectedPrice: osmomath.MustNewBigDecFromStr("3.5"),
+		},
+		{
+			name:         "window not covered",
+			windowBlocks: 4,
+			updates: []priceUpdate{
+				{height: 10, denom: baseDenom, price: "2"},
+				{height: 12, denom: baseDenom, price: "4"},
+			},
+			baseDenom: baseDenom,
+
+			expectErr:   true,
+			expectedErr: twappricing.ErrInsufficientPriceHistory,
+		},
+		{
+			name:         "no prices recorded",
+			windowBlocks: 4,
+			updates: []priceUpdate{
+				{height: 10, denom: otherDenom, price: "2"},
+			},
+			baseDenom: baseDenom,
+
+			expectErr: true,
+		},
+		{
+			name:         "base is quote",
+			windowBlocks: 4,
+			baseDenom:    quoteDenom,
+
+			expectedPrice: osmomath.OneBigDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := priceobservation.New(tc.windowBlocks)
+			twapPricing := twappricing.New(tc.windowBlocks, observations)
+
+			for _, update := range tc.updates {
+				prices := domain.PricesResult{
+					update.denom: {quoteDenom: osmomath.MustNewBigDecFromStr(update.price)},
+				}
+				require.NoError(t, observations.OnPricingUpdate(context.Background(), update.height, domain.BlockPoolMetadata{}, prices, quoteDenom))
+			}
+
+			price, err := twapPricing.GetPrice(context.Background(), tc.baseDenom, quoteDenom)
+			if tc.expectErr {
+				require.Error(t, err)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), price.String())
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/log"
+)
+
+var _ domain.PricingUpdateListener = &quotePoolDenomMetadataWorker{}
+
+// quotePoolDenomMetadataWorker reprices the pool denom metadata in a quote denom other than the default one.
+// Contrary to the pool liquidity pricer worker, it does not reprice the pool liquidity capitalization
+// that is always computed in the default quote denom.
+type quotePoolDenomMetadataWorker struct {
+	tokenPoolLiquidityHandler mvc.TokensPoolLiquidityHandler
+
+	// Reprices the denoms metadata, tracking the latest update height per denom
+	// for the quote denom of this worker.
+	denomsMetadataPricer *poolLiquidityPricerWorker
+}
+
+func NewQuotePoolDenomMetadataWorker(tokensPoolLiquidityHandler mvc.TokensPoolLiquidityHandler, liquidityPricer domain.LiquidityPricer, logger log.Logger) *quotePoolDenomMetadataWorker {
+	return &quotePoolDenomMetadataWorker{
+		tokenPoolLiquidityHandler: tokensPoolLiquidityHandler,
+
+		denomsMetadataPricer: NewPoolLiquidityWorker(tokensPoolLiquidityHandler, nil, liquidityPricer, logger),
+	}
+}
+
+// OnPricingUpdate implements domain.PricingUpdateListener.
+func (q *quotePoolDenomMetadataWorker) OnPricingUpdate(ctx context.Context, height uint64, blockPoolMetadata domain.BlockPoolMetadata, baseDenomPriceUpdates domain.PricesResult, quoteDenom string) error {
+	repricedTokenMetadata := q.denomsMetadataPricer.RepriceDenomsMetadata(height, baseDenomPriceUpdates, quoteDenom, blockPoolMetadata)
+
+	q.tokenPoolLiquidityHandler.UpdateQuotePoolDenomMetadata(quoteDenom, repricedTokenMetadata)
+
+	return nil
+}
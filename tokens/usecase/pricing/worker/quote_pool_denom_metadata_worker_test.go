@@ -0,0 +1,52 @@
+package worker_test
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/log"
+	"github.com/osmosis-labs/sqs/tokens/usecase/pricing/worker"
+)
+
+// This is a test validating that the quote pool denom metadata worker stores the pool denom metadata
+// priced in its quote denom without overwriting the default quote pool denom metadata.
+// Additionally, it validates that an update for an earlier height is skipped.
+func (s *PoolLiquidityComputeWorkerSuite) TestQuotePoolDenomMetadataWorker_OnPricingUpdate() {
+	atomPrice := osmomath.NewBigDec(4)
+
+	liquidityPricer := worker.NewLiquidityPricer(USDC, mocks.SetupMockScalingFactorCbFromMap(defaultScalingFactorMap))
+
+	poolLiquidityHandlerMock := mocks.TokensPoolLiquidityHandlerMock{
+		DenomScalingFactorMap: defaultScalingFactorMap,
+	}
+
+	quotePoolDenomMetadataWorker := worker.NewQuotePoolDenomMetadataWorker(&poolLiquidityHandlerMock, liquidityPricer, &log.NoOpLogger{})
+
+	blockPriceUpdates := domain.PricesResult{
+		UOSMO: {
+			ATOM: atomPrice,
+		},
+	}
+
+	err := quotePoolDenomMetadataWorker.OnPricingUpdate(context.TODO(), defaultUpdateHeight, defaultBlockPoolMetaData, blockPriceUpdates, ATOM)
+	s.Require().NoError(err)
+
+	expectedQuotePoolDenomMetadata := domain.PoolDenomMetaDataMap{
+		UOSMO: domain.PoolDenomMetaData{
+			Price:             atomPrice,
+			TotalLiquidity:    defaultLiquidity,
+			TotalLiquidityCap: defaultLiquidity.ToLegacyDec().Quo(defaultScalingFactor).MulMut(atomPrice.Dec()).TruncateInt(),
+		},
+	}
+
+	s.Require().Equal(expectedQuotePoolDenomMetadata, poolLiquidityHandlerMock.QuotePoolDenomMetadataMap[ATOM])
+	s.Require().Nil(poolLiquidityHandlerMock.PoolDenomMetadataMap)
+
+	// An update for an earlier height is skipped.
+	err = quotePoolDenomMetadataWorker.OnPricingUpdate(context.TODO(), defaultUpdateHeight-1, defaultBlockPoolMetaData, domain.PricesResult{UOSMO: {ATOM: defaultPrice}}, ATOM)
+	s.Require().NoError(err)
+
+	s.Require().Empty(poolLiquidityHandlerMock.QuotePoolDenomMetadataMap[ATOM])
+}
@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	"github.com/osmosis-labs/sqs/domain/pipeline"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+// priceChangeWindowSeconds is the window over which the price change of the token markets is computed.
+const priceChangeWindowSeconds = 24 * 60 * 60
+
+// getTokenMarketsSortFuncs is a map of available sort functions for the token markets.
+var getTokenMarketsSortFuncs = map[string]func(a, b domain.TokenMarket, desc bool) bool{
+	"denom": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return a.Denom > b.Denom
+		}
+		return a.Denom < b.Denom
+	},
+	"symbol": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return strings.ToLower(a.Symbol) > strings.ToLower(b.Symbol)
+		}
+		return strings.ToLower(a.Symbol) < strings.ToLower(b.Symbol)
+	},
+	"name": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return strings.ToLower(a.Name) > strings.ToLower(b.Name)
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	},
+	"price": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return a.Price.GT(b.Price)
+		}
+		return a.Price.LT(b.Price)
+	},
+	"liquidityCap": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return a.LiquidityCap.GT(b.LiquidityCap)
+		}
+		return a.LiquidityCap.LT(b.LiquidityCap)
+	},
+	"numPools": func(a, b domain.TokenMarket, desc bool) bool {
+		if desc {
+			return a.NumPools > b.NumPools
+		}
+		return a.NumPools < b.NumPools
+	},
+	// The tokens without price change are always last.
+	"priceChange24h": func(a, b domain.TokenMarket, desc bool) bool {
+		if a.PriceChange24h == nil || b.PriceChange24h == nil {
+			return a.PriceChange24h != nil && b.PriceChange24h == nil
+		}
+		if desc {
+			return a.PriceChange24h.GT(*b.PriceChange24h)
+		}
+		return a.PriceChange24h.LT(*b.PriceChange24h)
+	},
+}
+
+// SetTokenMarketsSources implements mvc.TokensUsecase.
+func (t *tokensUseCase) SetTokenMarketsSources(poolsUsecase mvc.PoolsUsecase, priceHistoryUsecase mvc.PriceHistoryUsecase, defaultQuoteDenom string) {
+	t.poolsUsecase = poolsUsecase
+	t.priceHistoryUsecase = priceHistoryUsecase
+	t.defaultQuoteChainDenom = defaultQuoteDenom
+}
+
+// GetTokenMarkets implements mvc.TokensUsecase.
+func (t *tokensUseCase) GetTokenMarkets(ctx context.Context, opts ...domain.TokenMarketsOption) ([]domain.TokenMarket, uint64, error) {
+	var options domain.TokenMarketsOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Validate the sort fields before computing the markets.
+	if sort := options.Sort; sort != nil {
+		for _, v := range sort.Fields {
+			if _, ok := getTokenMarketsSortFuncs[v.Field]; !ok {
+				return nil, 0, domain.UnsupportedTokenMarketsSortFieldError{Field: v.Field}
+			}
+		}
+	}
+
+	markets, err := t.getTokenMarkets(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	marketsMap := &sync.Map{}
+	for denom, market := range markets {
+		marketsMap.Store(denom, market)
+	}
+
+	transformer := pipeline.NewSyncMapTransformer[string, domain.TokenMarket](marketsMap)
+
+	var sortopts []func(domain.TokenMarket, domain.TokenMarket) bool
+
+	// Filter by search, ranking the best matches first unless other sort fields are given.
+	if search := strings.ToLower(options.Search); search != "" {
+		searchScores := make(map[string]int, len(markets))
+		for denom, market := range markets {
+			if score := getSearchScore(search, market); score > 0 {
+				searchScores[denom] = score
+			}
+		}
+
+		transformer.Filter(func(market domain.TokenMarket) bool {
+			return searchScores[market.Denom] > 0
+		})
+
+		if options.Sort == nil {
+			sortopts = append(sortopts, func(a, b domain.TokenMarket) bool {
+				return searchScores[a.Denom] > searchScores[b.Denom]
+			})
+		}
+	}
+
+	// Sorting options for market results
+	if sort := options.Sort; sort != nil {
+		for _, v := range sort.Fields {
+			sortFunc := getTokenMarketsSortFuncs[v.Field]
+
+			// Pass direction as a parameter to avoid duplication
+			desc := v.Direction == v1beta1.SortDirection_DESCENDING
+			sortopts = append(sortopts, func(a, b domain.TokenMarket) bool {
+				return sortFunc(a, b, desc)
+			})
+		}
+	} else {
+		sortopts = append(sortopts, func(a, b domain.TokenMarket) bool {
+			return getTokenMarketsSortFuncs["liquidityCap"](a, b, true)
+		})
+	}
+
+	// Break the ties by chain denom so that the order and, as a result, the cursor pagination is stable across requests.
+	sortopts = append(sortopts, func(a, b domain.TokenMarket) bool {
+		return getTokenMarketsSortFuncs["denom"](a, b, false)
+	})
+	transformer.Sort(sortopts...) // apply sort options
+
+	var data []domain.TokenMarket
+	if pagination := options.Pagination; pagination == nil {
+		data = transformer.Data()
+	} else {
+		iterator := pipeline.NewSyncMapIterator[string, domain.TokenMarket](marketsMap, transformer.Keys())
+		paginator := pipeline.NewPaginator[string](iterator, pagination)
+		data = paginator.GetPage()
+	}
+
+	return data, transformer.Count(), nil
+}
+
+// getTokenMarkets returns the market stats of all tokens by chain denom.
+// The pools and the pool denom metadata are read from the state snapshot pinned in the context, if any.
+func (t *tokensUseCase) getTokenMarkets(ctx context.Context) (map[string]domain.TokenMarket, error) {
+	tokensMetadata, err := t.GetFullTokenMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := t.getAllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numPoolsByDenom := make(map[string]int)
+	for _, pool := range pools {
+		for _, denom := range pool.GetPoolDenoms() {
+			numPoolsByDenom[denom]++
+		}
+	}
+
+	chainDenoms := make([]string, 0, len(tokensMetadata))
+	for denom := range tokensMetadata {
+		chainDenoms = append(chainDenoms, denom)
+	}
+
+	poolDenomsMetadata := t.GetPoolDenomsMetadata(ctx, chainDenoms)
+
+	from := time.Now().Unix() - priceChangeWindowSeconds
+
+	markets := make(map[string]domain.TokenMarket, len(tokensMetadata))
+	for denom, token := range tokensMetadata {
+		market := domain.TokenMarket{
+			Denom:        denom,
+			Symbol:       token.HumanDenom,
+			Name:         token.Name,
+			Decimals:     token.Precision,
+			Price:        osmomath.ZeroBigDec(),
+			LiquidityCap: osmomath.ZeroInt(),
+			NumPools:     numPoolsByDenom[denom],
+			IsUnlisted:   token.IsUnlisted,
+			IsVerified:   !token.IsUnverified,
+		}
+
+		if poolDenomMetadata, ok := poolDenomsMetadata[denom]; ok {
+			if !poolDenomMetadata.Price.IsNil() {
+				market.Price = poolDenomMetadata.Price
+			}
+			if !poolDenomMetadata.TotalLiquidityCap.IsNil() {
+				market.LiquidityCap = poolDenomMetadata.TotalLiquidityCap
+			}
+		}
+
+		if t.priceHistoryUsecase != nil {
+			candles := t.priceHistoryUsecase.GetPriceCandles(denom, t.defaultQuoteChainDenom, domain.PriceCandleResolutionHour, from, 0)
+			market.PriceChange24h = computePriceChange(candles)
+		}
+
+		markets[denom] = market
+	}
+
+	return markets, nil
+}
+
+// getAllPools returns all pools from the state snapshot pinned in the context or, if none is pinned, the latest pools.
+func (t *tokensUseCase) getAllPools(ctx context.Context) ([]sqsdomain.PoolI, error) {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		return snapshot.GetAllPools(), nil
+	}
+	if t.poolsUsecase == nil {
+		return nil, fmt.Errorf("token markets sources are not set")
+	}
+	return t.poolsUsecase.GetAllPools()
+}
+
+// computePriceChange returns the relative change from the open price of the oldest candle
+// to the close price of the newest candle.
+// Returns nil if there are no candles or the open price is zero.
+func computePriceChange(candles []domain.PriceCandle) *osmomath.BigDec {
+	if len(candles) == 0 {
+		return nil
+	}
+
+	open := candles[0].Open
+	if open.IsNil() || open.IsZero() {
+		return nil
+	}
+
+	priceChange := candles[len(candles)-1].Close.Sub(open).QuoMut(open)
+	return &priceChange
+}
+
+// getSearchScore returns how well the given market matches the given lower case search, zero if it does not match.
+// In decreasing order of score: exact symbol or denom match, symbol prefix, symbol substring, name substring
+// and, finally, the search characters appearing in order in the symbol or name.
+func getSearchScore(search string, market domain.TokenMarket) int {
+	symbol := strings.ToLower(market.Symbol)
+	name := strings.ToLower(market.Name)
+
+	switch {
+	case symbol == search || strings.ToLower(market.Denom) == search:
+		return 5
+	case strings.HasPrefix(symbol, search):
+		return 4
+	case strings.Contains(symbol, search):
+		return 3
+	case strings.Contains(name, search):
+		return 2
+	case isSubsequence(search, symbol) || isSubsequence(search, name):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// isSubsequence returns true if the characters of s appear in t in the same order.
+func isSubsequence(s, t string) bool {
+	i := 0
+	for j := 0; i < len(s) && j < len(t); j++ {
+		if s[i] == t[j] {
+			i++
+		}
+	}
+	return i == len(s)
+}
@@ -0,0 +1,196 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/domain/mocks"
+	"github.com/osmosis-labs/sqs/domain/mvc"
+	v1beta1 "github.com/osmosis-labs/sqs/pkg/api/v1beta1"
+	"github.com/osmosis-labs/sqs/sqsdomain"
+	tokensusecase "github.com/osmosis-labs/sqs/tokens/usecase"
+)
+
+// mockPriceHistoryUsecase is a price history use case returning the candles by base denom.
+type mockPriceHistoryUsecase struct {
+	mvc.PriceHistoryUsecase
+
+	candles map[string][]domain.PriceCandle
+}
+
+// GetPriceCandles implements mvc.PriceHistoryUsecase.
+func (m *mockPriceHistoryUsecase) GetPriceCandles(baseDenom, quoteDenom string, resolution domain.PriceCandleResolution, from, to int64) []domain.PriceCandle {
+	return m.candles[baseDenom]
+}
+
+// TestGetTokenMarkets validates that the token markets join the token metadata with the pool denom metadata,
+// the number of pools and the recorded price change, and that they are searched, sorted and paginated.
+func TestGetTokenMarkets(t *testing.T) {
+	const PEPE = "factory/osmo1creator/pepe"
+
+	usecase := tokensusecase.NewTokensUsecase(map[string]domain.Token{
+		UOSMO:  {Name: "Osmosis", HumanDenom: "OSMO", CoinMinimalDenom: UOSMO, Precision: 6},
+		ATOM:   {Name: "Cosmos Hub", HumanDenom: "ATOM", CoinMinimalDenom: ATOM, Precision: 6},
+		stATOM: {Name: "Stride Staked Atom", HumanDenom: "stATOM", CoinMinimalDenom: stATOM, Precision: 6, IsUnlisted: true},
+	}, 0, nil)
+	usecase.LoadUnverifiedTokens(map[string]domain.Token{
+		PEPE: {Name: "Pepe", HumanDenom: "PEPE", CoinMinimalDenom: PEPE, Precision: 6, IsUnverified: true},
+	})
+
+	usecase.UpdatePoolDenomMetadata(domain.PoolDenomMetaDataMap{
+		UOSMO:  {Price: osmomath.MustNewBigDecFromStr("0.5"), TotalLiquidityCap: osmomath.NewInt(1000)},
+		ATOM:   {Price: osmomath.NewBigDec(4), TotalLiquidityCap: osmomath.NewInt(500)},
+		stATOM: {Price: osmomath.NewBigDec(6), TotalLiquidityCap: osmomath.NewInt(700)},
+	})
+
+	poolsUsecase := &mocks.PoolsUsecaseMock{
+		Pools: []sqsdomain.PoolI{
+			&mocks.MockRoutablePool{ID: 1, Denoms: []string{UOSMO, ATOM}},
+			&mocks.MockRoutablePool{ID: 2, Denoms: []string{UOSMO, stATOM}},
+		},
+	}
+
+	now := time.Now().Unix()
+	priceHistoryUsecase := &mockPriceHistoryUsecase{
+		candles: map[string][]domain.PriceCandle{
+			UOSMO: {
+				domain.NewPriceCandle(now-3600, osmomath.MustNewBigDecFromStr("0.4")),
+				domain.NewPriceCandle(now, osmomath.MustNewBigDecFromStr("0.5")),
+			},
+			ATOM: {
+				domain.NewPriceCandle(now-3600, osmomath.NewBigDec(5)),
+				domain.NewPriceCandle(now, osmomath.NewBigDec(4)),
+			},
+		},
+	}
+
+	usecase.SetTokenMarketsSources(poolsUsecase, priceHistoryUsecase, USDC)
+
+	tests := []struct {
+		name string
+		opts []domain.TokenMarketsOption
+
+		expectedDenoms []string
+		expectedTotal  uint64
+		expectedErr    error
+	}{
+		{
+			name: "default sort by liquidity cap",
+
+			expectedDenoms: []string{UOSMO, stATOM, ATOM, PEPE},
+			expectedTotal:  4,
+		},
+		{
+			name: "search ranks exact symbol match first",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSearch("atom")},
+
+			expectedDenoms: []string{ATOM, stATOM},
+			expectedTotal:  2,
+		},
+		{
+			name: "fuzzy search by name",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSearch("csmhb")},
+
+			expectedDenoms: []string{ATOM},
+			expectedTotal:  1,
+		},
+		{
+			name: "search without match",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSearch("nomatch")},
+
+			expectedDenoms: []string{},
+			expectedTotal:  0,
+		},
+		{
+			name: "sort by price change descending with missing price changes last",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSort(&v1beta1.SortRequest{
+				Fields: []*v1beta1.SortField{{Field: "priceChange24h", Direction: v1beta1.SortDirection_DESCENDING}},
+			})},
+
+			expectedDenoms: []string{UOSMO, ATOM, PEPE, stATOM},
+			expectedTotal:  4,
+		},
+		{
+			name: "sort by number of pools and symbol",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSort(&v1beta1.SortRequest{
+				Fields: []*v1beta1.SortField{
+					{Field: "numPools", Direction: v1beta1.SortDirection_DESCENDING},
+					{Field: "symbol", Direction: v1beta1.SortDirection_ASCENDING},
+				},
+			})},
+
+			expectedDenoms: []string{UOSMO, ATOM, stATOM, PEPE},
+			expectedTotal:  4,
+		},
+		{
+			name: "cursor pagination",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsPagination(&v1beta1.PaginationRequest{
+				Strategy: v1beta1.PaginationStrategy_CURSOR,
+				Cursor:   1,
+				Limit:    2,
+			})},
+
+			expectedDenoms: []string{stATOM, ATOM},
+			expectedTotal:  4,
+		},
+		{
+			name: "unsupported sort field",
+			opts: []domain.TokenMarketsOption{domain.WithTokenMarketsSort(&v1beta1.SortRequest{
+				Fields: []*v1beta1.SortField{{Field: "volume"}},
+			})},
+
+			expectedErr: domain.UnsupportedTokenMarketsSortFieldError{Field: "volume"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			markets, total, err := usecase.GetTokenMarkets(context.Background(), tc.opts...)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			denoms := make([]string, 0, len(markets))
+			for _, market := range markets {
+				denoms = append(denoms, market.Denom)
+			}
+			require.Equal(t, tc.expectedDenoms, denoms)
+			require.Equal(t, tc.expectedTotal, total)
+		})
+	}
+
+	// Validate the market stats of the best match.
+	// The name of ATOM also matches the search.
+	markets, _, err := usecase.GetTokenMarkets(context.Background(), domain.WithTokenMarketsSearch("osmo"))
+	require.NoError(t, err)
+	require.Len(t, markets, 2)
+
+	market := markets[0]
+	require.Equal(t, UOSMO, market.Denom)
+	require.Equal(t, "OSMO", market.Symbol)
+	require.Equal(t, "Osmosis", market.Name)
+	require.Equal(t, 6, market.Decimals)
+	require.Equal(t, osmomath.MustNewBigDecFromStr("0.5").String(), market.Price.String())
+	require.Equal(t, osmomath.NewInt(1000), market.LiquidityCap)
+	require.Equal(t, 2, market.NumPools)
+	require.NotNil(t, market.PriceChange24h)
+	require.Equal(t, osmomath.MustNewBigDecFromStr("0.25").String(), market.PriceChange24h.String())
+	require.False(t, market.IsUnlisted)
+	require.True(t, market.IsVerified)
+
+	// The pools are read from the state snapshot pinned in the context.
+	snapshot := domain.NewStateSnapshot(1, []sqsdomain.PoolI{
+		&mocks.MockRoutablePool{ID: 1, Denoms: []string{UOSMO, ATOM}},
+	}, nil, nil, nil)
+	markets, _, err = usecase.GetTokenMarkets(domain.ContextWithStateSnapshot(context.Background(), snapshot), domain.WithTokenMarketsSearch("osmo"))
+	require.NoError(t, err)
+	require.Equal(t, 1, markets[0].NumPools)
+}
@@ -6,8 +6,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
 )
 
 // GetTokensFromChainRegistryFunc is a GetTokensFromChainRegistry function signature.
@@ -30,12 +40,29 @@ func GetTokensFromChainRegistry(chainRegistryAssetsFileURL string) (map[string]d
 		return nil, "", err
 	}
 
+	return parseAssetList(data)
+}
+
+// GetTokensFromFile reads the tokens from a local asset list file in the chain registry asset list format.
+// It returns a map of tokens by chain denom.
+func GetTokensFromFile(path string) (map[string]domain.Token, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return parseAssetList(data)
+}
+
+// parseAssetList parses the tokens from the given asset list data.
+// It returns a map of tokens by chain denom and the checksum of the data.
+func parseAssetList(data []byte) (map[string]domain.Token, string, error) {
 	// Calculate the MD5 checksum of the data
 	checksum := fmt.Sprintf("%x", md5.Sum(data))
 
 	// Decode the JSON data
 	var assetList AssetList
-	err = json.Unmarshal(data, &assetList)
+	err := json.Unmarshal(data, &assetList)
 	if err != nil {
 		return nil, "", err
 	}
@@ -89,3 +116,320 @@ func (f *ChainRegistryHTTPFetcher) FetchAndUpdateTokens() error {
 
 	return nil
 }
+
+// chainRegistrySource is a token registry source fetching the tokens from the HTTP chain registry.
+type chainRegistrySource struct {
+	registryURL                string
+	getTokensFromChainRegistry GetTokensFromChainRegistryFunc
+}
+
+var _ domain.TokenRegistrySource = &chainRegistrySource{}
+
+// NewChainRegistrySource creates a new token registry source fetching the tokens from the HTTP chain registry.
+func NewChainRegistrySource(registryURL string, getTokensFromChainRegistry GetTokensFromChainRegistryFunc) domain.TokenRegistrySource {
+	return &chainRegistrySource{
+		registryURL:                registryURL,
+		getTokensFromChainRegistry: getTokensFromChainRegistry,
+	}
+}
+
+// GetName implements domain.TokenRegistrySource.
+func (s *chainRegistrySource) GetName() string {
+	return s.registryURL
+}
+
+// GetTokens implements domain.TokenRegistrySource.
+func (s *chainRegistrySource) GetTokens() (map[string]domain.Token, string, error) {
+	return s.getTokensFromChainRegistry(s.registryURL)
+}
+
+// fileSource is a token registry source reading the tokens from a local asset list file.
+type fileSource struct {
+	path string
+}
+
+var _ domain.TokenRegistrySource = &fileSource{}
+
+// NewFileSource creates a new token registry source reading the tokens from the local asset list file at the given path.
+func NewFileSource(path string) domain.TokenRegistrySource {
+	return &fileSource{
+		path: filepath.Clean(path),
+	}
+}
+
+// GetName implements domain.TokenRegistrySource.
+func (s *fileSource) GetName() string {
+	return s.path
+}
+
+// GetTokens implements domain.TokenRegistrySource.
+func (s *fileSource) GetTokens() (map[string]domain.Token, string, error) {
+	return GetTokensFromFile(s.path)
+}
+
+// MultiSourceTokenRegistryLoader is an implementation of TokenRegistryLoader that merges the tokens
+// from several sources in order of precedence, patches them with the overrides and loads them into the token registry.
+// The tokens are only loaded if any of the sources changed since the last load.
+type MultiSourceTokenRegistryLoader struct {
+	sources    []domain.TokenRegistrySource
+	overrides  []domain.TokenRegistryOverride
+	loadTokens LoadTokensFunc
+	logger     log.Logger
+
+	// mx guards the fields below and serializes the loads.
+	mx sync.Mutex
+	// sourceTokens are the tokens last fetched from each source, in the same order as the sources.
+	sourceTokens []map[string]domain.Token
+	// sourceHashes are the checksums of the tokens last fetched from each source, in the same order as the sources.
+	sourceHashes []string
+	lastLoadHash string
+
+	// reportMx guards lastReport so that it can be read while the tokens are fetched.
+	reportMx   sync.RWMutex
+	lastReport domain.TokenRegistryReport
+
+	// stopWatch stops watching the files when closed.
+	stopWatch chan struct{}
+}
+
+var (
+	_ domain.TokenRegistryLoader   = &MultiSourceTokenRegistryLoader{}
+	_ domain.TokenRegistryReporter = &MultiSourceTokenRegistryLoader{}
+)
+
+// NewMultiSourceTokenRegistryLoader creates a new instance of MultiSourceTokenRegistryLoader.
+// The sources are given in ascending order of precedence and the overrides take precedence over all of them.
+func NewMultiSourceTokenRegistryLoader(sources []domain.TokenRegistrySource, overrides []domain.TokenRegistryOverride, loadTokens LoadTokensFunc, logger log.Logger) *MultiSourceTokenRegistryLoader {
+	return &MultiSourceTokenRegistryLoader{
+		sources:    sources,
+		overrides:  overrides,
+		loadTokens: loadTokens,
+		logger:     logger,
+
+		sourceTokens: make([]map[string]domain.Token, len(sources)),
+		sourceHashes: make([]string, len(sources)),
+	}
+}
+
+// FetchAndUpdateTokens fetches the tokens from all the sources and updates the token registry.
+// Returns error without updating the token registry if any of the sources fails.
+// In case there were no changes since last fetch, it does not update the token registry.
+func (l *MultiSourceTokenRegistryLoader) FetchAndUpdateTokens() error {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for i, source := range l.sources {
+		tokens, hash, err := source.GetTokens()
+		if err != nil {
+			return fmt.Errorf("failed to fetch tokens from source (%s): %w", source.GetName(), err)
+		}
+
+		l.sourceTokens[i] = tokens
+		l.sourceHashes[i] = hash
+	}
+
+	l.mergeAndLoadTokens()
+
+	return nil
+}
+
+// WatchFiles polls the file sources at the given interval and reloads the ones whose modification time or size changed
+// until Close is called.
+// A file that fails to be read, e.g. while it is being written, keeps its last tokens and is retried on the next poll.
+func (l *MultiSourceTokenRegistryLoader) WatchFiles(interval time.Duration) {
+	// Source index -> file path.
+	filePaths := make(map[int]string)
+	for i, source := range l.sources {
+		if fileSource, ok := source.(*fileSource); ok {
+			filePaths[i] = fileSource.path
+		}
+	}
+
+	if len(filePaths) == 0 {
+		return
+	}
+
+	// Source index -> file info at the last successful load.
+	lastFileInfos := make(map[int]os.FileInfo, len(filePaths))
+	for i, path := range filePaths {
+		if fileInfo, err := os.Stat(path); err == nil {
+			lastFileInfos[i] = fileInfo
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	stopWatch := make(chan struct{})
+	l.stopWatch = stopWatch
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopWatch:
+				return
+			case <-ticker.C:
+				for i, path := range filePaths {
+					fileInfo, err := os.Stat(path)
+					if err != nil {
+						l.logger.Error("failed to stat token registry file", zap.String("file", path), zap.Error(err))
+						continue
+					}
+
+					if lastFileInfo, ok := lastFileInfos[i]; ok && fileInfo.ModTime().Equal(lastFileInfo.ModTime()) && fileInfo.Size() == lastFileInfo.Size() {
+						continue
+					}
+
+					if err := l.reloadSource(i); err != nil {
+						l.logger.Error("failed to reload token registry file", zap.String("file", path), zap.Error(err))
+						continue
+					}
+
+					lastFileInfos[i] = fileInfo
+				}
+			}
+		}
+	}()
+}
+
+// Close stops watching the files, if any.
+func (l *MultiSourceTokenRegistryLoader) Close() {
+	if l.stopWatch != nil {
+		close(l.stopWatch)
+		l.stopWatch = nil
+	}
+}
+
+// reloadSource fetches the tokens from the source at the given index
+// and updates the token registry with the last tokens of the other sources.
+func (l *MultiSourceTokenRegistryLoader) reloadSource(i int) error {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	tokens, hash, err := l.sources[i].GetTokens()
+	if err != nil {
+		return err
+	}
+
+	l.sourceTokens[i] = tokens
+	l.sourceHashes[i] = hash
+
+	l.mergeAndLoadTokens()
+
+	return nil
+}
+
+// mergeAndLoadTokens merges the last tokens of the sources and loads them into the token registry
+// unless none of the sources changed since the last load.
+// CONTRACT: l.mx is held.
+func (l *MultiSourceTokenRegistryLoader) mergeAndLoadTokens() {
+	hash := strings.Join(l.sourceHashes, ",")
+	if hash == l.lastLoadHash {
+		return
+	}
+
+	tokens, report := mergeTokens(l.sourceTokens, l.overrides)
+
+	if !report.IsEmpty() {
+		l.logger.Warn("token registry validation issues",
+			zap.Any("duplicate_symbols", report.DuplicateSymbols),
+			zap.Strings("missing_decimals", report.MissingDecimals),
+			zap.Any("conflicting_coingecko_ids", report.ConflictingCoingeckoIDs),
+		)
+	}
+
+	l.loadTokens(tokens)
+	l.lastLoadHash = hash
+
+	l.reportMx.Lock()
+	l.lastReport = report
+	l.reportMx.Unlock()
+}
+
+// GetReport implements domain.TokenRegistryReporter.
+func (l *MultiSourceTokenRegistryLoader) GetReport() domain.TokenRegistryReport {
+	l.reportMx.RLock()
+	defer l.reportMx.RUnlock()
+
+	return l.lastReport
+}
+
+// mergeTokens merges the tokens from the sources given in ascending order of precedence,
+// a token replacing the one with the same chain denom from the preceding sources,
+// and patches the result with the non-empty fields of the overrides.
+// Returns the merged tokens by chain denom and their validation report.
+func mergeTokens(sourceTokens []map[string]domain.Token, overrides []domain.TokenRegistryOverride) (map[string]domain.Token, domain.TokenRegistryReport) {
+	mergedTokens := make(map[string]domain.Token)
+
+	// Chain denom -> CoinGecko IDs given by the sources.
+	coingeckoIDs := make(map[string][]string)
+
+	for _, tokens := range sourceTokens {
+		for chainDenom, token := range tokens {
+			mergedTokens[chainDenom] = token
+
+			if token.CoingeckoID != "" && !slices.Contains(coingeckoIDs[chainDenom], token.CoingeckoID) {
+				coingeckoIDs[chainDenom] = append(coingeckoIDs[chainDenom], token.CoingeckoID)
+			}
+		}
+	}
+
+	for _, override := range overrides {
+		token := mergedTokens[override.Denom]
+		token.CoinMinimalDenom = override.Denom
+
+		if override.Name != "" {
+			token.Name = override.Name
+		}
+		if override.Symbol != "" {
+			token.HumanDenom = override.Symbol
+		}
+		if override.Decimals != nil {
+			token.Precision = *override.Decimals
+		}
+		if override.CoingeckoID != "" {
+			token.CoingeckoID = override.CoingeckoID
+
+			// The override resolves any conflict between the sources.
+			delete(coingeckoIDs, override.Denom)
+		}
+		if override.Preview != nil {
+			token.IsUnlisted = *override.Preview
+		}
+
+		mergedTokens[override.Denom] = token
+	}
+
+	report := domain.TokenRegistryReport{
+		DuplicateSymbols:        make(map[string][]string),
+		MissingDecimals:         []string{},
+		ConflictingCoingeckoIDs: make(map[string][]string),
+	}
+
+	chainDenomsBySymbol := make(map[string][]string)
+	for chainDenom, token := range mergedTokens {
+		lowerCaseSymbol := strings.ToLower(token.HumanDenom)
+		chainDenomsBySymbol[lowerCaseSymbol] = append(chainDenomsBySymbol[lowerCaseSymbol], chainDenom)
+
+		if token.Precision == 0 {
+			report.MissingDecimals = append(report.MissingDecimals, chainDenom)
+		}
+	}
+
+	for symbol, chainDenoms := range chainDenomsBySymbol {
+		if len(chainDenoms) > 1 {
+			sort.Strings(chainDenoms)
+			report.DuplicateSymbols[symbol] = chainDenoms
+		}
+	}
+
+	for chainDenom, ids := range coingeckoIDs {
+		if len(ids) > 1 {
+			report.ConflictingCoingeckoIDs[chainDenom] = ids
+		}
+	}
+
+	sort.Strings(report.MissingDecimals)
+
+	return mergedTokens, report
+}
@@ -1,9 +1,17 @@
 package usecase_test
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/sqs/domain"
+	"github.com/osmosis-labs/sqs/log"
 	tokensusecase "github.com/osmosis-labs/sqs/tokens/usecase"
 )
 
@@ -64,3 +72,144 @@ func TestFetchAndUpdateTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeTokens(t *testing.T) {
+	var (
+		decimals = 8
+		preview  = false
+
+		remoteTokens = map[string]domain.Token{
+			"uosmo":     {CoinMinimalDenom: "uosmo", HumanDenom: "OSMO", Precision: 6, CoingeckoID: "osmosis"},
+			"ibc/ATOM":  {CoinMinimalDenom: "ibc/ATOM", HumanDenom: "ATOM", Precision: 6, CoingeckoID: "cosmos"},
+			"ibc/WBTC":  {CoinMinimalDenom: "ibc/WBTC", HumanDenom: "WBTC", Precision: 8, CoingeckoID: "wrapped-bitcoin", IsUnlisted: true},
+			"ibc/OTHER": {CoinMinimalDenom: "ibc/OTHER", HumanDenom: "atom", Precision: 6},
+		}
+
+		fileTokens = map[string]domain.Token{
+			"ibc/ATOM":         {CoinMinimalDenom: "ibc/ATOM", HumanDenom: "ATOM", Precision: 6, CoingeckoID: "cosmos-hub"},
+			"factory/new/coin": {CoinMinimalDenom: "factory/new/coin", HumanDenom: "NEW"},
+		}
+	)
+
+	tests := []struct {
+		name         string
+		sourceTokens []map[string]domain.Token
+		overrides    []domain.TokenRegistryOverride
+
+		expectedTokens map[string]domain.Token
+		expectedReport domain.TokenRegistryReport
+	}{
+		{
+			name:         "file replaces remote tokens and reports issues",
+			sourceTokens: []map[string]domain.Token{remoteTokens, fileTokens},
+
+			expectedTokens: map[string]domain.Token{
+				"uosmo":            remoteTokens["uosmo"],
+				"ibc/ATOM":         fileTokens["ibc/ATOM"],
+				"ibc/WBTC":         remoteTokens["ibc/WBTC"],
+				"ibc/OTHER":        remoteTokens["ibc/OTHER"],
+				"factory/new/coin": fileTokens["factory/new/coin"],
+			},
+			expectedReport: domain.TokenRegistryReport{
+				DuplicateSymbols:        map[string][]string{"atom": {"ibc/ATOM", "ibc/OTHER"}},
+				MissingDecimals:         []string{"factory/new/coin"},
+				ConflictingCoingeckoIDs: map[string][]string{"ibc/ATOM": {"cosmos", "cosmos-hub"}},
+			},
+		},
+		{
+			name:         "overrides patch tokens and resolve issues",
+			sourceTokens: []map[string]domain.Token{remoteTokens, fileTokens},
+			overrides: []domain.TokenRegistryOverride{
+				{Denom: "ibc/ATOM", CoingeckoID: "cosmos"},
+				{Denom: "ibc/OTHER", Symbol: "OTHER"},
+				{Denom: "factory/new/coin", Decimals: &decimals},
+				{Denom: "ibc/WBTC", Preview: &preview},
+				{Denom: "factory/override/coin", Name: "Override", Symbol: "OVR", Decimals: &decimals},
+			},
+
+			expectedTokens: map[string]domain.Token{
+				"uosmo":                 remoteTokens["uosmo"],
+				"ibc/ATOM":              {CoinMinimalDenom: "ibc/ATOM", HumanDenom: "ATOM", Precision: 6, CoingeckoID: "cosmos"},
+				"ibc/WBTC":              {CoinMinimalDenom: "ibc/WBTC", HumanDenom: "WBTC", Precision: 8, CoingeckoID: "wrapped-bitcoin"},
+				"ibc/OTHER":             {CoinMinimalDenom: "ibc/OTHER", HumanDenom: "OTHER", Precision: 6},
+				"factory/new/coin":      {CoinMinimalDenom: "factory/new/coin", HumanDenom: "NEW", Precision: 8},
+				"factory/override/coin": {CoinMinimalDenom: "factory/override/coin", Name: "Override", HumanDenom: "OVR", Precision: 8},
+			},
+			expectedReport: domain.TokenRegistryReport{
+				DuplicateSymbols:        map[string][]string{},
+				MissingDecimals:         []string{},
+				ConflictingCoingeckoIDs: map[string][]string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, report := tokensusecase.MergeTokens(tt.sourceTokens, tt.overrides)
+
+			require.Equal(t, tt.expectedTokens, tokens)
+			require.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
+
+// TestMultiSourceTokenRegistryLoader_WatchFiles validates that the tokens are loaded from a local file
+// and reloaded once the file changes.
+func TestMultiSourceTokenRegistryLoader_WatchFiles(t *testing.T) {
+	var (
+		mx           sync.Mutex
+		loadedTokens map[string]domain.Token
+
+		path = filepath.Join(t.TempDir(), "assetlist.json")
+	)
+
+	writeAssetList := func(symbols ...string) {
+		assetList := tokensusecase.AssetList{ChainName: "localosmosis"}
+		for _, symbol := range symbols {
+			assetList.Assets = append(assetList.Assets, struct {
+				Name             string `json:"name"`
+				CoinMinimalDenom string `json:"coinMinimalDenom"`
+				Symbol           string `json:"symbol"`
+				Decimals         int    `json:"decimals"`
+				CoingeckoID      string `json:"coingeckoId"`
+				Preview          bool   `json:"preview"`
+			}{Name: symbol, CoinMinimalDenom: "factory/creator/" + symbol, Symbol: symbol, Decimals: 6})
+		}
+
+		data, err := json.Marshal(assetList)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+	}
+
+	writeAssetList("A")
+
+	loader := tokensusecase.NewMultiSourceTokenRegistryLoader(
+		[]domain.TokenRegistrySource{tokensusecase.NewFileSource(path)},
+		nil,
+		func(tokens map[string]domain.Token) {
+			mx.Lock()
+			defer mx.Unlock()
+			loadedTokens = tokens
+		},
+		&log.NoOpLogger{},
+	)
+
+	require.NoError(t, loader.FetchAndUpdateTokens())
+	require.Contains(t, loadedTokens, "factory/creator/A")
+	require.True(t, loader.GetReport().IsEmpty())
+
+	loader.WatchFiles(10 * time.Millisecond)
+	defer loader.Close()
+
+	writeAssetList("A", "B", "b")
+
+	require.Eventually(t, func() bool {
+		mx.Lock()
+		defer mx.Unlock()
+		_, ok := loadedTokens["factory/creator/B"]
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The report of the last load is kept.
+	require.Equal(t, map[string][]string{"b": {"factory/creator/B", "factory/creator/b"}}, loader.GetReport().DuplicateSymbols)
+}
@@ -30,6 +30,9 @@ type tokensUseCase struct {
 	// Metadata about denoms that is collected from the pools.
 	// E.g. total denom liquidity across all pools.
 	poolDenomMetaData sync.Map
+	// Same as poolDenomMetaData but priced in the quote denoms other than the default one.
+	// Quote denom -> *sync.Map of chain denom -> domain.PoolDenomMetaData
+	quotePoolDenomMetaData sync.Map
 
 	// We persist pricing strategies across endpoint calls as they
 	// may cache responses internally.
@@ -44,6 +47,12 @@ type tokensUseCase struct {
 	// TokenRegistryLoader fetches tokens from the chain registry into the tokens use case
 	tokenLoader domain.TokenRegistryLoader
 
+	// Sources of the token markets, see SetTokenMarketsSources.
+	poolsUsecase mvc.PoolsUsecase
+	// priceHistoryUsecase is nil if the price history is disabled.
+	priceHistoryUsecase    mvc.PriceHistoryUsecase
+	defaultQuoteChainDenom string
+
 	// Logger instance
 	logger log.Logger
 }
@@ -64,7 +73,7 @@ type AssetList struct {
 // Define a result struct to hold the base denom and prices for each possible quote denom or error
 type priceResults struct {
 	baseDenom string
-	prices    map[string]osmomath.BigDec
+	prices    map[string]domain.PriceDetails
 	err       error
 }
 
@@ -93,6 +102,8 @@ func (t *tokensUseCase) SetTokenRegistryLoader(loader domain.TokenRegistryLoader
 type LoadTokensFunc func(tokenMetadataByChainDenom map[string]domain.Token)
 
 // LoadTokens implements mvc.TokensUsecase.
+// The given tokens replace the registry tokens so that the ones removed from the registry are unloaded.
+// The unverified tokens resolved from the chain are kept unless replaced by a registry token.
 func (t *tokensUseCase) LoadTokens(tokenMetadataByChainDenom map[string]domain.Token) {
 	// Create human denom to chain denom map
 	for chainDenom, tokenMetadata := range tokenMetadataByChainDenom {
@@ -106,6 +117,55 @@ func (t *tokensUseCase) LoadTokens(tokenMetadataByChainDenom map[string]domain.T
 
 		t.coingeckoIds.Store(chainDenom, tokenMetadata.CoingeckoID)
 	}
+
+	// Unload the registry tokens that are no longer given.
+	t.tokenMetadataByChainDenom.Range(func(key, value any) bool {
+		chainDenom, _ := key.(string)
+		if _, ok := tokenMetadataByChainDenom[chainDenom]; ok {
+			return true
+		}
+
+		if tokenMetadata, ok := value.(domain.Token); ok && tokenMetadata.IsUnverified {
+			return true
+		}
+
+		t.tokenMetadataByChainDenom.Delete(chainDenom)
+		t.chainDenoms.Delete(chainDenom)
+		t.coingeckoIds.Delete(chainDenom)
+		return true
+	})
+
+	// Unload the human denoms of the unloaded tokens and the ones renamed.
+	t.humanToChainDenomMap.Range(func(key, value any) bool {
+		lowerCaseHumanDenom, _ := key.(string)
+		chainDenom, _ := value.(string)
+
+		tokenMetadata, err := t.GetMetadataByChainDenom(chainDenom)
+		if err != nil || strings.ToLower(tokenMetadata.HumanDenom) != lowerCaseHumanDenom {
+			t.humanToChainDenomMap.Delete(lowerCaseHumanDenom)
+		}
+		return true
+	})
+}
+
+// LoadUnverifiedTokens implements mvc.TokensUsecase.
+func (t *tokensUseCase) LoadUnverifiedTokens(tokenMetadataByChainDenom map[string]domain.Token) {
+	for chainDenom, tokenMetadata := range tokenMetadataByChainDenom {
+		tokenMetadata.IsUnverified = true
+
+		if _, loaded := t.tokenMetadataByChainDenom.LoadOrStore(chainDenom, tokenMetadata); loaded {
+			continue
+		}
+
+		// The human denom of an unverified token must not shadow the one of a registry token.
+		if tokenMetadata.HumanDenom != "" {
+			t.humanToChainDenomMap.LoadOrStore(strings.ToLower(tokenMetadata.HumanDenom), chainDenom)
+		}
+
+		t.chainDenoms.Store(chainDenom, struct{}{})
+
+		t.coingeckoIds.Store(chainDenom, tokenMetadata.CoingeckoID)
+	}
 }
 
 // UpdatePoolDenomMetadata implements mvc.TokensUsecase.
@@ -115,15 +175,67 @@ func (t *tokensUseCase) UpdatePoolDenomMetadata(poolDenomMetadata domain.PoolDen
 	}
 }
 
+// UpdateQuotePoolDenomMetadata implements mvc.TokensUsecase.
+func (t *tokensUseCase) UpdateQuotePoolDenomMetadata(quoteDenom string, poolDenomMetadata domain.PoolDenomMetaDataMap) {
+	quotePoolDenomMetaDataObj, _ := t.quotePoolDenomMetaData.LoadOrStore(quoteDenom, &sync.Map{})
+
+	quotePoolDenomMetaData, ok := quotePoolDenomMetaDataObj.(*sync.Map)
+	if !ok {
+		return
+	}
+
+	for chainDenom, tokenMetadata := range poolDenomMetadata {
+		quotePoolDenomMetaData.Store(chainDenom, tokenMetadata)
+	}
+}
+
 // ClearPoolDenomMetadata implements mvc.TokensUsecase.
 // WARNING: use with caution, this will clear all pool denom metadata
 func (t *tokensUseCase) ClearPoolDenomMetadata() {
 	t.poolDenomMetaData = sync.Map{}
+	t.quotePoolDenomMetaData = sync.Map{}
+}
+
+// GetPoolDenomMetadataSnapshot implements mvc.TokensUsecase.
+func (t *tokensUseCase) GetPoolDenomMetadataSnapshot() (domain.PoolDenomMetaDataMap, map[string]domain.PoolDenomMetaDataMap) {
+	poolDenomMetadata := copyPoolDenomMetadata(&t.poolDenomMetaData)
+
+	quotePoolDenomMetadata := make(map[string]domain.PoolDenomMetaDataMap)
+	t.quotePoolDenomMetaData.Range(func(quoteDenomObj, quotePoolDenomMetaDataObj any) bool {
+		quoteDenom, ok := quoteDenomObj.(string)
+		if !ok {
+			return true
+		}
+
+		if v, ok := quotePoolDenomMetaDataObj.(*sync.Map); ok {
+			quotePoolDenomMetadata[quoteDenom] = copyPoolDenomMetadata(v)
+		}
+		return true
+	})
+
+	return poolDenomMetadata, quotePoolDenomMetadata
+}
+
+// copyPoolDenomMetadata returns a copy of the given metadata map.
+func copyPoolDenomMetadata(poolDenomMetaData *sync.Map) domain.PoolDenomMetaDataMap {
+	result := domain.PoolDenomMetaDataMap{}
+	poolDenomMetaData.Range(func(chainDenomObj, poolDenomMetadataObj any) bool {
+		chainDenom, ok := chainDenomObj.(string)
+		if !ok {
+			return true
+		}
+
+		if poolDenomMetadata, ok := poolDenomMetadataObj.(domain.PoolDenomMetaData); ok {
+			result[chainDenom] = poolDenomMetadata
+		}
+		return true
+	})
+	return result
 }
 
 // GetPoolLiquidityCap implements mvc.TokensUsecase.
-func (t *tokensUseCase) GetPoolLiquidityCap(chainDenom string) (osmomath.Int, error) {
-	poolDenomMetadata, err := t.GetPoolDenomMetadata(chainDenom)
+func (t *tokensUseCase) GetPoolLiquidityCap(ctx context.Context, chainDenom string) (osmomath.Int, error) {
+	poolDenomMetadata, err := t.GetPoolDenomMetadata(ctx, chainDenom)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -131,8 +243,64 @@ func (t *tokensUseCase) GetPoolLiquidityCap(chainDenom string) (osmomath.Int, er
 }
 
 // GetPoolDenomMetadata implements mvc.TokensUsecase.
-func (t *tokensUseCase) GetPoolDenomMetadata(chainDenom string) (domain.PoolDenomMetaData, error) {
-	poolDenomMetadataObj, ok := t.poolDenomMetaData.Load(chainDenom)
+func (t *tokensUseCase) GetPoolDenomMetadata(ctx context.Context, chainDenom string) (domain.PoolDenomMetaData, error) {
+	return t.getPoolDenomMetadataGetter(ctx)(chainDenom)
+}
+
+// poolDenomMetadataGetter returns the pool denom metadata of the given chain denom.
+type poolDenomMetadataGetter func(chainDenom string) (domain.PoolDenomMetaData, error)
+
+// getPoolDenomMetadataGetter returns the getter of the pool denom metadata priced in the default quote denom
+// from the state snapshot pinned in the context or, if none is pinned, from the latest metadata.
+func (t *tokensUseCase) getPoolDenomMetadataGetter(ctx context.Context) poolDenomMetadataGetter {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		if poolDenomMetadata, ok := snapshot.GetPoolDenomMetadata(); ok {
+			return getPinnedPoolDenomMetadataGetter(poolDenomMetadata)
+		}
+	}
+
+	return func(chainDenom string) (domain.PoolDenomMetaData, error) {
+		return getPoolDenomMetadata(&t.poolDenomMetaData, chainDenom)
+	}
+}
+
+// getQuotePoolDenomMetadataGetter is the same as getPoolDenomMetadataGetter but for the pool denom metadata
+// priced in the given quote denom other than the default one.
+func (t *tokensUseCase) getQuotePoolDenomMetadataGetter(ctx context.Context, quoteDenom string) poolDenomMetadataGetter {
+	if snapshot, ok := domain.GetStateSnapshotFromContext(ctx); ok {
+		if quotePoolDenomMetadata, ok := snapshot.GetQuotePoolDenomMetadata(quoteDenom); ok {
+			return getPinnedPoolDenomMetadataGetter(quotePoolDenomMetadata)
+		}
+	}
+
+	quotePoolDenomMetaData := &sync.Map{}
+	if quotePoolDenomMetaDataObj, ok := t.quotePoolDenomMetaData.Load(quoteDenom); ok {
+		if v, ok := quotePoolDenomMetaDataObj.(*sync.Map); ok {
+			quotePoolDenomMetaData = v
+		}
+	}
+
+	return func(chainDenom string) (domain.PoolDenomMetaData, error) {
+		return getPoolDenomMetadata(quotePoolDenomMetaData, chainDenom)
+	}
+}
+
+// getPinnedPoolDenomMetadataGetter returns the getter of the pool denom metadata from the given pinned metadata map.
+func getPinnedPoolDenomMetadataGetter(poolDenomMetadata domain.PoolDenomMetaDataMap) poolDenomMetadataGetter {
+	return func(chainDenom string) (domain.PoolDenomMetaData, error) {
+		metadata, ok := poolDenomMetadata[chainDenom]
+		if !ok {
+			return domain.PoolDenomMetaData{}, domain.PoolDenomMetaDataNotPresentError{
+				ChainDenom: chainDenom,
+			}
+		}
+		return metadata, nil
+	}
+}
+
+// getPoolDenomMetadata returns the pool denom metadata of the given chain denom from the given metadata map.
+func getPoolDenomMetadata(poolDenomMetaData *sync.Map, chainDenom string) (domain.PoolDenomMetaData, error) {
+	poolDenomMetadataObj, ok := poolDenomMetaData.Load(chainDenom)
 	if !ok {
 		return domain.PoolDenomMetaData{}, domain.PoolDenomMetaDataNotPresentError{
 			ChainDenom: chainDenom,
@@ -148,11 +316,22 @@ func (t *tokensUseCase) GetPoolDenomMetadata(chainDenom string) (domain.PoolDeno
 }
 
 // GetPoolDenomsMetadata implements mvc.TokensUsecase.
-func (t *tokensUseCase) GetPoolDenomsMetadata(chainDenoms []string) domain.PoolDenomMetaDataMap {
+func (t *tokensUseCase) GetPoolDenomsMetadata(ctx context.Context, chainDenoms []string) domain.PoolDenomMetaDataMap {
+	return getPoolDenomsMetadata(t.getPoolDenomMetadataGetter(ctx), chainDenoms)
+}
+
+// GetQuotePoolDenomsMetadata implements mvc.TokensUsecase.
+func (t *tokensUseCase) GetQuotePoolDenomsMetadata(ctx context.Context, quoteDenom string, chainDenoms []string) domain.PoolDenomMetaDataMap {
+	return getPoolDenomsMetadata(t.getQuotePoolDenomMetadataGetter(ctx, quoteDenom), chainDenoms)
+}
+
+// getPoolDenomsMetadata returns the pool denom metadata of the given chain denoms from the given getter.
+// The denoms without metadata are set to zero.
+func getPoolDenomsMetadata(getPoolDenomMetadata poolDenomMetadataGetter, chainDenoms []string) domain.PoolDenomMetaDataMap {
 	result := make(domain.PoolDenomMetaDataMap, len(chainDenoms))
 
 	for _, chainDenom := range chainDenoms {
-		poolDenomMetadata, err := t.GetPoolDenomMetadata(chainDenom)
+		poolDenomMetadata, err := getPoolDenomMetadata(chainDenom)
 
 		// Instead of failing the entire request, we just set the results to zero
 		if err != nil {
@@ -171,7 +350,17 @@ func (t *tokensUseCase) GetPoolDenomsMetadata(chainDenoms []string) domain.PoolD
 }
 
 // GetFullPoolDenomMetadata implements mvc.TokensUsecase.
-func (t *tokensUseCase) GetFullPoolDenomMetadata() domain.PoolDenomMetaDataMap {
+func (t *tokensUseCase) GetFullPoolDenomMetadata(ctx context.Context) domain.PoolDenomMetaDataMap {
+	return t.GetPoolDenomsMetadata(ctx, t.getChainDenoms())
+}
+
+// GetFullQuotePoolDenomMetadata implements mvc.TokensUsecase.
+func (t *tokensUseCase) GetFullQuotePoolDenomMetadata(ctx context.Context, quoteDenom string) domain.PoolDenomMetaDataMap {
+	return t.GetQuotePoolDenomsMetadata(ctx, quoteDenom, t.getChainDenoms())
+}
+
+// getChainDenoms returns all the valid chain denoms.
+func (t *tokensUseCase) getChainDenoms() []string {
 	var chainDenoms []string
 	t.chainDenoms.Range(func(chainDenom, _ any) bool {
 		v, ok := chainDenom.(string)
@@ -180,7 +369,7 @@ func (t *tokensUseCase) GetFullPoolDenomMetadata() domain.PoolDenomMetaDataMap {
 		}
 		return true
 	})
-	return t.GetPoolDenomsMetadata(chainDenoms)
+	return chainDenoms
 }
 
 // GetChainDenom implements mvc.TokensUsecase.
@@ -260,7 +449,32 @@ func (t *tokensUseCase) GetChainScalingFactorByDenomMut(denom string) (osmomath.
 
 // GetPrices implements pricing.PricingStrategy.
 func (t *tokensUseCase) GetPrices(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PricesResult, error) {
-	byBaseDenomResult := make(map[string]map[string]osmomath.BigDec, len(baseDenoms))
+	priceDetails, err := t.getPriceDetails(ctx, baseDenoms, quoteDenoms, pricingSourceType, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	byBaseDenomResult := make(map[string]map[string]osmomath.BigDec, len(priceDetails))
+	for baseDenom, byQuoteDenom := range priceDetails {
+		byQuoteDenomResult := make(map[string]osmomath.BigDec, len(byQuoteDenom))
+		for quoteDenom, details := range byQuoteDenom {
+			byQuoteDenomResult[quoteDenom] = details.Price
+		}
+		byBaseDenomResult[baseDenom] = byQuoteDenomResult
+	}
+
+	return byBaseDenomResult, nil
+}
+
+// GetPriceDetails implements mvc.TokensUsecase.
+func (t *tokensUseCase) GetPriceDetails(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, opts ...domain.PricingOption) (domain.PriceDetailsResult, error) {
+	return t.getPriceDetails(ctx, baseDenoms, quoteDenoms, pricingSourceType, true, opts...)
+}
+
+// getPriceDetails computes the prices with their details for all given base and quote denoms concurrently.
+// If isDetailed is false, only the price and the source are reported so that the pricing sources may serve the prices from their caches.
+func (t *tokensUseCase) getPriceDetails(ctx context.Context, baseDenoms []string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, isDetailed bool, opts ...domain.PricingOption) (domain.PriceDetailsResult, error) {
+	byBaseDenomResult := make(domain.PriceDetailsResult, len(baseDenoms))
 
 	numWorkers := len(baseDenoms)
 	if numWorkers > maxNumWorkes {
@@ -285,7 +499,7 @@ func (t *tokensUseCase) GetPrices(ctx context.Context, baseDenoms []string, quot
 					}
 				}()
 
-				prices, err := t.getPricesForBaseDenom(ctx, baseDenom, quoteDenoms, pricingSourceType, opts...)
+				prices, err := t.getPriceDetailsForBaseDenom(ctx, baseDenom, quoteDenoms, pricingSourceType, isDetailed, opts...)
 				if err != nil {
 					// This should not panic, so just logging the error here and continue
 					fmt.Println(err.Error())
@@ -313,21 +527,37 @@ func (t *tokensUseCase) GetPrices(ctx context.Context, baseDenoms []string, quot
 	return byBaseDenomResult, nil
 }
 
-// getPricesForBaseDenom fetches all prices for base denom given a slice of quotes and pricing options.
+// getPriceDetailsForBaseDenom fetches all prices with their details for base denom given a slice of quotes and pricing options.
 // Pricing options determine whether to recompute prices or use the cache as well as the desired source of prices.
-// Returns a map with keys as quotes and values as prices or error, if any.
+// Returns a map with keys as quotes and values as price details or error, if any.
 // Returns error if base denom is not found in the token metadata.
 // Sets the price to zero in case of failing to compute the price between base and quote but these being valid tokens.
-func (t *tokensUseCase) getPricesForBaseDenom(ctx context.Context, baseDenom string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, pricingOptions ...domain.PricingOption) (map[string]osmomath.BigDec, error) {
-	byQuoteDenomForGivenBaseResult := make(map[string]osmomath.BigDec, len(quoteDenoms))
-	// Validate base denom is a valid denom
-	// Return zeroes for all quotes if base denom is not found
-	_, err := t.GetMetadataByChainDenom(baseDenom)
-	if err != nil {
-		for _, quoteDenom := range quoteDenoms {
-			byQuoteDenomForGivenBaseResult[quoteDenom] = osmomath.ZeroBigDec()
+// Share denoms are priced by the share pricing source, if registered, regardless of the given pricing source.
+func (t *tokensUseCase) getPriceDetailsForBaseDenom(ctx context.Context, baseDenom string, quoteDenoms []string, pricingSourceType domain.PricingSourceType, isDetailed bool, pricingOptions ...domain.PricingOption) (map[string]domain.PriceDetails, error) {
+	byQuoteDenomForGivenBaseResult := make(map[string]domain.PriceDetails, len(quoteDenoms))
+
+	_, hasSharePricingSource := t.pricingStrategyMap[domain.SharePricingSourceType]
+	isShareDenom := hasSharePricingSource && domain.IsShareDenom(baseDenom)
+
+	var err error
+	// GAMM shares and concentrated liquidity positions are not in the token metadata
+	// and are validated by the share pricing source. Alloyed denoms are validated as any other denom.
+	if !isShareDenom || domain.IsAlloyedDenom(baseDenom) {
+		// Validate base denom is a valid denom
+		// Return zeroes for all quotes if base denom is not found
+		if _, err = t.GetMetadataByChainDenom(baseDenom); err != nil {
+			for _, quoteDenom := range quoteDenoms {
+				byQuoteDenomForGivenBaseResult[quoteDenom] = domain.PriceDetails{
+					Price:  osmomath.ZeroBigDec(),
+					Source: pricingSourceType.String(),
+				}
+			}
+			return byQuoteDenomForGivenBaseResult, nil
 		}
-		return byQuoteDenomForGivenBaseResult, nil
+	}
+
+	if isShareDenom {
+		pricingSourceType = domain.SharePricingSourceType
 	}
 
 	// Get the pricing strategy
@@ -344,7 +574,7 @@ func (t *tokensUseCase) getPricesForBaseDenom(ctx context.Context, baseDenom str
 	}()
 
 	for _, quoteDenom := range quoteDenoms {
-		price, err := pricingStrategy.GetPrice(ctx, baseDenom, quoteDenom, pricingOptions...)
+		priceDetails, err := getPriceDetails(ctx, pricingStrategy, pricingSourceType, baseDenom, quoteDenom, isDetailed, pricingOptions...)
 		if err != nil { // Check if we should fallback to another pricing source
 			fallbackSourceType := pricingStrategy.GetFallbackStrategy(quoteDenom)
 			if fallbackSourceType != domain.NoneSourceType {
@@ -352,24 +582,45 @@ func (t *tokensUseCase) getPricesForBaseDenom(ctx context.Context, baseDenom str
 				domain.SQSPricingFallbackCounter.Inc()
 				fallbackPricingStrategy, ok := t.pricingStrategyMap[fallbackSourceType]
 				if ok {
-					price, err = fallbackPricingStrategy.GetPrice(ctx, baseDenom, quoteDenom, pricingOptions...)
+					priceDetails, err = getPriceDetails(ctx, fallbackPricingStrategy, fallbackSourceType, baseDenom, quoteDenom, isDetailed, pricingOptions...)
 				}
 			}
 		}
 
 		if err != nil {
-			price = osmomath.ZeroBigDec()
+			priceDetails = domain.PriceDetails{
+				Price:  osmomath.ZeroBigDec(),
+				Source: pricingSourceType.String(),
+			}
 			// Increase prometheus counter
 			t.logger.Error(domain.SQSPricingErrorCounterMetricName, zap.String("baseDenom", baseDenom), zap.String("quoteDenom", quoteDenom))
 			domain.SQSPricingErrorCounter.Inc()
 		}
 
-		byQuoteDenomForGivenBaseResult[quoteDenom] = price
+		byQuoteDenomForGivenBaseResult[quoteDenom] = priceDetails
 	}
 
 	return byQuoteDenomForGivenBaseResult, nil
 }
 
+// getPriceDetails returns the price computed by the given pricing source together with its details.
+// If isDetailed is false or the pricing source does not implement domain.DetailedPricingSource, only the price and the source are reported.
+func getPriceDetails(ctx context.Context, pricingSource domain.PricingSource, pricingSourceType domain.PricingSourceType, baseDenom, quoteDenom string, isDetailed bool, opts ...domain.PricingOption) (domain.PriceDetails, error) {
+	if detailedPricingSource, ok := pricingSource.(domain.DetailedPricingSource); ok && isDetailed {
+		return detailedPricingSource.GetPriceDetails(ctx, baseDenom, quoteDenom, opts...)
+	}
+
+	price, err := pricingSource.GetPrice(ctx, baseDenom, quoteDenom, opts...)
+	if err != nil {
+		return domain.PriceDetails{}, err
+	}
+
+	return domain.PriceDetails{
+		Price:  price,
+		Source: pricingSourceType.String(),
+	}, nil
+}
+
 // UpdateAssetsAtHeightIntervalSync updates assets at configured height interval.
 func (t *tokensUseCase) UpdateAssetsAtHeightIntervalSync(height uint64) error {
 	if height%uint64(t.updateAssetsHeightInterval) == 0 {
@@ -421,14 +672,16 @@ func (t *tokensUseCase) IsValidChainDenom(chainDenom string) bool {
 }
 
 // GetMinPoolLiquidityCap implements mvc.TokensUsecase.
-func (t *tokensUseCase) GetMinPoolLiquidityCap(denomA, denomB string) (uint64, error) {
+func (t *tokensUseCase) GetMinPoolLiquidityCap(ctx context.Context, denomA, denomB string) (uint64, error) {
+	getPoolDenomMetadata := t.getPoolDenomMetadataGetter(ctx)
+
 	// Get the pool denoms metadata
-	poolDenomMetadataA, err := t.GetPoolDenomMetadata(denomA)
+	poolDenomMetadataA, err := getPoolDenomMetadata(denomA)
 	if err != nil {
 		return 0, err
 	}
 
-	poolDenomMetadataB, err := t.GetPoolDenomMetadata(denomB)
+	poolDenomMetadataB, err := getPoolDenomMetadata(denomB)
 	if err != nil {
 		return 0, err
 	}
@@ -446,7 +699,7 @@ func (t *tokensUseCase) GetMinPoolLiquidityCap(denomA, denomB string) (uint64, e
 // IsValidPricingSource implements mvc.TokensUsecase.
 func (t *tokensUseCase) IsValidPricingSource(pricingSource int) bool {
 	ps := domain.PricingSourceType(pricingSource)
-	return ps == domain.ChainPricingSourceType || ps == domain.CoinGeckoPricingSourceType
+	return ps == domain.ChainPricingSourceType || ps == domain.CoinGeckoPricingSourceType || ps == domain.TWAPPricingSourceType || ps == domain.AggregatedPricingSourceType
 }
 
 // GetCoingeckoIdByChainDenom implements mvc.TokensUsecase
@@ -382,7 +382,7 @@ func (s *TokensUseCaseTestSuite) TestPoolDenomMetadata() {
 
 	// System under test.
 	// Get the liquidity of ATOM
-	xAmount, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(ATOM)
+	xAmount, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(context.Background(), ATOM)
 	s.Require().Error(err)
 
 	s.Require().ErrorIs(err, domain.PoolDenomMetaDataNotPresentError{
@@ -406,21 +406,21 @@ func (s *TokensUseCaseTestSuite) TestPoolDenomMetadata() {
 	})
 
 	// Get the liquidity of ATOM again
-	atomLiquidityUpdated, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(ATOM)
+	atomLiquidityUpdated, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(context.Background(), ATOM)
 	s.Require().NoError(err)
 
 	// Check if the liquidity is updated.
 	s.Require().Equal(atomPoolDenomMetadata.TotalLiquidity.String(), atomLiquidityUpdated.String())
 
 	// Get the liquidity of OSMO
-	osmoLiquidityUpdated, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(UOSMO)
+	osmoLiquidityUpdated, err := mainnetUsecase.Tokens.GetPoolLiquidityCap(context.Background(), UOSMO)
 	s.Require().NoError(err)
 
 	// Check if the liquidity is updated.
 	s.Require().Equal(osmoPoolDenomMetadata.TotalLiquidity.String(), osmoLiquidityUpdated.String())
 
 	// Fail to get the liquidity of another token
-	_, err = mainnetUsecase.Tokens.GetPoolLiquidityCap(UION)
+	_, err = mainnetUsecase.Tokens.GetPoolLiquidityCap(context.Background(), UION)
 	s.Require().Error(err)
 	s.Require().ErrorIs(err, domain.PoolDenomMetaDataNotPresentError{
 		ChainDenom: UION,
@@ -435,7 +435,7 @@ func (s *TokensUseCaseTestSuite) TestPoolDenomMetadata() {
 	})
 
 	// Get all the pool denom metadata
-	poolDenomMetadata := mainnetUsecase.Tokens.GetPoolDenomsMetadata([]string{ATOM, UOSMO, UION})
+	poolDenomMetadata := mainnetUsecase.Tokens.GetPoolDenomsMetadata(context.Background(), []string{ATOM, UOSMO, UION})
 	s.Require().Len(poolDenomMetadata, 3)
 	for chainDenom, metadata := range poolDenomMetadata {
 		switch chainDenom {
@@ -546,7 +546,7 @@ func (s *TokensUseCaseTestSuite) TestGetMinPoolLiquidityCap() {
 			mainnetUsecase.Tokens.UpdatePoolDenomMetadata(tt.preSetPoolDenomMetadata)
 
 			// System under test.
-			actualMinPoolLiquidityCap, err := mainnetUsecase.Tokens.GetMinPoolLiquidityCap(tt.denomA, tt.denomB)
+			actualMinPoolLiquidityCap, err := mainnetUsecase.Tokens.GetMinPoolLiquidityCap(context.Background(), tt.denomA, tt.denomB)
 
 			if tt.expectError {
 				s.Require().Error(err)
@@ -608,6 +608,203 @@ func (s *TokensUseCaseTestSuite) TestIsValidChainDenom() {
 	}
 }
 
+// Test to validate that the unverified tokens are flagged and valid
+// and that they never overwrite the chain denoms and human denoms of the registry tokens.
+func (s *TokensUseCaseTestSuite) TestLoadUnverifiedTokens() {
+	const (
+		registryDenom = "ibc/registry"
+		newDenom      = "factory/osmo1creator/pepe"
+		shadowDenom   = "factory/osmo1creator/atom"
+	)
+
+	usecase := tokensusecase.NewTokensUsecase(map[string]domain.Token{
+		registryDenom: {HumanDenom: "atom", CoinMinimalDenom: registryDenom, Precision: 6},
+	}, 0, nil)
+
+	usecase.LoadUnverifiedTokens(map[string]domain.Token{
+		registryDenom: {HumanDenom: "other", CoinMinimalDenom: registryDenom, Precision: 18},
+		newDenom:      {HumanDenom: "PEPE", CoinMinimalDenom: newDenom, Precision: 6},
+		shadowDenom:   {HumanDenom: "ATOM", CoinMinimalDenom: shadowDenom, Precision: 0},
+	})
+
+	// The registry token is left unchanged.
+	registryToken, err := usecase.GetMetadataByChainDenom(registryDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.Token{HumanDenom: "atom", CoinMinimalDenom: registryDenom, Precision: 6}, registryToken)
+
+	chainDenom, err := usecase.GetChainDenom("atom")
+	s.Require().NoError(err)
+	s.Require().Equal(registryDenom, chainDenom)
+
+	// The new token is flagged as unverified, valid and found by human denom.
+	newToken, err := usecase.GetMetadataByChainDenom(newDenom)
+	s.Require().NoError(err)
+	s.Require().True(newToken.IsUnverified)
+	s.Require().True(usecase.IsValidChainDenom(newDenom))
+
+	chainDenom, err = usecase.GetChainDenom("pepe")
+	s.Require().NoError(err)
+	s.Require().Equal(newDenom, chainDenom)
+
+	scalingFactor, err := usecase.GetChainScalingFactorByDenomMut(newDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(1_000_000), scalingFactor)
+
+	// The shadowing token is found by chain denom only.
+	_, err = usecase.GetMetadataByChainDenom(shadowDenom)
+	s.Require().NoError(err)
+
+	// The registry takes precedence on reload.
+	usecase.LoadTokens(map[string]domain.Token{
+		newDenom: {HumanDenom: "pepe", CoinMinimalDenom: newDenom, Precision: 6},
+	})
+
+	newToken, err = usecase.GetMetadataByChainDenom(newDenom)
+	s.Require().NoError(err)
+	s.Require().False(newToken.IsUnverified)
+}
+
+// Test to validate that loading the registry tokens unloads the registry tokens no longer given
+// and their human denoms while keeping the unverified tokens.
+func (s *TokensUseCaseTestSuite) TestLoadTokens_Replace() {
+	const (
+		keptDenom       = "ibc/kept"
+		removedDenom    = "ibc/removed"
+		unverifiedDenom = "factory/osmo1creator/pepe"
+	)
+
+	usecase := tokensusecase.NewTokensUsecase(map[string]domain.Token{
+		keptDenom:    {HumanDenom: "kept", CoinMinimalDenom: keptDenom, Precision: 6},
+		removedDenom: {HumanDenom: "removed", CoinMinimalDenom: removedDenom, Precision: 6},
+	}, 0, nil)
+
+	usecase.LoadUnverifiedTokens(map[string]domain.Token{
+		unverifiedDenom: {HumanDenom: "pepe", CoinMinimalDenom: unverifiedDenom, Precision: 6},
+	})
+
+	// The kept token is renamed and the removed token is no longer given.
+	usecase.LoadTokens(map[string]domain.Token{
+		keptDenom: {HumanDenom: "renamed", CoinMinimalDenom: keptDenom, Precision: 6},
+	})
+
+	// The removed token is unloaded.
+	_, err := usecase.GetMetadataByChainDenom(removedDenom)
+	s.Require().Error(err)
+	s.Require().False(usecase.IsValidChainDenom(removedDenom))
+
+	_, err = usecase.GetChainDenom("removed")
+	s.Require().Error(err)
+
+	// The kept token is only found by its new human denom.
+	_, err = usecase.GetChainDenom("kept")
+	s.Require().Error(err)
+
+	chainDenom, err := usecase.GetChainDenom("renamed")
+	s.Require().NoError(err)
+	s.Require().Equal(keptDenom, chainDenom)
+
+	// The unverified token is kept.
+	unverifiedToken, err := usecase.GetMetadataByChainDenom(unverifiedDenom)
+	s.Require().NoError(err)
+	s.Require().True(unverifiedToken.IsUnverified)
+
+	chainDenom, err = usecase.GetChainDenom("pepe")
+	s.Require().NoError(err)
+	s.Require().Equal(unverifiedDenom, chainDenom)
+}
+
+// Test to validate that the pool denom metadata of the other quotes is stored separately from the default quote one
+// and that the denoms without metadata in the given quote are set to zero.
+func (s *TokensUseCaseTestSuite) TestGetQuotePoolDenomsMetadata() {
+	const quoteDenom = "uosmo"
+
+	zeroPoolDenomMetadata := domain.PoolDenomMetaData{
+		TotalLiquidity:    osmomath.ZeroInt(),
+		TotalLiquidityCap: osmomath.ZeroInt(),
+		Price:             osmomath.ZeroBigDec(),
+	}
+
+	usecase := tokensusecase.NewTokensUsecase(nil, 0, nil)
+	usecase.SetChainDenoms("denom1", struct{}{})
+	usecase.SetChainDenoms("denom2", struct{}{})
+
+	usecase.UpdatePoolDenomMetadata(domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(10)},
+	})
+	usecase.UpdateQuotePoolDenomMetadata(quoteDenom, domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(20)},
+	})
+
+	s.Require().Equal(domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(20)},
+		"denom2": zeroPoolDenomMetadata,
+	}, usecase.GetFullQuotePoolDenomMetadata(context.Background(), quoteDenom))
+
+	s.Require().Equal(domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(10)},
+	}, usecase.GetPoolDenomsMetadata(context.Background(), []string{"denom1"}))
+
+	// Quote without pool denom metadata.
+	s.Require().Equal(domain.PoolDenomMetaDataMap{
+		"denom1": zeroPoolDenomMetadata,
+	}, usecase.GetQuotePoolDenomsMetadata(context.Background(), "uatom", []string{"denom1"}))
+
+	usecase.ClearPoolDenomMetadata()
+	s.Require().Equal(domain.PoolDenomMetaDataMap{
+		"denom1": zeroPoolDenomMetadata,
+	}, usecase.GetQuotePoolDenomsMetadata(context.Background(), quoteDenom, []string{"denom1"}))
+}
+
+// Test to validate that the pool denom metadata captured by the state snapshot pinned in the context
+// is read instead of the latest one and that the latest one is read otherwise.
+func (s *TokensUseCaseTestSuite) TestGetPoolDenomMetadata_PinnedSnapshot() {
+	const quoteDenom = "uosmo"
+
+	usecase := tokensusecase.NewTokensUsecase(nil, 0, nil)
+	usecase.SetChainDenoms("denom1", struct{}{})
+
+	usecase.UpdatePoolDenomMetadata(domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{TotalLiquidity: osmomath.NewInt(10), TotalLiquidityCap: osmomath.NewInt(10)},
+		"denom2": domain.PoolDenomMetaData{TotalLiquidity: osmomath.NewInt(20), TotalLiquidityCap: osmomath.NewInt(20)},
+	})
+	usecase.UpdateQuotePoolDenomMetadata(quoteDenom, domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(20)},
+	})
+
+	snapshot := domain.NewStateSnapshot(1, nil, nil, nil, nil).WithPoolDenomMetadata(usecase.GetPoolDenomMetadataSnapshot())
+	pinnedCtx := domain.ContextWithStateSnapshot(context.Background(), snapshot)
+
+	// Repriced after the snapshot is taken.
+	usecase.UpdatePoolDenomMetadata(domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{TotalLiquidity: osmomath.NewInt(30), TotalLiquidityCap: osmomath.NewInt(30)},
+	})
+	usecase.UpdateQuotePoolDenomMetadata(quoteDenom, domain.PoolDenomMetaDataMap{
+		"denom1": domain.PoolDenomMetaData{Price: osmomath.NewBigDec(40)},
+	})
+
+	liquidity, err := usecase.GetPoolLiquidityCap(pinnedCtx, "denom1")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(10), liquidity)
+
+	liquidity, err = usecase.GetPoolLiquidityCap(context.Background(), "denom1")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(30), liquidity)
+
+	minLiquidityCap, err := usecase.GetMinPoolLiquidityCap(pinnedCtx, "denom1", "denom2")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), minLiquidityCap)
+
+	minLiquidityCap, err = usecase.GetMinPoolLiquidityCap(context.Background(), "denom1", "denom2")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(20), minLiquidityCap)
+
+	s.Require().Equal(osmomath.NewBigDec(20), usecase.GetFullQuotePoolDenomMetadata(pinnedCtx, quoteDenom)["denom1"].Price)
+	s.Require().Equal(osmomath.NewBigDec(40), usecase.GetFullQuotePoolDenomMetadata(context.Background(), quoteDenom)["denom1"].Price)
+
+	_, err = usecase.GetPoolDenomMetadata(pinnedCtx, "denom3")
+	s.Require().ErrorAs(err, &domain.PoolDenomMetaDataNotPresentError{})
+}
+
 // Test to validate valid human denoms.
 func (s *TokensUseCaseTestSuite) TestGetFullPoolDenomMetadata() {
 	testcases := []struct {
@@ -692,7 +889,7 @@ func (s *TokensUseCaseTestSuite) TestGetFullPoolDenomMetadata() {
 				usecase.SetChainDenoms(k, v)
 			}
 
-			result := usecase.GetFullPoolDenomMetadata()
+			result := usecase.GetFullPoolDenomMetadata(context.Background())
 			s.Require().Equal(tt.expectedMetadata, result)
 		})
 	}